@@ -15,6 +15,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/client"
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
@@ -22,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/sirupsen/logrus"
 )
 
@@ -35,6 +37,15 @@ const (
 	getStatePath             = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath       = "/eth/v1/node/version"
 	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getPeerScoresPath        = "/prysm/v1/node/peer_scores"
+	getCrawledNodesPath      = "/prysm/v1/node/crawled_nodes"
+	getGenesisPath           = "/eth/v1/beacon/genesis"
+	getSyncStatusPath        = "/eth/v1/node/syncing"
+	getBlockHeaderPath       = "/eth/v1/beacon/headers/{{.Id}}"
+	getValidatorCountPath    = "/prysm/v1/beacon/states/{{.Id}}/validator_count"
+	attesterSlashingsPath    = "/eth/v1/beacon/pool/attester_slashings"
+	attesterSlashingsV2Path  = "/eth/v2/beacon/pool/attester_slashings"
+	proposerSlashingsPath    = "/eth/v1/beacon/pool/proposer_slashings"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -334,6 +345,156 @@ func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*structs.BLSToEx
 	return poolResponse, nil
 }
 
+// GetPeerScores retrieves the gossipsub and Prysm scores of the node's connected peers.
+// When peerID is not empty, only the score of that peer is requested.
+func (c *Client) GetPeerScores(ctx context.Context, peerID string) (*structs.GetPeerScoresResponse, error) {
+	var opts []client.ReqOption
+	if peerID != "" {
+		opts = append(opts, client.WithQueryParam("peer_id", peerID))
+	}
+	body, err := c.Get(ctx, getPeerScoresPath, opts...)
+	if err != nil {
+		return nil, err
+	}
+	scoresResponse := &structs.GetPeerScoresResponse{}
+	if err := json.Unmarshal(body, scoresResponse); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("problem unmarshaling %s response", getPeerScoresPath))
+	}
+	return scoresResponse, nil
+}
+
+// GetCrawledNodes retrieves the beacon nodes found by the node's discovery crawler. The attnet, syncnet,
+// fork_digest and client query parameters can be given with client.WithQueryParam to filter the nodes.
+func (c *Client) GetCrawledNodes(ctx context.Context, opts ...client.ReqOption) (*structs.GetCrawledNodesResponse, error) {
+	body, err := c.Get(ctx, getCrawledNodesPath, opts...)
+	if err != nil {
+		return nil, err
+	}
+	nodesResponse := &structs.GetCrawledNodesResponse{}
+	if err := json.Unmarshal(body, nodesResponse); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("problem unmarshaling %s response", getCrawledNodesPath))
+	}
+	return nodesResponse, nil
+}
+
+// GetGenesis retrieves the genesis time and genesis validators root of the chain.
+func (c *Client) GetGenesis(ctx context.Context) (*structs.Genesis, error) {
+	body, err := c.Get(ctx, getGenesisPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting genesis")
+	}
+	genesisResponse := &structs.GetGenesisResponse{}
+	if err := json.Unmarshal(body, genesisResponse); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("problem unmarshaling %s response", getGenesisPath))
+	}
+	if genesisResponse.Data == nil {
+		return nil, errors.New("genesis response has no data")
+	}
+	return genesisResponse.Data, nil
+}
+
+// GetSyncStatus retrieves the head slot of the beacon node and whether it is syncing.
+func (c *Client) GetSyncStatus(ctx context.Context) (*structs.SyncStatusResponseData, error) {
+	body, err := c.Get(ctx, getSyncStatusPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting sync status")
+	}
+	syncResponse := &structs.SyncStatusResponse{}
+	if err := json.Unmarshal(body, syncResponse); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("problem unmarshaling %s response", getSyncStatusPath))
+	}
+	if syncResponse.Data == nil {
+		return nil, errors.New("sync status response has no data")
+	}
+	return syncResponse.Data, nil
+}
+
+var getBlockHeaderTpl = idTemplate(getBlockHeaderPath)
+
+// GetBlockHeader retrieves the SignedBeaconBlockHeader for the given block id.
+// Block identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded blockRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetBlockHeader(ctx context.Context, blockId StateOrBlockId) (*ethpb.SignedBeaconBlockHeader, error) {
+	body, err := c.Get(ctx, getBlockHeaderTpl(blockId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting block header by id = %s", blockId)
+	}
+	headerResponse := &structs.GetBlockHeaderResponse{}
+	if err := json.Unmarshal(body, headerResponse); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetBlockHeader")
+	}
+	if headerResponse.Data == nil || headerResponse.Data.Header == nil {
+		return nil, errors.New("block header response has no header")
+	}
+	return headerResponse.Data.Header.ToConsensus()
+}
+
+var getValidatorCountTpl = idTemplate(getValidatorCountPath)
+
+// GetValidatorCount retrieves the number of validators of the state identified by stateId, by status.
+// When no status is given, the counts of all the statuses are returned.
+func (c *Client) GetValidatorCount(ctx context.Context, stateId StateOrBlockId, statuses ...string) (*structs.GetValidatorCountResponse, error) {
+	opts := make([]client.ReqOption, len(statuses))
+	for i, status := range statuses {
+		opts[i] = client.WithQueryParam("status", status)
+	}
+	body, err := c.Get(ctx, getValidatorCountTpl(stateId), opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting validator count by state id = %s", stateId)
+	}
+	countResponse := &structs.GetValidatorCountResponse{}
+	if err := json.Unmarshal(body, countResponse); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetValidatorCount")
+	}
+	return countResponse, nil
+}
+
+// SubmitAttesterSlashing submits an attester slashing to the operations pool of the beacon node,
+// which verifies and broadcasts it.
+func (c *Client) SubmitAttesterSlashing(ctx context.Context, slashing *structs.AttesterSlashing) error {
+	return c.post(ctx, attesterSlashingsPath, slashing, nil)
+}
+
+// SubmitAttesterSlashingElectra submits an Electra attester slashing to the operations pool of
+// the beacon node, which verifies and broadcasts it.
+func (c *Client) SubmitAttesterSlashingElectra(ctx context.Context, slashing *structs.AttesterSlashingElectra) error {
+	return c.post(ctx, attesterSlashingsV2Path, slashing, map[string]string{api.VersionHeader: version.String(version.Electra)})
+}
+
+// SubmitProposerSlashing submits a proposer slashing to the operations pool of the beacon node,
+// which verifies and broadcasts it.
+func (c *Client) SubmitProposerSlashing(ctx context.Context, slashing *structs.ProposerSlashing) error {
+	return c.post(ctx, proposerSlashingsPath, slashing, nil)
+}
+
+func (c *Client) post(ctx context.Context, p string, request interface{}, headers map[string]string) error {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: p})
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrap(err, "invalid format, failed to create new POST request object")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	return nil
+}
+
 type forkScheduleResponse struct {
 	Data []structs.Fork
 }
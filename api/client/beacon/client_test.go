@@ -1,10 +1,15 @@
 package beacon
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -137,3 +142,38 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPeerScores(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getPeerScoresPath, r.URL.Path)
+		require.Equal(t, "16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ", r.URL.Query().Get("peer_id"))
+		resp := &structs.GetPeerScoresResponse{Data: []*structs.PeerScore{{PeerId: r.URL.Query().Get("peer_id")}}}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	resp, err := c.GetPeerScores(context.Background(), "16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, "16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ", resp.Data[0].PeerId)
+}
+
+func TestGetCrawledNodes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getCrawledNodesPath, r.URL.Path)
+		require.Equal(t, "5", r.URL.Query().Get("attnet"))
+		require.Equal(t, "0xaabbccdd", r.URL.Query().Get("fork_digest"))
+		resp := &structs.GetCrawledNodesResponse{Data: []*structs.CrawledNode{{NodeId: "01", Attnets: "0x2000000000000000"}}}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	resp, err := c.GetCrawledNodes(context.Background(), client.WithQueryParam("attnet", "5"), client.WithQueryParam("fork_digest", "0xaabbccdd"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, "01", resp.Data[0].NodeId)
+}
@@ -28,6 +28,8 @@ const (
 	EventLightClientOptimisticUpdate = "light_client_optimistic_update"
 	EventPayloadAttributes           = "payload_attributes"
 	EventBlobSidecar                 = "blob_sidecar"
+	EventIndexedAttestation          = "indexed_attestation"
+	EventBlockHeader                 = "block_header"
 	EventError                       = "error"
 	EventConnectionError             = "connection_error"
 )
@@ -77,13 +79,15 @@ func NewEventStream(ctx context.Context, httpClient *http.Client, host string, t
 func (h *EventStream) Subscribe(eventsChannel chan<- *Event) {
 	allTopics := strings.Join(h.topics, ",")
 	log.WithField("topics", allTopics).Info("Listening to Beacon API events")
-	fullUrl := h.host + "/eth/v1/events?topics=" + allTopics
+	// Each topic is given as its own query parameter, as beacon nodes do not all split comma separated topics.
+	fullUrl := h.host + "/eth/v1/events?" + url.Values{"topics": h.topics}.Encode()
 	req, err := http.NewRequestWithContext(h.ctx, http.MethodGet, fullUrl, nil)
 	if err != nil {
 		eventsChannel <- &Event{
 			EventType: EventConnectionError,
 			Data:      []byte(errors.Wrap(err, "failed to create HTTP request").Error()),
 		}
+		return
 	}
 	req.Header.Set("Accept", api.EventStreamMediaType)
 	req.Header.Set("Connection", api.KeepAlive)
@@ -93,6 +97,7 @@ func (h *EventStream) Subscribe(eventsChannel chan<- *Event) {
 			EventType: EventConnectionError,
 			Data:      []byte(errors.Wrap(err, client.ErrConnectionIssue.Error()).Error()),
 		}
+		return
 	}
 
 	defer func() {
@@ -100,6 +105,13 @@ func (h *EventStream) Subscribe(eventsChannel chan<- *Event) {
 			log.WithError(closeErr).Error("Failed to close events response body")
 		}
 	}()
+	if resp.StatusCode != http.StatusOK {
+		eventsChannel <- &Event{
+			EventType: EventConnectionError,
+			Data:      []byte(client.Non200Err(resp).Error()),
+		}
+		return
+	}
 	// Create a new scanner to read lines from the response body
 	scanner := bufio.NewScanner(resp.Body)
 	// Set the split function for the scanning operation
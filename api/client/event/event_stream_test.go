@@ -79,3 +79,22 @@ func TestEventStream(t *testing.T) {
 		}
 	}
 }
+
+func TestEventStream_NotOK(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/events", func(w http.ResponseWriter, r *http.Request) {
+		require.DeepEqual(t, []string{"block", "indexed_attestation"}, r.URL.Query()["topics"])
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	eventsChannel := make(chan *Event, 1)
+	stream, err := NewEventStream(context.Background(), http.DefaultClient, server.URL, []string{"block", "indexed_attestation"})
+	require.NoError(t, err)
+	stream.Subscribe(eventsChannel)
+
+	event := <-eventsChannel
+	require.Equal(t, EventConnectionError, event.EventType)
+	require.StringContains(t, "code=400", string(event.Data))
+}
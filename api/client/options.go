@@ -16,6 +16,15 @@ func WithSSZEncoding() ReqOption {
 	}
 }
 
+// WithQueryParam is a request functional option that adds a query parameter to the request URL.
+func WithQueryParam(key, value string) ReqOption {
+	return func(req *http.Request) {
+		q := req.URL.Query()
+		q.Add(key, value)
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
 // WithAuthorizationToken is a request functional option that adds header for authorization token.
 func WithAuthorizationToken(token string) ReqOption {
 	return func(req *http.Request) {
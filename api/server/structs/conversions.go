@@ -756,6 +756,30 @@ func (a *IndexedAttestationElectra) ToConsensus() (*eth.IndexedAttestationElectr
 	}, nil
 }
 
+func IndexedAttestationFromConsensus(a *eth.IndexedAttestation) *IndexedAttestation {
+	indices := make([]string, len(a.AttestingIndices))
+	for i, ix := range a.AttestingIndices {
+		indices[i] = fmt.Sprintf("%d", ix)
+	}
+	return &IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             AttDataFromConsensus(a.Data),
+		Signature:        hexutil.Encode(a.Signature),
+	}
+}
+
+func IndexedAttestationElectraFromConsensus(a *eth.IndexedAttestationElectra) *IndexedAttestationElectra {
+	indices := make([]string, len(a.AttestingIndices))
+	for i, ix := range a.AttestingIndices {
+		indices[i] = fmt.Sprintf("%d", ix)
+	}
+	return &IndexedAttestationElectra{
+		AttestingIndices: indices,
+		Data:             AttDataFromConsensus(a.Data),
+		Signature:        hexutil.Encode(a.Signature),
+	}
+}
+
 func WithdrawalsFromConsensus(ws []*enginev1.Withdrawal) []*Withdrawal {
 	result := make([]*Withdrawal, len(ws))
 	for i, w := range ws {
@@ -1508,3 +1532,12 @@ func PendingConsolidationsFromConsensus(cs []*eth.PendingConsolidation) []*Pendi
 	}
 	return consolidations
 }
+
+func ClientVersionFromConsensus(v *enginev1.ClientVersionV1) *ClientVersionV1 {
+	return &ClientVersionV1{
+		Code:    v.Code,
+		Name:    v.Name,
+		Version: v.Version,
+		Commit:  v.Commit,
+	}
+}
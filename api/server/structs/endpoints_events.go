@@ -48,6 +48,22 @@ type ChainReorgEvent struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type LateBlockReorgEvent struct {
+	Proposing          bool   `json:"proposing"`
+	HeadRoot           string `json:"head_root"`
+	HeadSlot           string `json:"head_slot"`
+	HeadWeight         string `json:"head_weight"`
+	ParentRoot         string `json:"parent_root"`
+	ParentSlot         string `json:"parent_slot"`
+	ParentWeight       string `json:"parent_weight"`
+	HeadArrivalMillis  string `json:"head_arrival_millis"`
+	TimeIntoSlotMillis string `json:"time_into_slot_millis"`
+	CommitteeWeight    string `json:"committee_weight"`
+	FinalizedEpoch     string `json:"finalized_epoch"`
+	Reorg              bool   `json:"reorg"`
+	Reason             string `json:"reason"`
+}
+
 type PayloadAttributesEvent struct {
 	Version string          `json:"version"`
 	Data    json.RawMessage `json:"data"`
@@ -61,7 +61,143 @@ type GetVersionResponse struct {
 }
 
 type Version struct {
+	Version         string             `json:"version"`
+	ExecutionClient []*ClientVersionV1 `json:"execution_client,omitempty"`
+}
+
+// VersionMetadata is the JSON metadata of the node version returned by the v1alpha1 node gRPC API.
+type VersionMetadata struct {
+	ExecutionClient []*ClientVersionV1 `json:"execution_client,omitempty"`
+}
+
+type GetExecutionClientVersionResponse struct {
+	Data []*ClientVersionV1 `json:"data"`
+}
+
+type ClientVersionV1 struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
 	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
+type ConnectionFilterRule struct {
+	Action string `json:"action"`
+	Target string `json:"target"`
+}
+
+type ConnectionFilterRulesResponse struct {
+	Data []*ConnectionFilterRule `json:"data"`
+}
+
+type GetPeerScoresResponse struct {
+	Data []*PeerScore `json:"data"`
+}
+
+type PeerScore struct {
+	PeerId    string          `json:"peer_id"`
+	State     string          `json:"state"`
+	Direction string          `json:"direction"`
+	Gossipsub *GossipsubScore `json:"gossipsub"`
+	Prysm     *PrysmPeerScore `json:"prysm"`
+}
+
+type GossipsubScore struct {
+	Score              string                          `json:"score"`
+	AppSpecificScore   string                          `json:"app_specific_score"`
+	IPColocationFactor string                          `json:"ip_colocation_factor"`
+	BehaviourPenalty   string                          `json:"behaviour_penalty"`
+	Topics             map[string]*GossipsubTopicScore `json:"topics"`
+}
+
+type GossipsubTopicScore struct {
+	TimeInMesh               string `json:"time_in_mesh"`
+	FirstMessageDeliveries   string `json:"first_message_deliveries"`
+	MeshMessageDeliveries    string `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries string `json:"invalid_message_deliveries"`
+}
+
+type PrysmPeerScore struct {
+	Score              string `json:"score"`
+	BadResponsesScore  string `json:"bad_responses_score"`
+	BadResponses       string `json:"bad_responses"`
+	BlockProviderScore string `json:"block_provider_score"`
+	ProcessedBlocks    string `json:"processed_blocks"`
+	PeerStatusScore    string `json:"peer_status_score"`
+	GossipScore        string `json:"gossip_score"`
+	ValidationError    string `json:"validation_error,omitempty"`
+}
+
+type GetBandwidthResponse struct {
+	Data *Bandwidth `json:"data"`
+}
+
+type Bandwidth struct {
+	Total     *BandwidthUsage   `json:"total"`
+	Protocols []*BandwidthUsage `json:"protocols"`
+	Topics    []*BandwidthUsage `json:"topics"`
+}
+
+type BandwidthUsage struct {
+	Name     string `json:"name,omitempty"`
+	TotalIn  string `json:"total_in"`
+	TotalOut string `json:"total_out"`
+	RateIn   string `json:"rate_in"`
+	RateOut  string `json:"rate_out"`
+}
+
+type GetRateLimitsResponse struct {
+	Data []*PeerRateLimits `json:"data"`
+}
+
+type PeerRateLimits struct {
+	PeerId  string            `json:"peer_id"`
+	Trusted bool              `json:"trusted"`
+	Usage   []*RateLimitUsage `json:"usage"`
+}
+
+type RateLimitUsage struct {
+	Topic           string `json:"topic"`
+	Requests        string `json:"requests"`
+	RateLimited     string `json:"rate_limited"`
+	LastRateLimited string `json:"last_rate_limited,omitempty"`
+	Remaining       string `json:"remaining"`
+	Capacity        string `json:"capacity"`
+}
+
+type GetCrawledNodesResponse struct {
+	Data []*CrawledNode `json:"data"`
+}
+
+type CrawledNode struct {
+	NodeId          string `json:"node_id"`
+	PeerId          string `json:"peer_id"`
+	Enr             string `json:"enr"`
+	Seq             string `json:"seq"`
+	Ip              string `json:"ip"`
+	TcpPort         string `json:"tcp_port,omitempty"`
+	UdpPort         string `json:"udp_port,omitempty"`
+	QuicPort        string `json:"quic_port,omitempty"`
+	ForkDigest      string `json:"fork_digest"`
+	NextForkVersion string `json:"next_fork_version"`
+	NextForkEpoch   string `json:"next_fork_epoch"`
+	Attnets         string `json:"attnets"`
+	Syncnets        string `json:"syncnets"`
+	Client          string `json:"client,omitempty"`
+	FirstSeen       string `json:"first_seen"`
+	LastSeen        string `json:"last_seen"`
+}
+
+type GetBuilderRelaysResponse struct {
+	Data []*BuilderRelay `json:"data"`
+}
+
+type BuilderRelay struct {
+	Name             string `json:"name"`
+	Blacklisted      bool   `json:"blacklisted"`
+	BlacklistedUntil string `json:"blacklisted_until,omitempty"`
+	BlacklistReason  string `json:"blacklist_reason,omitempty"`
+	Blacklistings    string `json:"blacklistings"`
 }
 
 type AddrRequest struct {
@@ -0,0 +1,50 @@
+package structs
+
+import "encoding/json"
+
+type GetDetectedSlashingsResponse struct {
+	Data          []*DetectedSlashing `json:"data"`
+	NextPageToken string              `json:"next_page_token"`
+}
+
+type DetectedSlashing struct {
+	DetectedAt       string            `json:"detected_at"`
+	ValidatorIndices []string          `json:"validator_indices"`
+	AttesterSlashing json.RawMessage   `json:"attester_slashing,omitempty"` // represents the attester slashing values based on the version
+	ProposerSlashing *ProposerSlashing `json:"proposer_slashing,omitempty"`
+}
+
+type IsSlashableAttestationResponse struct {
+	Version string                `json:"version"`
+	Data    *SlashableAttestation `json:"data"`
+}
+
+type SlashableAttestation struct {
+	Slashable         bool              `json:"slashable"`
+	AttesterSlashings []json.RawMessage `json:"attester_slashings"` // represents the attester slashing values based on the version
+}
+
+type IsSlashableBlockResponse struct {
+	Data *SlashableBlock `json:"data"`
+}
+
+type SlashableBlock struct {
+	Slashable         bool                `json:"slashable"`
+	ProposerSlashings []*ProposerSlashing `json:"proposer_slashings"`
+}
+
+type GetValidatorSpansResponse struct {
+	Data *ValidatorSpans `json:"data"`
+}
+
+type ValidatorSpans struct {
+	ValidatorIndex   string       `json:"validator_index"`
+	Kind             string       `json:"kind"`
+	LastEpochWritten string       `json:"last_epoch_written"`
+	Spans            []*EpochSpan `json:"spans"`
+}
+
+type EpochSpan struct {
+	Epoch string `json:"epoch"`
+	Span  string `json:"span"`
+}
@@ -149,3 +149,42 @@ type ActiveSetChanges struct {
 	EjectedPublicKeys   []string `json:"ejected_public_keys"`
 	EjectedIndices      []string `json:"ejected_indices"`
 }
+
+type GetPayloadDecisionResponse struct {
+	Data *PayloadDecision `json:"data"`
+}
+
+type PayloadDecision struct {
+	Slot                 string   `json:"slot"`
+	ProposerIndex        string   `json:"proposer_index"`
+	Relays               []string `json:"relays"`
+	BuilderBlockHash     string   `json:"builder_block_hash"`
+	BuilderValueGwei     string   `json:"builder_value_gwei"`
+	LocalValueGwei       string   `json:"local_value_gwei"`
+	BuilderBoostFactor   string   `json:"builder_boost_factor"`
+	LocalBoostPercentage string   `json:"local_boost_percentage"`
+	Source               string   `json:"source"`
+	Reason               string   `json:"reason"`
+	Delivery             string   `json:"delivery"`
+	DeliveryError        string   `json:"delivery_error"`
+}
+
+type GetValidatorMonitorHistoryResponse struct {
+	Data []*ValidatorEpochPerformance `json:"data"`
+}
+
+type ValidatorEpochPerformance struct {
+	Epoch                 string   `json:"epoch"`
+	Attested              bool     `json:"attested"`
+	AttestedSlot          string   `json:"attested_slot"`
+	InclusionSlot         string   `json:"inclusion_slot"`
+	InclusionDistance     string   `json:"inclusion_distance"`
+	CorrectSource         bool     `json:"correct_source"`
+	CorrectTarget         bool     `json:"correct_target"`
+	CorrectHead           bool     `json:"correct_head"`
+	ProposedSlots         []string `json:"proposed_slots"`
+	SyncCommitteeExpected string   `json:"sync_committee_expected"`
+	SyncCommitteeIncluded string   `json:"sync_committee_included"`
+	Balance               string   `json:"balance"`
+	BalanceChange         string   `json:"balance_change"`
+}
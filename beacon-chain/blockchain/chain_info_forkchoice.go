@@ -15,11 +15,22 @@ func (s *Service) CachedHeadRoot() [32]byte {
 	return s.cfg.ForkChoiceStore.CachedHeadRoot()
 }
 
-// GetProposerHead returns the corresponding value from forkchoice
+// GetProposerHead returns the corresponding value from forkchoice, and notifies
+// the decision to orphan, or not, the head block when it arrived late.
 func (s *Service) GetProposerHead() [32]byte {
+	s.cfg.ForkChoiceStore.RLock()
+	head := s.cfg.ForkChoiceStore.GetProposerHead()
+	d := s.cfg.ForkChoiceStore.ProposerHeadDecision()
+	s.cfg.ForkChoiceStore.RUnlock()
+	s.sendLateBlockReorgDecision(d)
+	return head
+}
+
+// ShouldOverrideFCU returns the corresponding value from forkchoice
+func (s *Service) ShouldOverrideFCU() bool {
 	s.cfg.ForkChoiceStore.RLock()
 	defer s.cfg.ForkChoiceStore.RUnlock()
-	return s.cfg.ForkChoiceStore.GetProposerHead()
+	return s.cfg.ForkChoiceStore.ShouldOverrideFCU()
 }
 
 // SetForkChoiceGenesisTime sets the genesis time in Forkchoice
@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -129,7 +132,9 @@ func (s *Service) shouldOverrideFCU(newHeadRoot [32]byte, proposingSlot primitiv
 			params.BeaconConfig().SecondsPerSlot)
 		lateBlockFailedAttemptSecondThreshold.Inc()
 	} else {
-		if s.cfg.ForkChoiceStore.ShouldOverrideFCU() {
+		d := s.cfg.ForkChoiceStore.ShouldOverrideFCUDecision()
+		s.sendLateBlockReorgDecision(d)
+		if d != nil && d.Reorg {
 			return true
 		}
 		secs, err := slots.SecondsSinceSlotStart(currentSlot,
@@ -148,3 +153,24 @@ func (s *Service) shouldOverrideFCU(newHeadRoot [32]byte, proposingSlot primitiv
 	}
 	return false
 }
+
+// sendLateBlockReorgDecision notifies the decision to orphan, or not, a head block which arrived late.
+func (s *Service) sendLateBlockReorgDecision(d *forkchoicetypes.LateBlockReorgDecision) {
+	if d == nil {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"proposing":    d.Proposing,
+		"headRoot":     fmt.Sprintf("%#x", d.HeadRoot),
+		"headSlot":     d.HeadSlot,
+		"headWeight":   d.HeadWeight,
+		"parentWeight": d.ParentWeight,
+		"headArrival":  d.HeadArrival,
+		"reorg":        d.Reorg,
+		"reason":       d.Reason,
+	}).Debug("Late block reorg decision")
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.LateBlockReorgDecision,
+		Data: d,
+	})
+}
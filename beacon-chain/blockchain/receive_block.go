@@ -115,8 +115,8 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 	if err := s.updateCheckpoints(ctx, currentCheckpoints, preState, postState, blockRoot); err != nil {
 		return err
 	}
-	// If slasher is configured, forward the attestations in the block via an event feed for processing.
-	if features.Get().EnableSlasher {
+	// If slasher is configured, locally or through the event stream, forward the attestations in the block via an event feed.
+	if features.Get().EnableSlasher || features.Get().EnableSlasherEventStream {
 		go s.sendBlockAttestationsToSlasher(blockCopy, preState)
 	}
 
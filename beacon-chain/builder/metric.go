@@ -27,4 +27,56 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	relayGetHeaderLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_get_header_latency_milliseconds",
+			Help:    "Captures the latency of each relay to return a bid in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relaySubmitBlindedBlockLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_submit_blinded_block_latency_milliseconds",
+			Help:    "Captures the latency of each relay to reveal the payload of a blinded block in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relayRegisterValidatorLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_register_validator_latency_milliseconds",
+			Help:    "Captures the latency of each relay to register validators in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relayBidValue = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "builder_relay_bid_value_gwei",
+			Help: "The value of the last valid bid of each relay in gwei",
+		},
+		[]string{"relay"},
+	)
+	relayFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_failures_total",
+			Help: "The number of failed requests to each relay, by method",
+		},
+		[]string{"relay", "method"},
+	)
+	relayBlacklisted = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "builder_relay_blacklisted",
+			Help: "1 if the relay is blacklisted by the relay circuit breaker, 0 otherwise",
+		},
+		[]string{"relay"},
+	)
+	relayBlacklistings = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_blacklistings_total",
+			Help: "The number of times each relay was blacklisted by the relay circuit breaker",
+		},
+		[]string{"relay"},
+	)
 )
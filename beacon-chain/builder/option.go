@@ -1,6 +1,9 @@
 package builder
 
 import (
+	"strings"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
@@ -13,25 +16,44 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var opts []Option
+	for _, endpoint := range strings.Split(c.String(flags.MevRelayEndpoint.Name), ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		opts = append(opts, WithBuilderClient(client))
 	}
-	opts := []Option{
-		WithBuilderClient(client),
-	}
+	opts = append(opts, WithRelayTimeout(c.Duration(flags.MevRelayTimeout.Name)))
+	opts = append(opts, WithRelayBlacklistPeriod(c.Duration(flags.MevRelayBlacklistPeriod.Name)))
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a relay client to the beacon chain builder service.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
+		return nil
+	}
+}
+
+// WithRelayTimeout sets the time each relay has to return a bid.
+func WithRelayTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.relayTimeout = timeout
+		return nil
+	}
+}
+
+// WithRelayBlacklistPeriod sets how long a relay is blacklisted after it failed to reveal a payload or
+// offered an invalid bid. A zero period never blacklists relays.
+func WithRelayBlacklistPeriod(period time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.relayBlacklistPeriod = period
 		return nil
 	}
 }
@@ -0,0 +1,300 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// bidRelaysRetention is the number of slots for which the relays offering a bid are remembered.
+const bidRelaysRetention = primitives.Slot(32)
+
+// relay is a builder relay the service requests bids from.
+type relay struct {
+	client builder.BuilderClient
+	// name identifies the relay in logs, metrics and the API by the host and path of its URL,
+	// without its credentials. It is unique among the relays of the service.
+	name string
+
+	healthLock       sync.Mutex
+	blacklistedUntil time.Time
+	blacklistReason  string
+	blacklistings    uint64
+}
+
+func newRelay(client builder.BuilderClient) *relay {
+	name := client.NodeURL()
+	if u, err := url.Parse(name); err == nil && u.Host != "" {
+		name = u.Host + strings.TrimSuffix(u.Path, "/")
+	}
+	return &relay{client: client, name: name}
+}
+
+// relayBid is a valid bid offered by a relay.
+type relayBid struct {
+	relay     *relay
+	signed    builder.SignedBid
+	value     *big.Int
+	blockHash [32]byte
+}
+
+// errNilBid is returned when a relay has no bid to offer, which unlike an invalid bid is not a
+// misbehavior of the relay.
+var errNilBid = errors.New("relay returned nil bid")
+
+// requestBids requests a bid from all the relays which are not blacklisted in parallel, each within
+// the relay timeout, and returns the valid bids in the order of the relays. Relays offering an
+// invalid bid are blacklisted.
+func (s *Service) requestBids(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) ([]*relayBid, error) {
+	relays := s.healthyRelays()
+	if len(relays) == 0 {
+		return nil, errAllRelaysBlacklisted
+	}
+	bids := make([]*relayBid, len(relays))
+	errs := make([]error, len(relays))
+	var wg sync.WaitGroup
+	for i, r := range relays {
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			ctx := ctx
+			if s.cfg.relayTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, s.cfg.relayTimeout)
+				defer cancel()
+			}
+			start := time.Now()
+			signed, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+			relayGetHeaderLatency.WithLabelValues(r.name).Observe(float64(time.Since(start).Milliseconds()))
+			if err == nil {
+				bids[i], err = validateBid(signed, parentHash)
+				if err != nil && !errors.Is(err, errNilBid) {
+					s.blacklistRelay(r, "invalid bid: "+err.Error())
+				}
+			}
+			if err != nil {
+				relayFailures.WithLabelValues(r.name, "get_header").Inc()
+				log.WithError(err).WithField("relay", r.name).WithField("slot", slot).Debug("Relay did not offer a valid bid")
+				errs[i] = err
+				return
+			}
+			bids[i].relay = r
+			relayBidValue.WithLabelValues(r.name).Set(float64(primitives.WeiToGwei(bids[i].value)))
+		}(i, r)
+	}
+	wg.Wait()
+
+	valid := make([]*relayBid, 0, len(bids))
+	for _, b := range bids {
+		if b != nil {
+			valid = append(valid, b)
+		}
+	}
+	if len(valid) == 0 {
+		if len(errs) == 1 {
+			return nil, errs[0]
+		}
+		return nil, errors.Wrapf(errs[0], "none of the %d relays offered a valid bid", len(errs))
+	}
+	return valid, nil
+}
+
+// validateBid checks that the bid builds on the parent block and is signed by its builder.
+func validateBid(signed builder.SignedBid, parentHash [32]byte) (*relayBid, error) {
+	if signed == nil || signed.IsNil() {
+		return nil, errNilBid
+	}
+	bid, err := signed.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	if bid.IsNil() {
+		return nil, errNilBid
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return nil, err
+	}
+	if err := signing.VerifySigningRoot(bid, bid.Pubkey(), signed.Signature(), d); err != nil {
+		return nil, errors.Wrap(err, "invalid builder signature")
+	}
+	value := primitives.WeiToBigInt(bid.Value())
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return &relayBid{
+		signed:    signed,
+		value:     value,
+		blockHash: bytesutil.ToBytes32(header.BlockHash()),
+	}, nil
+}
+
+// bestBid returns the highest bid, the first relay winning ties.
+func bestBid(bids []*relayBid) *relayBid {
+	var best *relayBid
+	for _, b := range bids {
+		if best == nil || b.value.Cmp(best.value) > 0 {
+			best = b
+		}
+	}
+	return best
+}
+
+// recordBidRelays remembers the relays offering the block of the best bid, the only ones the blinded
+// block will be submitted to.
+func (s *Service) recordBidRelays(slot primitives.Slot, best *relayBid, bids []*relayBid) {
+	relays := make([]*relay, 0, len(bids))
+	for _, b := range bids {
+		if b.blockHash == best.blockHash {
+			relays = append(relays, b.relay)
+		}
+	}
+	s.bidRelaysLock.Lock()
+	defer s.bidRelaysLock.Unlock()
+	for sl := range s.bidRelays {
+		if sl+bidRelaysRetention < slot {
+			delete(s.bidRelays, sl)
+		}
+	}
+	if s.bidRelays[slot] == nil {
+		s.bidRelays[slot] = make(map[[32]byte][]*relay)
+	}
+	s.bidRelays[slot][best.blockHash] = relays
+}
+
+// relaysForBlock returns the relays which offered the payload of the blinded block, or all the relays
+// if the bid is unknown. The returned boolean is true if the relays offered the payload.
+func (s *Service) relaysForBlock(b interfaces.ReadOnlySignedBeaconBlock) ([]*relay, bool) {
+	if b == nil || b.IsNil() {
+		return s.relays, false
+	}
+	payload, err := b.Block().Body().Execution()
+	if err != nil {
+		return s.relays, false
+	}
+	s.bidRelaysLock.Lock()
+	defer s.bidRelaysLock.Unlock()
+	if relays := s.bidRelays[b.Block().Slot()][bytesutil.ToBytes32(payload.BlockHash())]; len(relays) > 0 {
+		return relays, true
+	}
+	return s.relays, false
+}
+
+// BidRelays returns the names of the relays which offered the payload with the given block hash at
+// the slot.
+func (s *Service) BidRelays(slot primitives.Slot, blockHash [32]byte) []string {
+	s.bidRelaysLock.Lock()
+	defer s.bidRelaysLock.Unlock()
+	relays := s.bidRelays[slot][blockHash]
+	names := make([]string, len(relays))
+	for i, r := range relays {
+		names[i] = r.name
+	}
+	return names
+}
+
+type submitResult struct {
+	relay   *relay
+	payload interfaces.ExecutionData
+	blobs   *v1.BlobsBundle
+	err     error
+}
+
+// submitToRelays submits the blinded block to the relays in parallel and returns the first payload
+// revealed by one of them. When the relays offered the payload, those failing to reveal it are
+// blacklisted, even after another relay revealed it.
+func (s *Service) submitToRelays(ctx context.Context, relays []*relay, offered bool, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	results := make(chan *submitResult, len(relays))
+	for _, r := range relays {
+		go func(r *relay) {
+			start := time.Now()
+			payload, blobs, err := r.client.SubmitBlindedBlock(ctx, b)
+			relaySubmitBlindedBlockLatency.WithLabelValues(r.name).Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayFailures.WithLabelValues(r.name, "submit_blinded_block").Inc()
+				// A canceled submission says nothing about the relay.
+				if offered && !errors.Is(err, context.Canceled) {
+					s.blacklistRelay(r, "payload not revealed: "+err.Error())
+				}
+			}
+			results <- &submitResult{relay: r, payload: payload, blobs: blobs, err: err}
+		}(r)
+	}
+	var firstErr error
+	for range relays {
+		res := <-results
+		if res.err == nil {
+			return res.payload, res.blobs, nil
+		}
+		log.WithError(res.err).WithField("relay", res.relay.name).Warn("Relay could not reveal the payload of the blinded block")
+		if firstErr == nil {
+			firstErr = res.err
+		}
+	}
+	if len(relays) == 1 {
+		return nil, nil, firstErr
+	}
+	return nil, nil, errors.Wrapf(firstErr, "none of the %d relays revealed the payload", len(relays))
+}
+
+// registerWithRelays registers the validators with all the relays in parallel. It only fails if no
+// relay accepted the registrations.
+func registerWithRelays(ctx context.Context, relays []*relay, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make([]error, len(relays))
+	var wg sync.WaitGroup
+	for i, r := range relays {
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			start := time.Now()
+			errs[i] = r.client.RegisterValidator(ctx, reg)
+			relayRegisterValidatorLatency.WithLabelValues(r.name).Observe(float64(time.Since(start).Milliseconds()))
+			if errs[i] != nil {
+				relayFailures.WithLabelValues(r.name, "register_validator").Inc()
+			}
+		}(i, r)
+	}
+	wg.Wait()
+
+	var firstErr error
+	failed := 0
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed++
+		if firstErr == nil {
+			firstErr = err
+		}
+		log.WithError(err).WithField("relay", relays[i].name).Warn("Could not register validators with relay")
+	}
+	if failed == len(relays) {
+		return firstErr
+	}
+	return nil
+}
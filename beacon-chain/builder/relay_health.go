@@ -0,0 +1,110 @@
+package builder
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var errAllRelaysBlacklisted = errors.New("all relays are blacklisted")
+
+// RelayStatus is the health of a relay as seen by the relay circuit breaker.
+type RelayStatus struct {
+	Name             string
+	Blacklisted      bool
+	BlacklistedUntil time.Time
+	BlacklistReason  string
+	Blacklistings    uint64
+}
+
+// RelayHealthManager reports the relays blacklisted by the relay circuit breaker and allows to
+// reset them.
+type RelayHealthManager interface {
+	RelayStatuses() []*RelayStatus
+	ResetRelayBlacklist(name string) bool
+}
+
+// blacklistRelay stops requesting bids from a relay for the blacklist period, because it failed to
+// reveal the payload of a blinded block signed by a proposer or offered an invalid bid.
+func (s *Service) blacklistRelay(r *relay, reason string) {
+	if s.cfg.relayBlacklistPeriod <= 0 {
+		return
+	}
+	until := time.Now().Add(s.cfg.relayBlacklistPeriod)
+	r.healthLock.Lock()
+	r.blacklistedUntil = until
+	r.blacklistReason = reason
+	r.blacklistings++
+	r.healthLock.Unlock()
+
+	relayBlacklisted.WithLabelValues(r.name).Set(1)
+	relayBlacklistings.WithLabelValues(r.name).Inc()
+	log.WithFields(log.Fields{
+		"relay":  r.name,
+		"reason": reason,
+		"until":  until.Format(time.RFC3339),
+	}).Warn("Blacklisted relay, falling back to the other relays or to local block building")
+}
+
+// blacklisted returns true while the relay is blacklisted.
+func (r *relay) blacklisted(now time.Time) bool {
+	r.healthLock.Lock()
+	defer r.healthLock.Unlock()
+	return now.Before(r.blacklistedUntil)
+}
+
+// healthyRelays returns the relays which are not blacklisted.
+func (s *Service) healthyRelays() []*relay {
+	now := time.Now()
+	relays := make([]*relay, 0, len(s.relays))
+	for _, r := range s.relays {
+		if r.blacklisted(now) {
+			continue
+		}
+		relayBlacklisted.WithLabelValues(r.name).Set(0)
+		relays = append(relays, r)
+	}
+	return relays
+}
+
+// RelayStatuses returns the health of the relays, in the order they were configured.
+func (s *Service) RelayStatuses() []*RelayStatus {
+	now := time.Now()
+	statuses := make([]*RelayStatus, len(s.relays))
+	for i, r := range s.relays {
+		r.healthLock.Lock()
+		statuses[i] = &RelayStatus{
+			Name:          r.name,
+			Blacklisted:   now.Before(r.blacklistedUntil),
+			Blacklistings: r.blacklistings,
+		}
+		if statuses[i].Blacklisted {
+			statuses[i].BlacklistedUntil = r.blacklistedUntil
+			statuses[i].BlacklistReason = r.blacklistReason
+		}
+		r.healthLock.Unlock()
+	}
+	return statuses
+}
+
+// ResetRelayBlacklist lifts the blacklisting of the relay with the given name, or of all the relays
+// if the name is empty. It returns false if no relay has this name.
+func (s *Service) ResetRelayBlacklist(name string) bool {
+	found := false
+	for _, r := range s.relays {
+		if name != "" && r.name != name {
+			continue
+		}
+		found = true
+		r.healthLock.Lock()
+		r.blacklistedUntil = time.Time{}
+		r.blacklistReason = ""
+		r.healthLock.Unlock()
+		relayBlacklisted.WithLabelValues(r.name).Set(0)
+	}
+	if found {
+		log.WithField("relay", name).Info("Reset relay blacklist")
+	}
+	return found
+}
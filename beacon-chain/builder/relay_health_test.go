@@ -0,0 +1,88 @@
+package builder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_BlacklistInvalidBidRelay(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'p'}
+	good := &testRelay{url: "https://good.relay", bid: testSignedBid(t, parentHash, [32]byte{'g'}, 1, true)}
+	badSignature := &testRelay{url: "https://bad-signature.relay", bid: testSignedBid(t, parentHash, [32]byte{'b'}, 10, false)}
+	noBid := &testRelay{url: "https://no-bid.relay"}
+	s, err := NewService(ctx, WithRelayBlacklistPeriod(time.Hour),
+		WithBuilderClient(good), WithBuilderClient(badSignature), WithBuilderClient(noBid))
+	require.NoError(t, err)
+
+	_, err = s.GetHeader(ctx, 10, parentHash, [48]byte{})
+	require.NoError(t, err)
+	statuses := s.RelayStatuses()
+	require.Equal(t, 3, len(statuses))
+	assert.Equal(t, false, statuses[0].Blacklisted)
+	assert.Equal(t, true, statuses[1].Blacklisted)
+	assert.StringContains(t, "invalid bid: invalid builder signature", statuses[1].BlacklistReason)
+	assert.Equal(t, uint64(1), statuses[1].Blacklistings)
+	assert.Equal(t, false, statuses[2].Blacklisted, "a relay without a bid should not be blacklisted")
+
+	// The blacklisted relay is not asked for a bid anymore.
+	_, err = s.GetHeader(ctx, 11, parentHash, [48]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, good.headerRequests())
+	assert.Equal(t, 1, badSignature.headerRequests())
+
+	require.Equal(t, false, s.ResetRelayBlacklist("unknown.relay"))
+	require.Equal(t, true, s.ResetRelayBlacklist("bad-signature.relay"))
+	assert.Equal(t, false, s.RelayStatuses()[1].Blacklisted)
+	_, err = s.GetHeader(ctx, 12, parentHash, [48]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, badSignature.headerRequests())
+}
+
+func TestService_BlacklistUnrevealedPayloadRelay(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'p'}
+	blockHash := [32]byte{'h'}
+	unrevealed := &testRelay{url: "https://unrevealed.relay", bid: testSignedBid(t, parentHash, blockHash, 3, true), submitErr: errors.New("payload unknown")}
+	other := &testRelay{url: "https://other.relay", bid: testSignedBid(t, parentHash, [32]byte{'o'}, 1, true), submitErr: errors.New("payload unknown")}
+	s, err := NewService(ctx, WithRelayBlacklistPeriod(time.Hour), WithBuilderClient(unrevealed), WithBuilderClient(other))
+	require.NoError(t, err)
+
+	_, err = s.GetHeader(ctx, 10, parentHash, [48]byte{})
+	require.NoError(t, err)
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 10, blockHash))
+	require.ErrorContains(t, "payload unknown", err)
+	statuses := s.RelayStatuses()
+	assert.Equal(t, true, statuses[0].Blacklisted)
+	assert.StringContains(t, "payload not revealed", statuses[0].BlacklistReason)
+
+	// Relays which did not offer the payload of an unknown block are not blacklisted.
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 11, [32]byte{'u'}))
+	require.ErrorContains(t, "payload unknown", err)
+	assert.Equal(t, false, s.RelayStatuses()[1].Blacklisted)
+
+	// Blocks are built locally when all the relays are blacklisted.
+	s.blacklistRelay(s.relays[1], "test")
+	_, err = s.GetHeader(ctx, 12, parentHash, [48]byte{})
+	require.ErrorIs(t, err, errAllRelaysBlacklisted)
+	require.Equal(t, true, s.ResetRelayBlacklist(""))
+	_, err = s.GetHeader(ctx, 12, parentHash, [48]byte{})
+	require.NoError(t, err)
+}
+
+func TestService_RelayBlacklistDisabled(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'p'}
+	badSignature := &testRelay{url: "https://bad-signature.relay", bid: testSignedBid(t, parentHash, [32]byte{'b'}, 10, false)}
+	s, err := NewService(ctx, WithRelayBlacklistPeriod(0), WithBuilderClient(badSignature))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 10, parentHash, [48]byte{})
+	require.ErrorContains(t, "invalid builder signature", err)
+	assert.Equal(t, false, s.RelayStatuses()[0].Blacklisted)
+	assert.Equal(t, uint64(0), s.RelayStatuses()[0].Blacklistings)
+}
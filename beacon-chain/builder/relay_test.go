@@ -0,0 +1,256 @@
+package builder
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	blockchainTesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type testRelay struct {
+	url       string
+	bid       builder.SignedBid
+	err       error
+	submitErr error
+	delay     time.Duration
+	lock      sync.Mutex
+	headers   int
+	submitted int
+	regs      int
+}
+
+func (r *testRelay) NodeURL() string {
+	return r.url
+}
+
+func (r *testRelay) GetHeader(ctx context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	r.lock.Lock()
+	r.headers++
+	r.lock.Unlock()
+	select {
+	case <-time.After(r.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return r.bid, r.err
+}
+
+func (r *testRelay) RegisterValidator(_ context.Context, _ []*ethpb.SignedValidatorRegistrationV1) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.regs++
+	return r.err
+}
+
+func (r *testRelay) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.submitted++
+	payload, err := blocks.WrappedExecutionPayloadCapella(&v1.ExecutionPayloadCapella{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.submitErr != nil {
+		return nil, nil, r.submitErr
+	}
+	return payload, nil, r.err
+}
+
+func (*testRelay) Status(_ context.Context) error {
+	return nil
+}
+
+func (r *testRelay) headerRequests() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.headers
+}
+
+func (r *testRelay) submissions() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.submitted
+}
+
+func testSignedBid(t *testing.T, parentHash, blockHash [32]byte, gwei int64, validSignature bool) builder.SignedBid {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	value := new(big.Int).Mul(big.NewInt(gwei), big.NewInt(1e9))
+	bid := &ethpb.BuilderBidCapella{
+		Header: &v1.ExecutionPayloadHeaderCapella{
+			ParentHash:       parentHash[:],
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        blockHash[:],
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		Value:  bytesutil.PadTo(bytesutil.ReverseByteOrder(value.Bytes()), 32),
+		Pubkey: sk.PublicKey().Marshal(),
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, d)
+	require.NoError(t, err)
+	if !validSignature {
+		sr[0] ^= 0xff
+	}
+	signed, err := builder.WrappedSignedBuilderBidCapella(&ethpb.SignedBuilderBidCapella{
+		Message:   bid,
+		Signature: sk.Sign(sr[:]).Marshal(),
+	})
+	require.NoError(t, err)
+	return signed
+}
+
+func testBlindedBlock(t *testing.T, slot primitives.Slot, blockHash [32]byte) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBlindedBeaconBlockCapella()
+	b.Block.Slot = slot
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = blockHash[:]
+	sb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return sb
+}
+
+// waitForSubmissions waits for the submissions still in flight after the first payload was revealed.
+func waitForSubmissions(t *testing.T, done func() bool) {
+	for i := 0; i < 100; i++ {
+		if done() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Blinded block was not submitted to the expected relays")
+}
+
+func bidValue(t *testing.T, signed builder.SignedBid) primitives.Gwei {
+	bid, err := signed.Message()
+	require.NoError(t, err)
+	return primitives.WeiToGwei(bid.Value())
+}
+
+func TestService_GetHeader_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'p'}
+	low, high := [32]byte{'l'}, [32]byte{'h'}
+	lowRelay := &testRelay{url: "https://0xaa@low.relay", bid: testSignedBid(t, parentHash, low, 1, true)}
+	highRelay := &testRelay{url: "https://high.relay", bid: testSignedBid(t, parentHash, high, 3, true)}
+	sameBlockRelay := &testRelay{url: "https://same.relay", bid: testSignedBid(t, parentHash, high, 3, true)}
+	badSignatureRelay := &testRelay{url: "https://bad-signature.relay", bid: testSignedBid(t, parentHash, [32]byte{'b'}, 10, false)}
+	wrongParentRelay := &testRelay{url: "https://wrong-parent.relay", bid: testSignedBid(t, [32]byte{'x'}, [32]byte{'w'}, 10, true)}
+	slowRelay := &testRelay{url: "https://slow.relay", bid: testSignedBid(t, parentHash, [32]byte{'s'}, 10, true), delay: time.Second}
+	failingRelay := &testRelay{url: "https://failing.relay", err: errors.New("no bid")}
+	relays := []*testRelay{lowRelay, highRelay, sameBlockRelay, badSignatureRelay, wrongParentRelay, slowRelay, failingRelay}
+
+	opts := []Option{WithRelayTimeout(100 * time.Millisecond)}
+	for _, r := range relays {
+		opts = append(opts, WithBuilderClient(r))
+	}
+	s, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	require.Equal(t, len(relays), len(s.relays))
+	assert.Equal(t, "low.relay", s.relays[0].name, "relay names should not contain credentials")
+
+	signed, err := s.GetHeader(ctx, 10, parentHash, [48]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Gwei(3), bidValue(t, signed))
+	assert.DeepEqual(t, []string{"high.relay", "same.relay"}, s.BidRelays(10, high))
+	assert.DeepEqual(t, []string{}, s.BidRelays(10, low))
+
+	// The blinded block is only submitted to the relays which offered its payload.
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 10, high))
+	require.NoError(t, err)
+	waitForSubmissions(t, func() bool {
+		return highRelay.submissions() == 1 && sameBlockRelay.submissions() == 1
+	})
+	for _, r := range []*testRelay{lowRelay, badSignatureRelay, wrongParentRelay, slowRelay, failingRelay} {
+		assert.Equal(t, 0, r.submissions(), r.url)
+	}
+
+	// A block with an unknown payload is submitted to all the relays.
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, 11, [32]byte{'u'}))
+	require.NoError(t, err)
+	waitForSubmissions(t, func() bool {
+		return lowRelay.submissions() == 1 && failingRelay.submissions() == 1 && slowRelay.submissions() == 1
+	})
+}
+
+func TestService_GetHeader_NoValidBid(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'p'}
+	s, err := NewService(ctx,
+		WithBuilderClient(&testRelay{url: "https://failing.relay", err: errors.New("no bid")}),
+		WithBuilderClient(&testRelay{url: "https://nil.relay"}),
+		WithBuilderClient(&testRelay{url: "https://bad-signature.relay", bid: testSignedBid(t, parentHash, [32]byte{'b'}, 1, false)}),
+	)
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 10, parentHash, [48]byte{})
+	require.ErrorContains(t, "none of the 3 relays offered a valid bid", err)
+
+	single, err := NewService(ctx, WithBuilderClient(&testRelay{url: "https://failing.relay", err: errors.New("no bid")}))
+	require.NoError(t, err)
+	_, err = single.GetHeader(ctx, 10, parentHash, [48]byte{})
+	require.ErrorContains(t, "no bid", err)
+}
+
+func TestService_RelayNames(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx,
+		WithBuilderClient(&testRelay{url: "https://0xaa@shared.relay/mainnet/"}),
+		WithBuilderClient(&testRelay{url: "https://0xbb@shared.relay/holesky"}),
+		WithBuilderClient(&testRelay{url: "https://shared.relay"}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(s.relays))
+	assert.Equal(t, "shared.relay/mainnet", s.relays[0].name)
+	assert.Equal(t, "shared.relay/holesky", s.relays[1].name)
+	assert.Equal(t, "shared.relay", s.relays[2].name)
+
+	_, err = NewService(ctx,
+		WithBuilderClient(&testRelay{url: "https://0xaa@shared.relay/mainnet"}),
+		WithBuilderClient(&testRelay{url: "https://0xbb@shared.relay/mainnet/"}),
+	)
+	require.ErrorContains(t, "duplicate builder relay shared.relay/mainnet", err)
+}
+
+func TestService_RegisterValidator_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	headFetcher := &blockchainTesting.ChainService{}
+	working := &testRelay{url: "https://working.relay"}
+	failing := &testRelay{url: "https://failing.relay", err: errors.New("unavailable")}
+	s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(headFetcher), WithBuilderClient(working), WithBuilderClient(failing))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	var feeRecipient [20]byte
+	reg := []*ethpb.SignedValidatorRegistrationV1{{Message: &ethpb.ValidatorRegistrationV1{Pubkey: pubkey[:], FeeRecipient: feeRecipient[:]}}}
+	require.NoError(t, s.RegisterValidator(ctx, reg))
+	assert.Equal(t, 1, working.regs)
+	assert.Equal(t, 1, failing.regs)
+
+	otherFailing := &testRelay{url: "https://other-failing.relay", err: errors.New("unavailable")}
+	s, err = NewService(ctx, WithRegistrationCache(), WithHeadFetcher(headFetcher), WithBuilderClient(failing), WithBuilderClient(otherFailing))
+	require.NoError(t, err)
+	require.ErrorContains(t, "unavailable", s.RegisterValidator(ctx, reg))
+}
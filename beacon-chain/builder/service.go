@@ -3,6 +3,7 @@ package builder
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,20 +30,26 @@ type BlockBuilder interface {
 	GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error)
 	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	BidRelays(slot primitives.Slot, blockHash [32]byte) []string
 	Configured() bool
 }
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients []builder.BuilderClient
+	relayTimeout   time.Duration
+	// relayBlacklistPeriod is how long misbehaving relays are blacklisted, 0 disabling the relay circuit breaker.
+	relayBlacklistPeriod time.Duration
+	beaconDB             db.HeadAccessDatabase
+	headFetcher          blockchain.HeadFetcher
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []*relay
+	bidRelays         map[primitives.Slot]map[[32]byte][]*relay
+	bidRelaysLock     sync.Mutex
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
@@ -52,27 +59,40 @@ type Service struct {
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:       ctx,
+		cancel:    cancel,
+		cfg:       &config{},
+		bidRelays: make(map[primitives.Slot]map[[32]byte][]*relay),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	names := make(map[string]bool, len(s.cfg.builderClients))
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		r := newRelay(c)
+		if names[r.name] {
+			cancel()
+			return nil, errors.Errorf("duplicate builder relay %s", r.name)
+		}
+		names[r.name] = true
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("relay", r.name).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", r.name).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -87,7 +107,8 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the relays which offered its payload, or to all the
+// relays if the bid is unknown, and returns the first payload revealed.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -95,14 +116,16 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return nil, nil, ErrNoBuilder
 	}
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	relays, offered := s.relaysForBlock(b)
+	return s.submitToRelays(ctx, relays, offered, b)
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// GetHeader retrieves the header for a given slot and parent hash from all the relays in parallel and
+// returns the highest valid bid.
 func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -110,27 +133,40 @@ func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHas
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	bids, err := s.requestBids(ctx, slot, parentHash, pubKey)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	best := bestBid(bids)
+	s.recordBidRelays(slot, best, bids)
+	if len(s.relays) > 1 {
+		log.WithFields(log.Fields{
+			"slot":      slot,
+			"relay":     best.relay.name,
+			"gweiValue": primitives.WeiToGwei(best.value),
+			"bids":      len(bids),
+		}).Debug("Selected highest relay bid")
+	}
+	return best.signed, nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if !s.Configured() {
 		return nil
 	}
 
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
+// RegisterValidator registers a validator with all the relays of the builder relay network.
 // It also saves the registration object to the DB.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
@@ -139,7 +175,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return ErrNoBuilder
 	}
 
@@ -164,7 +200,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := registerWithRelays(ctx, s.relays, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -193,7 +229,7 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -202,9 +238,10 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.client.Status(ctx); err != nil {
+					relayFailures.WithLabelValues(r.name, "status").Inc()
+					log.WithError(err).WithField("relay", r.name).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
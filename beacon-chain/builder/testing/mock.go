@@ -36,6 +36,7 @@ type MockBuilderService struct {
 	RegistrationCache     *cache.RegistrationCache
 	ErrGetHeader          error
 	ErrRegisterValidator  error
+	Relays                []string
 	Cfg                   *Config
 }
 
@@ -85,6 +86,11 @@ func (s *MockBuilderService) GetHeader(_ context.Context, slot primitives.Slot,
 	return w, s.ErrGetHeader
 }
 
+// BidRelays for mocking.
+func (s *MockBuilderService) BidRelays(primitives.Slot, [32]byte) []string {
+	return s.Relays
+}
+
 // RegistrationByValidatorID returns either the values from the cache or db.
 func (s *MockBuilderService) RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error) {
 	if s.RegistrationCache != nil {
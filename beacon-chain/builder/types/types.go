@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// PayloadSource is the origin of the execution payload of a proposed block.
+type PayloadSource string
+
+const (
+	LocalSource   PayloadSource = "local"
+	BuilderSource PayloadSource = "builder"
+)
+
+// DeliveryStatus tracks whether the relays revealed the payload of a blinded block.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending is the status of a builder payload whose blinded block was not submitted yet.
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+	DeliveryTimeout   DeliveryStatus = "timeout"
+)
+
+// PayloadDecision records why the local payload or the builder bid was chosen for a proposal, so
+// that relay behavior and missed payloads can be audited.
+type PayloadDecision struct {
+	Slot                 primitives.Slot           `json:"slot"`
+	ProposerIndex        primitives.ValidatorIndex `json:"proposer_index"`
+	Relays               []string                  `json:"relays,omitempty"`
+	BuilderBlockHash     hexutil.Bytes             `json:"builder_block_hash,omitempty"`
+	BuilderValueGwei     primitives.Gwei           `json:"builder_value_gwei"`
+	LocalValueGwei       primitives.Gwei           `json:"local_value_gwei"`
+	BuilderBoostFactor   primitives.Gwei           `json:"builder_boost_factor"`
+	LocalBoostPercentage uint64                    `json:"local_boost_percentage"`
+	Source               PayloadSource             `json:"source"`
+	Reason               string                    `json:"reason"`
+	Delivery             DeliveryStatus            `json:"delivery,omitempty"`
+	DeliveryError        string                    `json:"delivery_error,omitempty"`
+}
+
+// UseLocal records that the local payload was chosen. It is a no-op on a nil decision.
+func (d *PayloadDecision) UseLocal(reason string) {
+	if d == nil {
+		return
+	}
+	d.Source = LocalSource
+	d.Reason = reason
+	d.Delivery = ""
+}
+
+// UseBuilder records that the builder bid was chosen, its payload being delivered once the blinded
+// block is submitted. It is a no-op on a nil decision.
+func (d *PayloadDecision) UseBuilder(reason string) {
+	if d == nil {
+		return
+	}
+	d.Source = BuilderSource
+	d.Reason = reason
+	d.Delivery = DeliveryPending
+}
@@ -31,6 +31,9 @@ const (
 	LightClientFinalityUpdate
 	// LightClientOptimisticUpdate event
 	LightClientOptimisticUpdate
+	// LateBlockReorgDecision is sent when the node decides to orphan, or not, a head block which
+	// arrived late. Its data is the decision, with the inputs it was taken from.
+	LateBlockReorgDecision
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -10,7 +10,9 @@ import (
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 
 	"github.com/ethereum/go-ethereum/common"
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -58,6 +60,10 @@ type ReadOnlyDatabase interface {
 	// Fee recipients operations.
 	FeeRecipientByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (common.Address, error)
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	// Payload decisions operations.
+	PayloadDecision(ctx context.Context, slot primitives.Slot) (*buildertypes.PayloadDecision, error)
+	// Validator monitor operations.
+	ValidatorPerformances(ctx context.Context, idx primitives.ValidatorIndex, fromEpoch, toEpoch primitives.Epoch) ([]*monitortypes.EpochPerformance, error)
 	// light client operations
 	LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) (map[uint64]*ethpbv2.LightClientUpdateWithVersion, error)
 	LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdateWithVersion, error)
@@ -97,6 +103,12 @@ type NoHeadAccessDatabase interface {
 	// Fee recipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	// Payload decisions operations.
+	SavePayloadDecision(ctx context.Context, decision *buildertypes.PayloadDecision) error
+	DeletePayloadDecisionsBefore(ctx context.Context, slot primitives.Slot) error
+	// Validator monitor operations.
+	SaveValidatorPerformances(ctx context.Context, performances []*monitortypes.EpochPerformance) error
+	DeleteValidatorPerformancesBefore(ctx context.Context, epoch primitives.Epoch) error
 	// light client operations
 	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdateWithVersion) error
 
@@ -138,6 +150,9 @@ type SlasherDatabase interface {
 	SaveBlockProposals(
 		ctx context.Context, proposal []*slashertypes.SignedBlockHeaderWrapper,
 	) error
+	SaveDetectedSlashings(
+		ctx context.Context, slashings []*slashertypes.DetectedSlashing,
+	) error
 	LastEpochWrittenForValidators(
 		ctx context.Context, validatorIndices []primitives.ValidatorIndex,
 	) ([]*slashertypes.AttestedEpochForValidator, error)
@@ -162,10 +177,16 @@ type SlasherDatabase interface {
 	PruneProposalsAtEpoch(
 		ctx context.Context, maxEpoch primitives.Epoch,
 	) (numPruned uint, err error)
+	PruneDetectedSlashingsAtEpoch(
+		ctx context.Context, maxEpoch primitives.Epoch,
+	) (numPruned uint, err error)
 	HighestAttestations(
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	DetectedSlashings(
+		ctx context.Context, startKey []byte, limit int,
+	) ([]*slashertypes.DetectedSlashing, []byte, error)
 	DatabasePath() string
 	ClearDB() error
 	Migrate(ctx context.Context, headEpoch, maxPruningEpoch primitives.Epoch, batchSize int) error
@@ -119,6 +119,8 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+	payloadDecisionsBucket,
+	validatorMonitorBucket,
 }
 
 // KVStoreOption is a functional option that modifies a kv.Store.
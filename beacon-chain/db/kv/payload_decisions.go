@@ -0,0 +1,99 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PayloadDecision retrieves the payload decision of the proposal at the given slot.
+func (s *Store) PayloadDecision(ctx context.Context, slot primitives.Slot) (*buildertypes.PayloadDecision, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PayloadDecision")
+	defer span.End()
+
+	var enc []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc = bytesutil.SafeCopyBytes(tx.Bucket(payloadDecisionsBucket).Get(bytesutil.SlotToBytesBigEndian(slot)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		return nil, errors.Wrapf(ErrNotFound, "payload decision for slot %d", slot)
+	}
+	pb := &dbval.PayloadDecision{}
+	if err := decode(ctx, enc, pb); err != nil {
+		return nil, errors.Wrapf(err, "could not decode payload decision for slot %d", slot)
+	}
+	return payloadDecisionFromProto(pb), nil
+}
+
+// SavePayloadDecision saves the payload decision of a proposal, replacing any decision previously
+// saved for its slot.
+func (s *Store) SavePayloadDecision(ctx context.Context, decision *buildertypes.PayloadDecision) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePayloadDecision")
+	defer span.End()
+
+	if decision == nil {
+		return errors.New("nil payload decision")
+	}
+	enc, err := encode(ctx, payloadDecisionToProto(decision))
+	if err != nil {
+		return errors.Wrap(err, "could not encode payload decision")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(payloadDecisionsBucket).Put(bytesutil.SlotToBytesBigEndian(decision.Slot), enc)
+	})
+}
+
+// DeletePayloadDecisionsBefore deletes the payload decisions of the proposals before the given slot.
+func (s *Store) DeletePayloadDecisionsBefore(ctx context.Context, slot primitives.Slot) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeletePayloadDecisionsBefore")
+	defer span.End()
+
+	maxKey := bytesutil.SlotToBytesBigEndian(slot)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return deleteKeysBefore(tx.Bucket(payloadDecisionsBucket), maxKey)
+	})
+}
+
+func payloadDecisionToProto(d *buildertypes.PayloadDecision) *dbval.PayloadDecision {
+	return &dbval.PayloadDecision{
+		Slot:                 uint64(d.Slot),
+		ProposerIndex:        uint64(d.ProposerIndex),
+		Relays:               d.Relays,
+		BuilderBlockHash:     d.BuilderBlockHash,
+		BuilderValueGwei:     uint64(d.BuilderValueGwei),
+		LocalValueGwei:       uint64(d.LocalValueGwei),
+		BuilderBoostFactor:   uint64(d.BuilderBoostFactor),
+		LocalBoostPercentage: d.LocalBoostPercentage,
+		Source:               string(d.Source),
+		Reason:               d.Reason,
+		Delivery:             string(d.Delivery),
+		DeliveryError:        d.DeliveryError,
+	}
+}
+
+func payloadDecisionFromProto(pb *dbval.PayloadDecision) *buildertypes.PayloadDecision {
+	return &buildertypes.PayloadDecision{
+		Slot:                 primitives.Slot(pb.Slot),
+		ProposerIndex:        primitives.ValidatorIndex(pb.ProposerIndex),
+		Relays:               pb.Relays,
+		BuilderBlockHash:     pb.BuilderBlockHash,
+		BuilderValueGwei:     primitives.Gwei(pb.BuilderValueGwei),
+		LocalValueGwei:       primitives.Gwei(pb.LocalValueGwei),
+		BuilderBoostFactor:   primitives.Gwei(pb.BuilderBoostFactor),
+		LocalBoostPercentage: pb.LocalBoostPercentage,
+		Source:               buildertypes.PayloadSource(pb.Source),
+		Reason:               pb.Reason,
+		Delivery:             buildertypes.DeliveryStatus(pb.Delivery),
+		DeliveryError:        pb.DeliveryError,
+	}
+}
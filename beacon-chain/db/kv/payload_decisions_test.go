@@ -0,0 +1,63 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_PayloadDecision(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	require.ErrorContains(t, "nil payload decision", db.SavePayloadDecision(ctx, nil))
+
+	_, err := db.PayloadDecision(ctx, 10)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	decision := &buildertypes.PayloadDecision{
+		Slot:               10,
+		ProposerIndex:      3,
+		Relays:             []string{"relay.example"},
+		BuilderBlockHash:   []byte{'a'},
+		BuilderValueGwei:   200,
+		LocalValueGwei:     100,
+		BuilderBoostFactor: 100,
+	}
+	decision.UseBuilder("builder payload value is higher")
+	require.NoError(t, db.SavePayloadDecision(ctx, decision))
+	saved, err := db.PayloadDecision(ctx, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, decision, saved)
+
+	// The delivery of the payload updates the decision of the slot.
+	decision.Delivery = buildertypes.DeliveryTimeout
+	decision.DeliveryError = "context deadline exceeded"
+	require.NoError(t, db.SavePayloadDecision(ctx, decision))
+	saved, err = db.PayloadDecision(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, buildertypes.DeliveryTimeout, saved.Delivery)
+	assert.Equal(t, "context deadline exceeded", saved.DeliveryError)
+}
+
+func TestStore_DeletePayloadDecisionsBefore(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	for _, slot := range []primitives.Slot{1, 2, 3, 256, 257} {
+		require.NoError(t, db.SavePayloadDecision(ctx, &buildertypes.PayloadDecision{Slot: slot}))
+	}
+
+	require.NoError(t, db.DeletePayloadDecisionsBefore(ctx, 256))
+	for _, slot := range []primitives.Slot{1, 2, 3} {
+		_, err := db.PayloadDecision(ctx, slot)
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+	for _, slot := range []primitives.Slot{256, 257} {
+		saved, err := db.PayloadDecision(ctx, slot)
+		require.NoError(t, err)
+		assert.Equal(t, slot, saved.Slot)
+	}
+}
@@ -17,6 +17,13 @@ var (
 	feeRecipientBucket    = []byte("fee-recipient")
 	registrationBucket    = []byte("registration")
 
+	// Payload decisions of the proposals, indexed by slot.
+	payloadDecisionsBucket = []byte("payload-decisions")
+
+	// Epoch performances of the validators tracked by the validator monitor, indexed by validator
+	// index and epoch.
+	validatorMonitorBucket = []byte("validator-monitor")
+
 	// Light Client Updates Bucket
 	lightClientUpdatesBucket = []byte("light-client-updates")
 
@@ -104,6 +104,18 @@ func deleteValueForIndices(ctx context.Context, indicesByBucket map[string][]byt
 
 var errMisalignedRootList = errors.New("incorrectly packed root list, length is not a multiple of 32")
 
+// deleteKeysBefore deletes the keys of the bucket lower than maxKey.
+func deleteKeysBefore(bkt *bolt.Bucket, maxKey []byte) error {
+	c := bkt.Cursor()
+	// Deleting with the cursor moves it to the next key, hence the lookup of the first key again.
+	for k, _ := c.First(); k != nil && bytes.Compare(k, maxKey) < 0; k, _ = c.First() {
+		if err := c.Delete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func splitRoots(b []byte) ([][32]byte, error) {
 	rl := make([][32]byte, 0)
 	if len(b)%32 != 0 {
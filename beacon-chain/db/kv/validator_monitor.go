@@ -0,0 +1,158 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ValidatorPerformances retrieves the epoch performances of a validator tracked by the validator
+// monitor, from fromEpoch to toEpoch included, sorted by epoch.
+func (s *Store) ValidatorPerformances(
+	ctx context.Context, idx primitives.ValidatorIndex, fromEpoch, toEpoch primitives.Epoch,
+) ([]*monitortypes.EpochPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ValidatorPerformances")
+	defer span.End()
+
+	performances := make([]*monitortypes.EpochPerformance, 0)
+	if fromEpoch > toEpoch {
+		return performances, nil
+	}
+	encs := make([][]byte, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		// The keys are sorted by epoch then validator index, so the cursor seeks the key of the
+		// validator in each epoch which has performances.
+		c := tx.Bucket(validatorMonitorBucket).Cursor()
+		for k, v := c.Seek(validatorPerformanceKey(fromEpoch, idx)); k != nil; {
+			epoch := bytesutil.BytesToEpochBigEndian(k[:8])
+			if epoch > toEpoch {
+				break
+			}
+			keyIdx := primitives.ValidatorIndex(bytesutil.BytesToUint64BigEndian(k[8:]))
+			if keyIdx < idx {
+				k, v = c.Seek(validatorPerformanceKey(epoch, idx))
+				continue
+			}
+			if keyIdx == idx {
+				encs = append(encs, bytesutil.SafeCopyBytes(v))
+			}
+			if epoch == toEpoch {
+				break
+			}
+			k, v = c.Seek(validatorPerformanceKey(epoch+1, idx))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, enc := range encs {
+		pb := &dbval.ValidatorPerformance{}
+		if err := decode(ctx, enc, pb); err != nil {
+			return nil, errors.Wrapf(err, "could not decode performance of validator %d", idx)
+		}
+		performances = append(performances, validatorPerformanceFromProto(pb))
+	}
+	return performances, nil
+}
+
+// SaveValidatorPerformances saves the epoch performances of validators tracked by the validator
+// monitor, replacing any performance previously saved for the same validator and epoch.
+func (s *Store) SaveValidatorPerformances(ctx context.Context, performances []*monitortypes.EpochPerformance) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorPerformances")
+	defer span.End()
+
+	keys := make([][]byte, len(performances))
+	encs := make([][]byte, len(performances))
+	for i, p := range performances {
+		if p == nil {
+			return errors.New("nil validator performance")
+		}
+		enc, err := encode(ctx, validatorPerformanceToProto(p))
+		if err != nil {
+			return errors.Wrap(err, "could not encode validator performance")
+		}
+		keys[i] = validatorPerformanceKey(p.Epoch, p.ValidatorIndex)
+		encs[i] = enc
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorMonitorBucket)
+		for i := range keys {
+			if err := bkt.Put(keys[i], encs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteValidatorPerformancesBefore deletes the epoch performances of all validators before the
+// given epoch.
+func (s *Store) DeleteValidatorPerformancesBefore(ctx context.Context, epoch primitives.Epoch) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteValidatorPerformancesBefore")
+	defer span.End()
+
+	maxKey := bytesutil.EpochToBytesBigEndian(epoch)
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return deleteKeysBefore(tx.Bucket(validatorMonitorBucket), maxKey)
+	})
+}
+
+// validatorPerformanceKey is the epoch followed by the validator index, so that the performances of
+// an epoch are contiguous and the old epochs are deleted with a cursor range.
+func validatorPerformanceKey(epoch primitives.Epoch, idx primitives.ValidatorIndex) []byte {
+	return append(bytesutil.EpochToBytesBigEndian(epoch), bytesutil.Uint64ToBytesBigEndian(uint64(idx))...)
+}
+
+func validatorPerformanceToProto(p *monitortypes.EpochPerformance) *dbval.ValidatorPerformance {
+	proposedSlots := make([]uint64, len(p.ProposedSlots))
+	for i, slot := range p.ProposedSlots {
+		proposedSlots[i] = uint64(slot)
+	}
+	return &dbval.ValidatorPerformance{
+		ValidatorIndex:        uint64(p.ValidatorIndex),
+		Epoch:                 uint64(p.Epoch),
+		Attested:              p.Attested,
+		AttestedSlot:          uint64(p.AttestedSlot),
+		InclusionSlot:         uint64(p.InclusionSlot),
+		CorrectSource:         p.CorrectSource,
+		CorrectTarget:         p.CorrectTarget,
+		CorrectHead:           p.CorrectHead,
+		ProposedSlots:         proposedSlots,
+		SyncCommitteeExpected: p.SyncCommitteeExpected,
+		SyncCommitteeIncluded: p.SyncCommitteeIncluded,
+		Balance:               p.Balance,
+		BalanceChange:         p.BalanceChange,
+	}
+}
+
+func validatorPerformanceFromProto(pb *dbval.ValidatorPerformance) *monitortypes.EpochPerformance {
+	var proposedSlots []primitives.Slot
+	if len(pb.ProposedSlots) > 0 {
+		proposedSlots = make([]primitives.Slot, len(pb.ProposedSlots))
+		for i, slot := range pb.ProposedSlots {
+			proposedSlots[i] = primitives.Slot(slot)
+		}
+	}
+	return &monitortypes.EpochPerformance{
+		ValidatorIndex:        primitives.ValidatorIndex(pb.ValidatorIndex),
+		Epoch:                 primitives.Epoch(pb.Epoch),
+		Attested:              pb.Attested,
+		AttestedSlot:          primitives.Slot(pb.AttestedSlot),
+		InclusionSlot:         primitives.Slot(pb.InclusionSlot),
+		CorrectSource:         pb.CorrectSource,
+		CorrectTarget:         pb.CorrectTarget,
+		CorrectHead:           pb.CorrectHead,
+		ProposedSlots:         proposedSlots,
+		SyncCommitteeExpected: pb.SyncCommitteeExpected,
+		SyncCommitteeIncluded: pb.SyncCommitteeIncluded,
+		Balance:               pb.Balance,
+		BalanceChange:         pb.BalanceChange,
+	}
+}
@@ -0,0 +1,61 @@
+package kv
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_ValidatorPerformances(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	require.ErrorContains(t, "nil validator performance", db.SaveValidatorPerformances(ctx, []*monitortypes.EpochPerformance{nil}))
+
+	performances := make([]*monitortypes.EpochPerformance, 0)
+	for _, idx := range []primitives.ValidatorIndex{1, 2, 256} {
+		for epoch := primitives.Epoch(0); epoch < 5; epoch++ {
+			performances = append(performances, &monitortypes.EpochPerformance{
+				ValidatorIndex: idx,
+				Epoch:          epoch,
+				Attested:       true,
+				AttestedSlot:   primitives.Slot(epoch * 32),
+				InclusionSlot:  primitives.Slot(epoch*32 + 1),
+				CorrectTarget:  true,
+				ProposedSlots:  []primitives.Slot{primitives.Slot(epoch*32 + 2)},
+				Balance:        32000000000,
+				BalanceChange:  -10,
+			})
+		}
+	}
+	require.NoError(t, db.SaveValidatorPerformances(ctx, performances))
+
+	saved, err := db.ValidatorPerformances(ctx, 2, 1, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, performances[6:9], saved)
+	saved, err = db.ValidatorPerformances(ctx, 256, 0, 100)
+	require.NoError(t, err)
+	assert.DeepEqual(t, performances[10:], saved)
+	saved, err = db.ValidatorPerformances(ctx, 1, 2, math.MaxUint64)
+	require.NoError(t, err)
+	assert.DeepEqual(t, performances[2:5], saved)
+	saved, err = db.ValidatorPerformances(ctx, 3, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+	saved, err = db.ValidatorPerformances(ctx, 1, 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+
+	require.NoError(t, db.DeleteValidatorPerformancesBefore(ctx, 3))
+	for _, idx := range []primitives.ValidatorIndex{1, 2, 256} {
+		saved, err = db.ValidatorPerformances(ctx, idx, 0, 100)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(saved))
+		assert.Equal(t, primitives.Epoch(3), saved[0].Epoch)
+		assert.Equal(t, primitives.Epoch(4), saved[1].Epoch)
+	}
+}
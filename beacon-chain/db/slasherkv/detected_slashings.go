@@ -0,0 +1,201 @@
+package slasherkv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Kinds of the slashings stored in the detected slashings bucket.
+const (
+	proposerSlashingKind byte = iota
+	attesterSlashingKind
+	attesterSlashingElectraKind
+)
+
+// SaveDetectedSlashings saves the slashings detected by the slasher, keyed by the epoch of the
+// offense and the slashing root. A slashing which is already saved keeps its first detection time.
+func (s *Store) SaveDetectedSlashings(ctx context.Context, slashings []*slashertypes.DetectedSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveDetectedSlashings")
+	defer span.End()
+
+	encodedKeys := make([][]byte, len(slashings))
+	encodedSlashings := make([][]byte, len(slashings))
+	for i, slashing := range slashings {
+		key, enc, err := encodeDetectedSlashing(slashing)
+		if err != nil {
+			return err
+		}
+		encodedKeys[i] = key
+		encodedSlashings[i] = enc
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(detectedSlashingsBucket)
+		for i := range slashings {
+			if bkt.Get(encodedKeys[i]) != nil {
+				continue
+			}
+			if err := bkt.Put(encodedKeys[i], encodedSlashings[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DetectedSlashings retrieves at most limit slashings detected by the slasher, sorted by the epoch of
+// the offense, starting from the slashing with the given key or from the first slashing if the key is
+// empty. It also returns the key of the slashing following the last one retrieved, which is empty if
+// there is none.
+func (s *Store) DetectedSlashings(
+	ctx context.Context, startKey []byte, limit int,
+) ([]*slashertypes.DetectedSlashing, []byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DetectedSlashings")
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, nil, errors.New("limit must be positive")
+	}
+	slashings := make([]*slashertypes.DetectedSlashing, 0)
+	var nextKey []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(detectedSlashingsBucket).Cursor()
+		k, v := c.First()
+		if len(startKey) > 0 {
+			k, v = c.Seek(startKey)
+		}
+		for ; k != nil; k, v = c.Next() {
+			if len(slashings) == limit {
+				nextKey = bytesutil.SafeCopyBytes(k)
+				return nil
+			}
+			slashing, err := decodeDetectedSlashing(k, v)
+			if err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return slashings, nextKey, nil
+}
+
+// PruneDetectedSlashingsAtEpoch deletes all detected slashings from the slasher DB with an offense
+// epoch less than or equal to the specified epoch.
+func (s *Store) PruneDetectedSlashingsAtEpoch(
+	ctx context.Context, maxEpoch primitives.Epoch,
+) (numPruned uint, err error) {
+	encodedEndPruneEpoch := make([]byte, 8)
+	binary.BigEndian.PutUint64(encodedEndPruneEpoch, uint64(maxEpoch))
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		numPruned, err = deleteKeysUpToEpoch(ctx, tx.Bucket(detectedSlashingsBucket), encodedEndPruneEpoch)
+		slasherDetectedSlashingsPrunedTotal.Add(float64(numPruned))
+		return err
+	})
+	return
+}
+
+// Encodes a detected slashing into its key, the epoch of the offense concatenated with the
+// slashing root, and its value, the slashing kind concatenated with the detection time and the
+// compressed slashing.
+func encodeDetectedSlashing(slashing *slashertypes.DetectedSlashing) ([]byte, []byte, error) {
+	if slashing == nil {
+		return nil, nil, errors.New("nil detected slashing")
+	}
+
+	var (
+		kind      byte
+		epoch     primitives.Epoch
+		marshaler interface {
+			MarshalSSZ() ([]byte, error)
+			HashTreeRoot() ([32]byte, error)
+		}
+	)
+	switch {
+	case slashing.ProposerSlashing != nil:
+		kind, marshaler = proposerSlashingKind, slashing.ProposerSlashing
+		epoch = slots.ToEpoch(slashing.ProposerSlashing.Header_1.Header.Slot)
+	case slashing.AttesterSlashing != nil:
+		kind, marshaler = attesterSlashingKind, slashing.AttesterSlashing
+		if slashing.AttesterSlashing.Version() >= version.Electra {
+			kind = attesterSlashingElectraKind
+		}
+		epoch = max(
+			slashing.AttesterSlashing.FirstAttestation().GetData().Target.Epoch,
+			slashing.AttesterSlashing.SecondAttestation().GetData().Target.Epoch,
+		)
+	default:
+		return nil, nil, errors.New("detected slashing without any slashing")
+	}
+
+	root, err := marshaler.HashTreeRoot()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not hash tree root slashing")
+	}
+	encodedSlashing, err := marshaler.MarshalSSZ()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not marshal slashing")
+	}
+
+	key := make([]byte, 8, 8+rootSize)
+	binary.BigEndian.PutUint64(key, uint64(epoch))
+	key = append(key, root[:]...)
+
+	value := make([]byte, 9, 9+len(encodedSlashing))
+	value[0] = kind
+	binary.BigEndian.PutUint64(value[1:], uint64(slashing.DetectedAt.UnixNano()))
+	return key, append(value, snappy.Encode(nil, encodedSlashing)...), nil
+}
+
+// Decodes a detected slashing from its key and value.
+func decodeDetectedSlashing(key, encoded []byte) (*slashertypes.DetectedSlashing, error) {
+	if len(key) != 8+rootSize {
+		return nil, fmt.Errorf("wrong length for detected slashing key, want %d, got %d", 8+rootSize, len(key))
+	}
+	if len(encoded) < 9 {
+		return nil, fmt.Errorf("wrong length for detected slashing, want at least 9, got %d", len(encoded))
+	}
+
+	decodedBytes, err := snappy.Decode(nil, encoded[9:])
+	if err != nil {
+		return nil, err
+	}
+
+	slashing := &slashertypes.DetectedSlashing{
+		DetectedAt: time.Unix(0, int64(binary.BigEndian.Uint64(encoded[1:9]))),
+	}
+	switch encoded[0] {
+	case proposerSlashingKind:
+		slashing.ProposerSlashing = &ethpb.ProposerSlashing{}
+		err = slashing.ProposerSlashing.UnmarshalSSZ(decodedBytes)
+	case attesterSlashingKind:
+		slashing.AttesterSlashing = &ethpb.AttesterSlashing{}
+		err = slashing.AttesterSlashing.UnmarshalSSZ(decodedBytes)
+	case attesterSlashingElectraKind:
+		slashing.AttesterSlashing = &ethpb.AttesterSlashingElectra{}
+		err = slashing.AttesterSlashing.UnmarshalSSZ(decodedBytes)
+	default:
+		return nil, fmt.Errorf("unknown detected slashing kind %d", encoded[0])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return slashing, nil
+}
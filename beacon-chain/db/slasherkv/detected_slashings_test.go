@@ -0,0 +1,122 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_DetectedSlashings_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	slashings, next, err := beaconDB.DetectedSlashings(ctx, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slashings))
+	require.Equal(t, 0, len(next))
+	_, _, err = beaconDB.DetectedSlashings(ctx, nil, 0)
+	require.ErrorContains(t, "limit must be positive", err)
+
+	detectedAt := time.Unix(1_700_000_000, 0)
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2},
+			Data:             &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 1}},
+		}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{2}}),
+	}
+	electraSlashing := &ethpb.AttesterSlashingElectra{
+		Attestation_1: &ethpb.IndexedAttestationElectra{
+			AttestingIndices: []uint64{3},
+			Data:             util.HydrateAttestationData(&ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 3}}),
+			Signature:        make([]byte, 96),
+		},
+		Attestation_2: &ethpb.IndexedAttestationElectra{
+			AttestingIndices: []uint64{3},
+			Data:             util.HydrateAttestationData(&ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{Epoch: 3}}),
+			Signature:        make([]byte, 96),
+		},
+	}
+	slot := params.BeaconConfig().SlotsPerEpoch * 2
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 4, Slot: slot}}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 4, Slot: slot, BodyRoot: make([]byte, 32)}}),
+	}
+	proposerSlashing.Header_2.Header.BodyRoot[0] = 1
+	// Saved out of order, retrieved in the order of the offense epochs.
+	want := []*slashertypes.DetectedSlashing{
+		{DetectedAt: detectedAt, AttesterSlashing: attesterSlashing},
+		{DetectedAt: detectedAt.Add(time.Second), ProposerSlashing: proposerSlashing},
+		{DetectedAt: detectedAt.Add(2 * time.Second), AttesterSlashing: electraSlashing},
+	}
+	require.NoError(t, beaconDB.SaveDetectedSlashings(ctx, []*slashertypes.DetectedSlashing{want[2], want[0]}))
+	require.NoError(t, beaconDB.SaveDetectedSlashings(ctx, []*slashertypes.DetectedSlashing{want[1]}))
+	// Saving a slashing again keeps its first detection time.
+	require.NoError(t, beaconDB.SaveDetectedSlashings(ctx, []*slashertypes.DetectedSlashing{
+		{DetectedAt: detectedAt.Add(time.Hour), AttesterSlashing: attesterSlashing},
+	}))
+
+	requireSlashings := func(want, got []*slashertypes.DetectedSlashing) {
+		require.Equal(t, len(want), len(got))
+		for i := range want {
+			require.Equal(t, true, want[i].DetectedAt.Equal(got[i].DetectedAt))
+			if want[i].ProposerSlashing != nil {
+				require.DeepSSZEqual(t, want[i].ProposerSlashing, got[i].ProposerSlashing)
+				continue
+			}
+			require.DeepSSZEqual(t, want[i].AttesterSlashing, got[i].AttesterSlashing)
+		}
+	}
+	slashings, next, err = beaconDB.DetectedSlashings(ctx, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(next))
+	requireSlashings(want, slashings)
+
+	// Paging through the slashings.
+	slashings, next, err = beaconDB.DetectedSlashings(ctx, nil, 2)
+	require.NoError(t, err)
+	requireSlashings(want[:2], slashings)
+	require.NotEqual(t, 0, len(next))
+	slashings, next, err = beaconDB.DetectedSlashings(ctx, next, 2)
+	require.NoError(t, err)
+	requireSlashings(want[2:], slashings)
+	require.Equal(t, 0, len(next))
+
+	require.ErrorContains(t, "without any slashing", beaconDB.SaveDetectedSlashings(ctx, []*slashertypes.DetectedSlashing{{DetectedAt: detectedAt}}))
+}
+
+func TestStore_PruneDetectedSlashingsAtEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	detected := make([]*slashertypes.DetectedSlashing, 0)
+	for epoch := primitives.Epoch(0); epoch < 4; epoch++ {
+		detected = append(detected, &slashertypes.DetectedSlashing{
+			DetectedAt: time.Unix(1_700_000_000, 0),
+			AttesterSlashing: &ethpb.AttesterSlashing{
+				Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+					AttestingIndices: []uint64{1},
+					Data:             &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: epoch}},
+				}),
+				Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+			},
+		})
+	}
+	require.NoError(t, beaconDB.SaveDetectedSlashings(ctx, detected))
+
+	numPruned, err := beaconDB.PruneDetectedSlashingsAtEpoch(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), numPruned)
+	slashings, _, err := beaconDB.DetectedSlashings(ctx, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(slashings))
+	require.DeepSSZEqual(t, detected[2].AttesterSlashing, slashings[0].AttesterSlashing)
+	require.DeepSSZEqual(t, detected[3].AttesterSlashing, slashings[1].AttesterSlashing)
+}
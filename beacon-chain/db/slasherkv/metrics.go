@@ -14,4 +14,8 @@ var (
 		Name: "slasher_proposals_pruned_total",
 		Help: "Total number of old proposals pruned by slasher",
 	})
+	slasherDetectedSlashingsPrunedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_detected_slashings_pruned_total",
+		Help: "Total number of old detected slashings pruned by slasher",
+	})
 )
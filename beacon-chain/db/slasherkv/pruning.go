@@ -165,3 +165,21 @@ func uint64PrefixGreaterThan(key, lessThan []byte) bool {
 	enc := key[:8]
 	return bytes.Compare(enc, lessThan) > 0
 }
+
+// deleteKeysUpToEpoch deletes the keys of the bucket prefixed with an epoch less than or equal to the
+// encoded epoch, and returns the number of deleted keys.
+func deleteKeysUpToEpoch(ctx context.Context, bkt *bolt.Bucket, encodedEpoch []byte) (uint, error) {
+	var numDeleted uint
+	c := bkt.Cursor()
+	// Deleting with the cursor moves it to the next key, hence the lookup of the first key again.
+	for k, _ := c.First(); k != nil && !uint64PrefixGreaterThan(k, encodedEpoch); k, _ = c.First() {
+		if ctx.Err() != nil {
+			return numDeleted, ctx.Err()
+		}
+		if err := c.Delete(); err != nil {
+			return numDeleted, err
+		}
+		numDeleted++
+	}
+	return numDeleted, nil
+}
@@ -24,4 +24,8 @@ var (
 	// value: (encoded) SignedBlockHeaderWrapper
 	proposalRecordsBucket = []byte("proposal-records")
 	slasherChunksBucket   = []byte("slasher-chunks")
+
+	// key: (encoded) offense epoch + slashing root
+	// value: slashing kind + (encoded) detection time + (encoded + compressed) slashing
+	detectedSlashingsBucket = []byte("detected-slashings")
 )
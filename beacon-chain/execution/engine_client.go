@@ -85,6 +85,8 @@ const (
 	GetPayloadBodiesByRangeV2 = "engine_getPayloadBodiesByRangeV2"
 	// ExchangeCapabilities request string for JSON-RPC.
 	ExchangeCapabilities = "engine_exchangeCapabilities"
+	// GetClientVersionV1 is the JSON-RPC method identifying the execution client.
+	GetClientVersionV1 = "engine_getClientVersionV1"
 	// Defines the seconds before timing out engine endpoints with non-block execution semantics.
 	defaultEngineTimeout = time.Second
 )
@@ -121,6 +123,7 @@ type EngineCaller interface {
 	GetPayload(ctx context.Context, payloadId [8]byte, slot primitives.Slot) (*blocks.GetPayloadResponse, error)
 	ExecutionBlockByHash(ctx context.Context, hash common.Hash, withTxs bool) (*pb.ExecutionBlock, error)
 	GetTerminalBlockHash(ctx context.Context, transitionTime uint64) ([]byte, bool, error)
+	GetClientVersion(ctx context.Context) ([]*pb.ClientVersionV1, error)
 }
 
 var ErrEmptyBlockHash = errors.New("Block hash is empty 0x0000...")
@@ -139,46 +142,40 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	defer cancel()
 	result := &pb.PayloadStatus{}
 
+	var method string
+	var args []interface{}
 	switch payload.Proto().(type) {
 	case *pb.ExecutionPayload:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayload)
 		if !ok {
 			return nil, errors.New("execution data must be a Bellatrix or Capella execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethod, []interface{}{payloadPb}
 	case *pb.ExecutionPayloadCapella:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayloadCapella)
 		if !ok {
 			return nil, errors.New("execution data must be a Capella execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethodV2, []interface{}{payloadPb}
 	case *pb.ExecutionPayloadDeneb:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayloadDeneb)
 		if !ok {
 			return nil, errors.New("execution data must be a Deneb execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV3, payloadPb, versionedHashes, parentBlockRoot)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethodV3, []interface{}{payloadPb, versionedHashes, parentBlockRoot}
 	case *pb.ExecutionPayloadElectra:
 		payloadPb, ok := payload.Proto().(*pb.ExecutionPayloadElectra)
 		if !ok {
 			return nil, errors.New("execution data must be a Electra execution payload")
 		}
-		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV4, payloadPb, versionedHashes, parentBlockRoot)
-		if err != nil {
-			return nil, handleRPCError(err)
-		}
+		method, args = NewPayloadMethodV4, []interface{}{payloadPb, versionedHashes, parentBlockRoot}
 	default:
 		return nil, errors.New("unknown execution data type")
 	}
+	if err := s.callContext(ctx, result, method, args...); err != nil {
+		return nil, handleRPCError(err)
+	}
+	s.shadowNewPayload(payload.BlockHash(), result.Status, method, args...)
 	if result.ValidationError != "" {
 		log.WithError(errors.New(result.ValidationError)).Error("Got a validation error in newPayload")
 	}
@@ -221,7 +218,7 @@ func (s *Service) ForkchoiceUpdated(
 		if err != nil {
 			return nil, nil, err
 		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, a)
+		err = s.callContext(ctx, result, ForkchoiceUpdatedMethod, state, a)
 		if err != nil {
 			return nil, nil, handleRPCError(err)
 		}
@@ -230,7 +227,7 @@ func (s *Service) ForkchoiceUpdated(
 		if err != nil {
 			return nil, nil, err
 		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
+		err = s.callContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
 		if err != nil {
 			return nil, nil, handleRPCError(err)
 		}
@@ -239,7 +236,7 @@ func (s *Service) ForkchoiceUpdated(
 		if err != nil {
 			return nil, nil, err
 		}
-		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
+		err = s.callContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
 		if err != nil {
 			return nil, nil, handleRPCError(err)
 		}
@@ -294,7 +291,7 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	defer cancel()
 
 	method, result := getPayloadMethodAndMessage(slot)
-	err := s.rpcClient.CallContext(ctx, result, method, pb.PayloadIDBytes(payloadId))
+	err := s.callContext(ctx, result, method, pb.PayloadIDBytes(payloadId))
 	if err != nil {
 		return nil, handleRPCError(err)
 	}
@@ -310,7 +307,7 @@ func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
 	defer span.End()
 
 	result := &pb.ExchangeCapabilities{}
-	err := s.rpcClient.CallContext(ctx, &result, ExchangeCapabilities, supportedEngineEndpoints)
+	err := s.callContext(ctx, &result, ExchangeCapabilities, supportedEngineEndpoints)
 
 	var unsupported []string
 	for _, s1 := range supportedEngineEndpoints {
@@ -331,6 +328,43 @@ func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
 	return result.SupportedMethods, handleRPCError(err)
 }
 
+// GetClientVersion calls the engine_getClientVersionV1 method via JSON-RPC, sending the version of this beacon node
+// and returning the versions of the execution client. Several versions are returned by multiplexers.
+func (s *Service) GetClientVersion(ctx context.Context) ([]*pb.ClientVersionV1, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetClientVersion")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, defaultEngineTimeout)
+	defer cancel()
+
+	var result []*pb.ClientVersionV1
+	err := s.callContext(ctx, &result, GetClientVersionV1, beaconNodeClientVersion())
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
+	if len(result) == 0 {
+		return nil, errors.New("execution client returned no client version")
+	}
+	return result, nil
+}
+
+// beaconNodeClientVersion identifies this beacon node to the execution client.
+// The commit is the first four bytes of the git commit hash, when known.
+func beaconNodeClientVersion() *pb.ClientVersionV1 {
+	commit := "0x00000000"
+	if c := version.GitCommit(); len(c) >= 8 {
+		if _, err := hexutil.Decode("0x" + c[:8]); err == nil {
+			commit = "0x" + c[:8]
+		}
+	}
+	return &pb.ClientVersionV1{
+		Code:    "PM",
+		Name:    "Prysm",
+		Version: version.SemanticVersion(),
+		Commit:  commit,
+	}
+}
+
 // GetTerminalBlockHash returns the valid terminal block hash based on total difficulty.
 //
 // Spec code:
@@ -421,7 +455,7 @@ func (s *Service) LatestExecutionBlock(ctx context.Context) (*pb.ExecutionBlock,
 	defer span.End()
 
 	result := &pb.ExecutionBlock{}
-	err := s.rpcClient.CallContext(
+	err := s.callContext(
 		ctx,
 		result,
 		BlockByNumberMethod,
@@ -437,7 +471,7 @@ func (s *Service) ExecutionBlockByHash(ctx context.Context, hash common.Hash, wi
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExecutionBlockByHash")
 	defer span.End()
 	result := &pb.ExecutionBlock{}
-	err := s.rpcClient.CallContext(ctx, result, BlockByHashMethod, hash, withTxs)
+	err := s.callContext(ctx, result, BlockByHashMethod, hash, withTxs)
 	return result, handleRPCError(err)
 }
 
@@ -463,7 +497,7 @@ func (s *Service) ExecutionBlocksByHashes(ctx context.Context, hashes []common.H
 		})
 		execBlks = append(execBlks, blk)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.batchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -478,7 +512,7 @@ func (s *Service) ExecutionBlocksByHashes(ctx context.Context, hashes []common.H
 // HeaderByHash returns the relevant header details for the provided block hash.
 func (s *Service) HeaderByHash(ctx context.Context, hash common.Hash) (*types.HeaderInfo, error) {
 	var hdr *types.HeaderInfo
-	err := s.rpcClient.CallContext(ctx, &hdr, BlockByHashMethod, hash, false /* no transactions */)
+	err := s.callContext(ctx, &hdr, BlockByHashMethod, hash, false /* no transactions */)
 	if err == nil && hdr == nil {
 		err = ethereum.NotFound
 	}
@@ -488,7 +522,7 @@ func (s *Service) HeaderByHash(ctx context.Context, hash common.Hash) (*types.He
 // HeaderByNumber returns the relevant header details for the provided block number.
 func (s *Service) HeaderByNumber(ctx context.Context, number *big.Int) (*types.HeaderInfo, error) {
 	var hdr *types.HeaderInfo
-	err := s.rpcClient.CallContext(ctx, &hdr, BlockByNumberMethod, toBlockNumArg(number), false /* no transactions */)
+	err := s.callContext(ctx, &hdr, BlockByNumberMethod, toBlockNumArg(number), false /* no transactions */)
 	if err == nil && hdr == nil {
 		err = ethereum.NotFound
 	}
@@ -515,7 +549,9 @@ func (s *Service) ReconstructFullBlock(
 func (s *Service) ReconstructFullBellatrixBlockBatch(
 	ctx context.Context, blindedBlocks []interfaces.ReadOnlySignedBeaconBlock,
 ) ([]interfaces.SignedBeaconBlock, error) {
-	unb, err := reconstructBlindedBlockBatch(ctx, s.rpcClient, blindedBlocks)
+	client, done := s.acquireRPCClient()
+	defer done()
+	unb, err := reconstructBlindedBlockBatch(ctx, client, blindedBlocks)
 	if err != nil {
 		return nil, err
 	}
@@ -27,6 +27,7 @@ import (
 	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/clientstats"
 	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -2284,3 +2285,126 @@ func Test_ExchangeCapabilities(t *testing.T) {
 		}
 	})
 }
+
+func Test_GetClientVersion(t *testing.T) {
+	t.Run("client versions", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			defer func() {
+				require.NoError(t, r.Body.Close())
+			}()
+			req := &struct {
+				Method string                `json:"method"`
+				Params []*pb.ClientVersionV1 `json:"params"`
+			}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			require.Equal(t, GetClientVersionV1, req.Method)
+			require.Equal(t, 1, len(req.Params))
+			require.Equal(t, "PM", req.Params[0].Code)
+
+			resp := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"result": []*pb.ClientVersionV1{
+					{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"},
+				},
+			}
+			err := json.NewEncoder(w).Encode(resp)
+			require.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		service := &Service{}
+		service.rpcClient = rpcClient
+
+		versions, err := service.GetClientVersion(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, len(versions))
+		require.DeepEqual(t, &pb.ClientVersionV1{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}, versions[0])
+	})
+	t.Run("empty response", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			defer func() {
+				require.NoError(t, r.Body.Close())
+			}()
+			resp := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"result":  []*pb.ClientVersionV1{},
+			}
+			err := json.NewEncoder(w).Encode(resp)
+			require.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		rpcClient, err := rpc.DialHTTP(srv.URL)
+		require.NoError(t, err)
+		service := &Service{}
+		service.rpcClient = rpcClient
+
+		_, err = service.GetClientVersion(context.Background())
+		require.ErrorContains(t, "execution client returned no client version", err)
+	})
+}
+
+type recordingStatsUpdater struct {
+	stats []clientstats.BeaconNodeStats
+}
+
+func (r *recordingStatsUpdater) Update(stats clientstats.BeaconNodeStats) {
+	r.stats = append(r.stats, stats)
+}
+
+func Test_RefreshClientVersion(t *testing.T) {
+	available := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result": []*pb.ClientVersionV1{
+				{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"},
+			},
+		}
+		if !available {
+			resp = map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"error":   map[string]interface{}{"code": -32601, "message": "method not found"},
+			}
+		}
+		err := json.NewEncoder(w).Encode(resp)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	updater := &recordingStatsUpdater{}
+	service := &Service{cfg: &config{beaconNodeStatsUpdater: updater}}
+	service.rpcClient = rpcClient
+	require.Equal(t, 0, len(service.ExecutionClientVersion()))
+
+	service.refreshClientVersion(context.Background())
+	require.DeepEqual(t, []*pb.ClientVersionV1{{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}}, service.ExecutionClientVersion())
+	require.Equal(t, 1, len(updater.stats))
+	require.Equal(t, "Geth", updater.stats[0].ExecutionClientName)
+	require.Equal(t, "1.14.8", updater.stats[0].ExecutionClientVersion)
+	require.Equal(t, "0xa9523b64", updater.stats[0].ExecutionClientCommit)
+
+	// Stats are only updated when the version changes.
+	service.refreshClientVersion(context.Background())
+	require.Equal(t, 1, len(updater.stats))
+
+	available = false
+	service.refreshClientVersion(context.Background())
+	require.Equal(t, 0, len(service.ExecutionClientVersion()))
+	require.Equal(t, 2, len(updater.stats))
+	require.Equal(t, "", updater.stats[1].ExecutionClientName)
+}
@@ -0,0 +1,155 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// maxShadowNewPayloadCalls is the maximum number of mirrored newPayload calls in flight.
+const maxShadowNewPayloadCalls = 16
+
+// currentEndpoint returns the index and the value of the execution endpoint in use.
+func (s *Service) currentEndpoint() (int, network.Endpoint) {
+	s.endpointLock.RLock()
+	defer s.endpointLock.RUnlock()
+	return s.currEndpointIndex, s.cfg.currHttpEndpoint
+}
+
+// setCurrentEndpoint sets the execution endpoint in use to the configured endpoint at the given index.
+func (s *Service) setCurrentEndpoint(idx int) {
+	s.endpointLock.Lock()
+	defer s.endpointLock.Unlock()
+	s.currEndpointIndex = idx
+	s.cfg.currHttpEndpoint = s.cfg.httpEndpoints[idx]
+}
+
+// failover switches the current execution endpoint to the next configured one, if any.
+// The next connection attempt will use the new endpoint.
+func (s *Service) failover() {
+	if len(s.cfg.httpEndpoints) < 2 {
+		return
+	}
+	idx, prev := s.currentEndpoint()
+	next := (idx + 1) % len(s.cfg.httpEndpoints)
+	s.setCurrentEndpoint(next)
+	executionEndpointFailoverCount.Inc()
+	log.WithFields(logrus.Fields{
+		"from": logs.MaskCredentialsLogging(prev.Url),
+		"to":   logs.MaskCredentialsLogging(s.cfg.httpEndpoints[next].Url),
+	}).Warn("Execution endpoint is unreachable, failing over")
+}
+
+// failbackToPrimary switches back to the primary execution endpoint once it is reachable again.
+func (s *Service) failbackToPrimary(ctx context.Context) {
+	primary := s.cfg.httpEndpoints[0]
+	// Probe the primary endpoint first, so that the working connection is kept if it is still down.
+	client, err := s.newRPCClientWithAuth(ctx, primary)
+	if err != nil {
+		return
+	}
+	err = ensureCorrectExecutionChain(ctx, ethclient.NewClient(client))
+	client.Close()
+	if err != nil {
+		log.WithError(err).Debug("Primary execution endpoint is still unavailable")
+		return
+	}
+	// The clients of the service are only replaced once the new connection is verified,
+	// the previous ones are closed once the calls in flight with them are done.
+	if err := s.setupExecutionClientConnections(ctx, primary); err != nil {
+		log.WithError(err).Debug("Could not switch back to primary execution endpoint")
+		return
+	}
+	s.setCurrentEndpoint(0)
+	log.WithField("endpoint", logs.MaskCredentialsLogging(primary.Url)).Info("Primary execution endpoint is reachable again, switched back to it")
+}
+
+// shadowNewPayload mirrors a newPayload call to every execution endpoint other than the current one
+// and reports the endpoints that disagree with the status returned by the current endpoint.
+// Calls are made asynchronously and never affect the result of the original call. At most
+// maxShadowNewPayloadCalls calls are in flight, further calls are dropped.
+func (s *Service) shadowNewPayload(blockHash []byte, status pb.PayloadStatus_Status, method string, args ...interface{}) {
+	if s.cfg == nil || len(s.cfg.httpEndpoints) < 2 {
+		return
+	}
+	_, curr := s.currentEndpoint()
+	for _, endpoint := range s.cfg.httpEndpoints {
+		if endpoint.Url == curr.Url {
+			continue
+		}
+		select {
+		case s.shadowCalls <- struct{}{}:
+		default:
+			shadowNewPayloadDroppedCount.Inc()
+			continue
+		}
+		go func(endpoint network.Endpoint) {
+			defer func() { <-s.shadowCalls }()
+			d := time.Now().Add(time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue) * time.Second)
+			ctx, cancel := context.WithDeadline(s.ctx, d)
+			defer cancel()
+			client, err := s.shadowClient(ctx, endpoint)
+			if err != nil {
+				shadowNewPayloadErrorCount.Inc()
+				log.WithError(err).WithField("endpoint", logs.MaskCredentialsLogging(endpoint.Url)).Debug("Could not dial secondary execution endpoint")
+				return
+			}
+			result := &pb.PayloadStatus{}
+			if err := client.CallContext(ctx, result, method, args...); err != nil {
+				shadowNewPayloadErrorCount.Inc()
+				log.WithError(err).WithField("endpoint", logs.MaskCredentialsLogging(endpoint.Url)).Debug("Could not mirror newPayload call")
+				return
+			}
+			if payloadStatusesDisagree(status, result.Status) {
+				payloadStatusDisagreementCount.Inc()
+				log.WithFields(logrus.Fields{
+					"blockHash":       hexutil.Encode(blockHash),
+					"primaryEndpoint": logs.MaskCredentialsLogging(curr.Url),
+					"primaryStatus":   status.String(),
+					"shadowEndpoint":  logs.MaskCredentialsLogging(endpoint.Url),
+					"shadowStatus":    result.Status.String(),
+				}).Warn("Execution clients disagree on payload status")
+			}
+		}(endpoint)
+	}
+}
+
+// shadowClient returns the cached client for the endpoint, dialing it if needed.
+func (s *Service) shadowClient(ctx context.Context, endpoint network.Endpoint) (RPCClient, error) {
+	s.shadowClientsLock.Lock()
+	defer s.shadowClientsLock.Unlock()
+	if client, ok := s.shadowClients[endpoint.Url]; ok {
+		return client, nil
+	}
+	client, err := s.newRPCClientWithAuth(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	s.shadowClients[endpoint.Url] = client
+	return client, nil
+}
+
+func (s *Service) closeShadowClients() {
+	s.shadowClientsLock.Lock()
+	defer s.shadowClientsLock.Unlock()
+	for url, client := range s.shadowClients {
+		client.Close()
+		delete(s.shadowClients, url)
+	}
+}
+
+// payloadStatusesDisagree returns true when both statuses are definitive verdicts on the payload
+// validity and they differ. SYNCING and ACCEPTED do not carry a verdict and never disagree.
+func payloadStatusesDisagree(a, b pb.PayloadStatus_Status) bool {
+	definitive := func(st pb.PayloadStatus_Status) bool {
+		return st == pb.PayloadStatus_VALID || st == pb.PayloadStatus_INVALID || st == pb.PayloadStatus_INVALID_BLOCK_HASH
+	}
+	return definitive(a) && definitive(b) && a != b
+}
@@ -0,0 +1,217 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/network"
+	"github.com/prysmaticlabs/prysm/v5/network/authorization"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestWithSecondaryHttpEndpoints(t *testing.T) {
+	s := &Service{cfg: &config{}}
+	require.NoError(t, WithSecondaryHttpEndpoints([]string{"http://a:8551", "", "http://b:8551"}, []byte("secret"))(s))
+	require.Equal(t, 2, len(s.cfg.secondaryHttpEndpoints))
+	assert.Equal(t, "http://a:8551", s.cfg.secondaryHttpEndpoints[0].Url)
+	assert.Equal(t, "http://b:8551", s.cfg.secondaryHttpEndpoints[1].Url)
+	assert.Equal(t, authorization.Bearer, s.cfg.secondaryHttpEndpoints[1].Auth.Method)
+	assert.Equal(t, "secret", s.cfg.secondaryHttpEndpoints[1].Auth.Value)
+}
+
+func TestFailover(t *testing.T) {
+	endpoints := []network.Endpoint{
+		network.HttpEndpoint("http://primary:8551"),
+		network.HttpEndpoint("http://secondary1:8551"),
+		network.HttpEndpoint("http://secondary2:8551"),
+	}
+
+	t.Run("no secondary", func(t *testing.T) {
+		s := &Service{cfg: &config{currHttpEndpoint: endpoints[0], httpEndpoints: endpoints[:1]}}
+		s.failover()
+		assert.Equal(t, 0, s.currEndpointIndex)
+		assert.Equal(t, endpoints[0].Url, s.cfg.currHttpEndpoint.Url)
+	})
+	t.Run("rotates through endpoints", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		s := &Service{cfg: &config{currHttpEndpoint: endpoints[0], httpEndpoints: endpoints}}
+		s.failover()
+		assert.Equal(t, 1, s.currEndpointIndex)
+		assert.Equal(t, endpoints[1].Url, s.cfg.currHttpEndpoint.Url)
+		require.LogsContain(t, hook, "Execution endpoint is unreachable, failing over")
+		s.failover()
+		assert.Equal(t, endpoints[2].Url, s.cfg.currHttpEndpoint.Url)
+		s.failover()
+		assert.Equal(t, 0, s.currEndpointIndex)
+		assert.Equal(t, endpoints[0].Url, s.cfg.currHttpEndpoint.Url)
+	})
+}
+
+func TestSetupExecutionClientConnections_KeepsClientsOnFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The endpoint is on another chain.
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result":  "0x999999",
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	currClient := &RPCClientEmpty{}
+	s := &Service{
+		ctx:       context.Background(),
+		rpcClient: currClient,
+		cfg:       &config{},
+	}
+	require.ErrorContains(t, "wanted chain ID", s.setupExecutionClientConnections(context.Background(), network.HttpEndpoint(srv.URL)))
+	assert.Equal(t, RPCClient(currClient), s.rpcClient)
+	assert.Equal(t, nil, s.httpLogger)
+	assert.Equal(t, true, s.depositContractCaller == nil)
+}
+
+func TestShadowNewPayload(t *testing.T) {
+	newServer := func(status pb.PayloadStatus_Status, called chan<- struct{}) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			defer func() {
+				require.NoError(t, r.Body.Close())
+			}()
+			req := &struct {
+				Method string `json:"method"`
+			}{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			require.Equal(t, NewPayloadMethodV2, req.Method)
+			resp := map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"result":  &pb.PayloadStatus{Status: status},
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(resp))
+			called <- struct{}{}
+		}))
+	}
+	setup := func(t *testing.T, shadowStatus pb.PayloadStatus_Status) (*Service, chan struct{}) {
+		called := make(chan struct{}, 1)
+		srv := newServer(shadowStatus, called)
+		t.Cleanup(srv.Close)
+		primary := network.HttpEndpoint("http://primary:8551")
+		s := &Service{
+			ctx:           context.Background(),
+			shadowClients: make(map[string]RPCClient),
+			shadowCalls:   make(chan struct{}, maxShadowNewPayloadCalls),
+			cfg: &config{
+				currHttpEndpoint: primary,
+				httpEndpoints:    []network.Endpoint{primary, network.HttpEndpoint(srv.URL)},
+			},
+		}
+		t.Cleanup(s.closeShadowClients)
+		return s, called
+	}
+	waitForCall := func(t *testing.T, called <-chan struct{}) {
+		select {
+		case <-called:
+		case <-time.After(5 * time.Second):
+			t.Fatal("secondary execution endpoint was not called")
+		}
+	}
+	waitForLog := func(t *testing.T, hook *logTest.Hook, msg string) {
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			for _, e := range hook.AllEntries() {
+				if e.Message == msg {
+					return
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("log %q was not emitted", msg)
+	}
+
+	t.Run("disagreement", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		s, called := setup(t, pb.PayloadStatus_INVALID)
+		s.shadowNewPayload([]byte{'a'}, pb.PayloadStatus_VALID, NewPayloadMethodV2, &pb.ExecutionPayloadCapella{})
+		waitForCall(t, called)
+		waitForLog(t, hook, "Execution clients disagree on payload status")
+	})
+	t.Run("agreement", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		s, called := setup(t, pb.PayloadStatus_SYNCING)
+		s.shadowNewPayload([]byte{'a'}, pb.PayloadStatus_VALID, NewPayloadMethodV2, &pb.ExecutionPayloadCapella{})
+		waitForCall(t, called)
+		// Give the mirrored call time to be compared.
+		time.Sleep(100 * time.Millisecond)
+		require.LogsDoNotContain(t, hook, "Execution clients disagree on payload status")
+		require.Equal(t, 1, len(s.shadowClients))
+	})
+	t.Run("saturated", func(t *testing.T) {
+		s, called := setup(t, pb.PayloadStatus_VALID)
+		for i := 0; i < maxShadowNewPayloadCalls; i++ {
+			s.shadowCalls <- struct{}{}
+		}
+		s.shadowNewPayload([]byte{'a'}, pb.PayloadStatus_VALID, NewPayloadMethodV2, &pb.ExecutionPayloadCapella{})
+		select {
+		case <-called:
+			t.Fatal("secondary execution endpoint was called while mirrored calls are saturated")
+		case <-time.After(100 * time.Millisecond):
+		}
+		require.Equal(t, 0, len(s.shadowClients))
+	})
+}
+
+type closeRecordingClient struct {
+	RPCClientEmpty
+	closed chan struct{}
+}
+
+func (c *closeRecordingClient) Close() {
+	close(c.closed)
+}
+
+func TestService_SetClients_ClosesPreviousClientAfterCalls(t *testing.T) {
+	s := &Service{}
+	prev := &closeRecordingClient{closed: make(chan struct{})}
+	s.setClients(prev, nil, nil)
+
+	client, done := s.acquireRPCClient()
+	require.Equal(t, RPCClient(prev), client)
+	s.setClients(&closeRecordingClient{closed: make(chan struct{})}, nil, nil)
+	select {
+	case <-prev.closed:
+		t.Fatal("previous client was closed while a call was in flight")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	done()
+	select {
+	case <-prev.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("previous client was not closed once the call was done")
+	}
+}
+
+func TestPayloadStatusesDisagree(t *testing.T) {
+	tests := []struct {
+		a, b pb.PayloadStatus_Status
+		want bool
+	}{
+		{a: pb.PayloadStatus_VALID, b: pb.PayloadStatus_VALID, want: false},
+		{a: pb.PayloadStatus_VALID, b: pb.PayloadStatus_INVALID, want: true},
+		{a: pb.PayloadStatus_INVALID_BLOCK_HASH, b: pb.PayloadStatus_VALID, want: true},
+		{a: pb.PayloadStatus_VALID, b: pb.PayloadStatus_SYNCING, want: false},
+		{a: pb.PayloadStatus_ACCEPTED, b: pb.PayloadStatus_INVALID, want: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, payloadStatusesDisagree(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
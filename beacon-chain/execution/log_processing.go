@@ -67,7 +67,9 @@ func (s *Service) ProcessETH1Block(ctx context.Context, blkNum *big.Int) error {
 		FromBlock: blkNum,
 		ToBlock:   blkNum,
 	}
-	logs, err := s.httpLogger.FilterLogs(ctx, query)
+	_, logger, _, done := s.acquireClients()
+	logs, err := logger.FilterLogs(ctx, query)
+	done()
 	if err != nil {
 		return err
 	}
@@ -297,7 +299,9 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 	currentBlockNum = max(currentBlockNum, deploymentBlock)
 	// To store all blocks.
 	headersMap := make(map[uint64]*types.HeaderInfo)
-	rawLogCount, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	_, _, depositContractCaller, done := s.acquireClients()
+	rawLogCount, err := depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	done()
 	if err != nil {
 		return err
 	}
@@ -395,7 +399,9 @@ func (s *Service) processBlockInBatch(ctx context.Context, currentBlockNum uint6
 		query.ToBlock = new(big.Int).SetUint64(latestFollowHeight)
 		end = latestFollowHeight
 	}
-	logs, err := s.httpLogger.FilterLogs(ctx, query)
+	_, logger, _, done := s.acquireClients()
+	logs, err := logger.FilterLogs(ctx, query)
+	done()
 	if err != nil {
 		if tooMuchDataRequestedError(err) {
 			if batchSize == 0 {
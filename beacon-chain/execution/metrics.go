@@ -71,4 +71,20 @@ var (
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
 	})
+	executionEndpointFailoverCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_endpoint_failover_count",
+		Help: "The number of times the beacon node failed over to another execution endpoint",
+	})
+	payloadStatusDisagreementCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_payload_status_disagreement_count",
+		Help: "The number of newPayload calls for which the execution endpoints returned conflicting statuses",
+	})
+	shadowNewPayloadErrorCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_shadow_new_payload_error_count",
+		Help: "The number of newPayload calls mirrored to a secondary execution endpoint that failed",
+	})
+	shadowNewPayloadDroppedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_shadow_new_payload_dropped_count",
+		Help: "The number of newPayload calls not mirrored to a secondary execution endpoint because too many were in flight",
+	})
 )
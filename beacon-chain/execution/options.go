@@ -37,6 +37,25 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithSecondaryHttpEndpoints sets the execution client endpoints to fail over to when the primary endpoint
+// is unreachable. They also receive a copy of every newPayload call to verify the primary's payload statuses.
+func WithSecondaryHttpEndpoints(endpointStrings []string, secret []byte) Option {
+	return func(s *Service) error {
+		for _, endpointString := range endpointStrings {
+			if endpointString == "" {
+				continue
+			}
+			hEndpoint := network.HttpEndpoint(endpointString)
+			if len(secret) > 0 {
+				hEndpoint.Auth.Method = authorization.Bearer
+				hEndpoint.Auth.Value = string(secret)
+			}
+			s.cfg.secondaryHttpEndpoints = append(s.cfg.secondaryHttpEndpoints, hEndpoint)
+		}
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
@@ -13,9 +13,10 @@ type BeaconNodeStatsUpdater interface {
 }
 
 type PowchainCollector struct {
-	SyncEth1Connected *prometheus.Desc
-	updateChan        chan clientstats.BeaconNodeStats
-	latestStats       clientstats.BeaconNodeStats
+	SyncEth1Connected   *prometheus.Desc
+	ExecutionClientInfo *prometheus.Desc
+	updateChan          chan clientstats.BeaconNodeStats
+	latestStats         clientstats.BeaconNodeStats
 	sync.Mutex
 	ctx        context.Context
 	finishChan chan struct{}
@@ -36,6 +37,7 @@ func (pc *PowchainCollector) Update(update clientstats.BeaconNodeStats) {
 // prometheus.Collector interface.
 func (pc *PowchainCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- pc.SyncEth1Connected
+	ch <- pc.ExecutionClientInfo
 }
 
 // Collect is invoked by the prometheus collection loop.
@@ -59,6 +61,17 @@ func (pc *PowchainCollector) Collect(ch chan<- prometheus.Metric) {
 		prometheus.GaugeValue,
 		syncEth1Connected,
 	)
+
+	if bs.ExecutionClientName != "" {
+		ch <- prometheus.MustNewConstMetric(
+			pc.ExecutionClientInfo,
+			prometheus.GaugeValue,
+			1,
+			bs.ExecutionClientName,
+			bs.ExecutionClientVersion,
+			bs.ExecutionClientCommit,
+		)
+	}
 }
 
 func (pc *PowchainCollector) getLatestStats() clientstats.BeaconNodeStats {
@@ -102,6 +115,12 @@ func NewPowchainCollector(ctx context.Context) (*PowchainCollector, error) {
 			nil,
 			nil,
 		),
+		ExecutionClientInfo: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "execution_client_info"),
+			"Name, version and commit of the connected execution client, as returned by engine_getClientVersionV1.",
+			[]string{"name", "version", "commit"},
+			nil,
+		),
 		updateChan: updateChan,
 		ctx:        ctx,
 		finishChan: make(chan struct{}, 1),
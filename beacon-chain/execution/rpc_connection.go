@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
@@ -22,17 +24,12 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 	if err != nil {
 		return errors.Wrap(err, "could not dial execution node")
 	}
-	// Attach the clients to the service struct.
 	fetcher := ethclient.NewClient(client)
-	s.rpcClient = client
-	s.httpLogger = fetcher
-
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.cfg.depositContractAddr, fetcher)
 	if err != nil {
 		client.Close()
 		return errors.Wrap(err, "could not initialize deposit contract caller")
 	}
-	s.depositContractCaller = depositContractCaller
 
 	// Ensure we have the correct chain and deposit IDs.
 	if err := ensureCorrectExecutionChain(ctx, fetcher); err != nil {
@@ -45,8 +42,12 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 		}
 		return errors.Wrap(err, errStr)
 	}
+	// Attach the clients to the service struct together, once the connection is verified,
+	// so that the service keeps using its previous clients if the new endpoint is unusable.
+	s.setClients(client, fetcher, depositContractCaller)
 	s.updateConnectedETH1(true)
 	s.runError = nil
+	s.refreshClientVersion(ctx)
 	return nil
 }
 
@@ -67,17 +68,14 @@ func (s *Service) pollConnectionStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			log.Debugf("Trying to dial endpoint: %s", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url))
-			currClient := s.rpcClient
-			if err := s.setupExecutionClientConnections(ctx, s.cfg.currHttpEndpoint); err != nil {
+			_, endpoint := s.currentEndpoint()
+			log.Debugf("Trying to dial endpoint: %s", logs.MaskCredentialsLogging(endpoint.Url))
+			if err := s.setupExecutionClientConnections(ctx, endpoint); err != nil {
 				errorLogger(err, "Could not connect to execution client endpoint")
+				s.failover()
 				continue
 			}
-			// Close previous client, if connection was successful.
-			if currClient != nil {
-				currClient.Close()
-			}
-			log.WithField("endpoint", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url)).Info("Connected to new endpoint")
+			log.WithField("endpoint", logs.MaskCredentialsLogging(endpoint.Url)).Info("Connected to new endpoint")
 			return
 		case <-s.ctx.Done():
 			log.Debug("Received cancelled context,closing existing powchain service")
@@ -92,19 +90,88 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	s.updateConnectedETH1(false)
 	// Back off for a while before redialing.
 	time.Sleep(backOffPeriod)
-	currClient := s.rpcClient
-	if err := s.setupExecutionClientConnections(ctx, s.cfg.currHttpEndpoint); err != nil {
+	_, endpoint := s.currentEndpoint()
+	if err := s.setupExecutionClientConnections(ctx, endpoint); err != nil {
 		s.runError = errors.Wrap(err, "setupExecutionClientConnections")
 		return
 	}
-	// Close previous client, if connection was successful.
-	if currClient != nil {
-		currClient.Close()
-	}
 	// Reset run error in the event of a successful connection.
 	s.runError = nil
 }
 
+// setClients attaches the clients of a verified connection to the service. The previous RPC client
+// is closed once the calls in flight with the previous clients are done.
+func (s *Service) setClients(client RPCClient, fetcher bind.ContractFilterer, depositContractCaller *contracts.DepositContractCaller) {
+	s.clientsLock.Lock()
+	prevClient, prevCalls := s.rpcClient, s.clientCalls
+	s.rpcClient = client
+	s.httpLogger = fetcher
+	s.depositContractCaller = depositContractCaller
+	s.clientCalls = new(sync.WaitGroup)
+	s.clientsLock.Unlock()
+
+	if prevClient == nil {
+		return
+	}
+	go func() {
+		if prevCalls != nil {
+			prevCalls.Wait()
+		}
+		prevClient.Close()
+	}()
+}
+
+// hasRPCClient returns true when the service has an RPC client to the execution endpoint.
+func (s *Service) hasRPCClient() bool {
+	s.clientsLock.Lock()
+	defer s.clientsLock.Unlock()
+	return s.rpcClient != nil
+}
+
+// closeRPCClient closes the RPC client of the execution endpoint in use.
+func (s *Service) closeRPCClient() {
+	s.clientsLock.Lock()
+	client := s.rpcClient
+	s.clientsLock.Unlock()
+	if client != nil {
+		client.Close()
+	}
+}
+
+// acquireClients returns the clients of the execution endpoint in use, with a function to call once
+// the calls made with them are done. The clients are not closed before that function is called.
+func (s *Service) acquireClients() (RPCClient, bind.ContractFilterer, *contracts.DepositContractCaller, func()) {
+	s.clientsLock.Lock()
+	defer s.clientsLock.Unlock()
+	if s.clientCalls == nil {
+		s.clientCalls = new(sync.WaitGroup)
+	}
+	calls := s.clientCalls
+	calls.Add(1)
+	return s.rpcClient, s.httpLogger, s.depositContractCaller, calls.Done
+}
+
+// acquireRPCClient returns the RPC client of the execution endpoint in use, with a function to call
+// once the calls made with it are done.
+func (s *Service) acquireRPCClient() (RPCClient, func()) {
+	client, _, _, done := s.acquireClients()
+	return client, done
+}
+
+// callContext calls the given method with the RPC client of the execution endpoint in use.
+func (s *Service) callContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	client, done := s.acquireRPCClient()
+	defer done()
+	return client.CallContext(ctx, result, method, args...)
+}
+
+// batchCall sends the given batch of requests with the RPC client of the execution endpoint in use.
+func (s *Service) batchCall(b []gethRPC.BatchElem) error {
+	client, done := s.acquireRPCClient()
+	defer done()
+	return client.BatchCall(b)
+}
+
 // Initializes an RPC connection with authentication headers.
 func (s *Service) newRPCClientWithAuth(ctx context.Context, endpoint network.Endpoint) (*gethRPC.Client, error) {
 	headers := http.Header{}
@@ -35,6 +35,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/clientstats"
 	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -63,6 +64,8 @@ var (
 	logThreshold = 8
 	// period to log chainstart related information
 	logPeriod = 1 * time.Minute
+	// period to refresh the cached version of the execution client
+	clientVersionRefreshPeriod = 1 * time.Hour
 )
 
 // ChainStartFetcher retrieves information pertaining to the chain start event
@@ -79,6 +82,7 @@ type ChainInfoFetcher interface {
 	ExecutionClientConnected() bool
 	ExecutionClientEndpoint() string
 	ExecutionClientConnectionErr() error
+	ExecutionClientVersion() []*pb.ClientVersionV1
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -125,6 +129,8 @@ type config struct {
 	eth1HeaderReqLimit      uint64
 	beaconNodeStatsUpdater  BeaconNodeStatsUpdater
 	currHttpEndpoint        network.Endpoint
+	secondaryHttpEndpoints  []network.Endpoint
+	httpEndpoints           []network.Endpoint
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 	jwtId                   string
@@ -155,6 +161,15 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	clientVersion           []*pb.ClientVersionV1
+	clientVersionLock       sync.RWMutex
+	currEndpointIndex       int
+	endpointLock            sync.RWMutex
+	shadowClients           map[string]RPCClient
+	shadowClientsLock       sync.Mutex
+	shadowCalls             chan struct{} // bounds the number of mirrored newPayload calls in flight.
+	clientCalls             *sync.WaitGroup
+	clientsLock             sync.Mutex
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -191,6 +206,8 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		},
 		lastReceivedMerkleIndex: -1,
 		preGenesisState:         genState,
+		shadowClients:           make(map[string]RPCClient),
+		shadowCalls:             make(chan struct{}, maxShadowNewPayloadCalls),
 		eth1HeadTicker:          time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
 	}
 
@@ -199,6 +216,8 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			return nil, err
 		}
 	}
+	// The endpoint configured first is the primary one, secondaries follow in the order they were given.
+	s.cfg.httpEndpoints = append([]network.Endpoint{s.cfg.currHttpEndpoint}, s.cfg.secondaryHttpEndpoints...)
 
 	eth1Data, err := s.validPowchainData(ctx)
 	if err != nil {
@@ -242,9 +261,8 @@ func (s *Service) Stop() error {
 	if s.cancel != nil {
 		defer s.cancel()
 	}
-	if s.rpcClient != nil {
-		s.rpcClient.Close()
-	}
+	s.closeRPCClient()
+	s.closeShadowClients()
 	return nil
 }
 
@@ -282,7 +300,8 @@ func (s *Service) ExecutionClientConnected() bool {
 
 // ExecutionClientEndpoint returns the URL of the current, connected execution client.
 func (s *Service) ExecutionClientEndpoint() string {
-	return s.cfg.currHttpEndpoint.Url
+	_, endpoint := s.currentEndpoint()
+	return endpoint.Url
 }
 
 // ExecutionClientConnectionErr returns the error (if any) of the current connection.
@@ -290,11 +309,52 @@ func (s *Service) ExecutionClientConnectionErr() error {
 	return s.runError
 }
 
+// ExecutionClientVersion returns the versions of the execution client, as last returned by
+// engine_getClientVersionV1, or nil if they are not known.
+func (s *Service) ExecutionClientVersion() []*pb.ClientVersionV1 {
+	s.clientVersionLock.RLock()
+	defer s.clientVersionLock.RUnlock()
+	return s.clientVersion
+}
+
+// refreshClientVersion requests the versions of the execution client and caches them.
+// The cache is cleared if the execution client cannot be reached or does not support the method.
+func (s *Service) refreshClientVersion(ctx context.Context) {
+	clientVersion, err := s.GetClientVersion(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get execution client version")
+		clientVersion = nil
+	}
+
+	s.clientVersionLock.Lock()
+	changed := !reflect.DeepEqual(s.clientVersion, clientVersion)
+	s.clientVersion = clientVersion
+	s.clientVersionLock.Unlock()
+
+	if !changed {
+		return
+	}
+	for _, v := range clientVersion {
+		log.WithFields(logrus.Fields{
+			"code":    v.Code,
+			"name":    v.Name,
+			"version": v.Version,
+			"commit":  v.Commit,
+		}).Info("Connected to execution client")
+	}
+	s.updateBeaconNodeStats()
+}
+
 func (s *Service) updateBeaconNodeStats() {
 	bs := clientstats.BeaconNodeStats{}
 	if s.ExecutionClientConnected() {
 		bs.SyncEth1Connected = true
 	}
+	if clientVersion := s.ExecutionClientVersion(); len(clientVersion) > 0 {
+		bs.ExecutionClientName = clientVersion[0].Name
+		bs.ExecutionClientVersion = clientVersion[0].Version
+		bs.ExecutionClientCommit = clientVersion[0].Commit
+	}
 	s.cfg.beaconNodeStatsUpdater.Update(bs)
 }
 
@@ -417,7 +477,7 @@ func (s *Service) batchRequestHeaders(startBlock, endBlock uint64) ([]*types.Hea
 		})
 		headers = append(headers, header)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.batchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -579,12 +639,18 @@ func (s *Service) run(done <-chan struct{}) {
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
 
+	clientVersionTicker := time.NewTicker(clientVersionRefreshPeriod)
+	defer clientVersionTicker.Stop()
+
+	failbackTicker := time.NewTicker(backOffPeriod)
+	defer failbackTicker.Stop()
+
 	for {
 		select {
 		case <-done:
 			s.isRunning = false
 			s.runError = nil
-			s.rpcClient.Close()
+			s.closeRPCClient()
 			s.updateConnectedETH1(false)
 			log.Debug("Context closed, exiting goroutine")
 			return
@@ -603,6 +669,12 @@ func (s *Service) run(done <-chan struct{}) {
 				continue
 			}
 			s.logTillChainStart(context.Background())
+		case <-clientVersionTicker.C:
+			s.refreshClientVersion(s.ctx)
+		case <-failbackTicker.C:
+			if idx, _ := s.currentEndpoint(); idx != 0 {
+				s.failbackToPrimary(s.ctx)
+			}
 		}
 	}
 }
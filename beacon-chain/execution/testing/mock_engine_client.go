@@ -36,6 +36,8 @@ type EngineClient struct {
 	OverrideValidHash           [32]byte
 	GetPayloadResponse          *blocks.GetPayloadResponse
 	ErrGetPayload               error
+	ClientVersion               []*pb.ClientVersionV1
+	ErrGetClientVersion         error
 }
 
 // NewPayload --
@@ -106,6 +108,11 @@ func (e *EngineClient) ReconstructFullBellatrixBlockBatch(
 	return fullBlocks, nil
 }
 
+// GetClientVersion --
+func (e *EngineClient) GetClientVersion(_ context.Context) ([]*pb.ClientVersionV1, error) {
+	return e.ClientVersion, e.ErrGetClientVersion
+}
+
 // GetTerminalBlockHash --
 func (e *EngineClient) GetTerminalBlockHash(ctx context.Context, transitionTime uint64) ([]byte, bool, error) {
 	ttd := new(big.Int)
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
@@ -37,6 +38,7 @@ type Chain struct {
 	CurrError         error
 	Endpoints         []string
 	Errors            []error
+	ClientVersion     []*pb.ClientVersionV1
 }
 
 // GenesisTime represents a static past date - JAN 01 2000.
@@ -131,6 +133,10 @@ func (m *Chain) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
 
+func (m *Chain) ExecutionClientVersion() []*pb.ClientVersionV1 {
+	return m.ClientVersion
+}
+
 func (m *Chain) ETH1Endpoints() []string {
 	return m.Endpoints
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -109,6 +110,7 @@ func (f *ForkChoice) InsertNode(ctx context.Context, state state.BeaconState, ro
 	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.InsertNode")
 	defer span.End()
 
+	f.store.insertedUnrealizedJustified, f.store.insertedUnrealizedFinalized = nil, nil
 	slot := state.Slot()
 	bh := state.LatestBlockHeader()
 	if bh == nil {
@@ -140,7 +142,13 @@ func (f *ForkChoice) InsertNode(ctx context.Context, state state.BeaconState, ro
 		return err
 	}
 
-	jc, fc = f.store.pullTips(state, node, jc, fc)
+	jc, fc = f.store.pullTips(slot, func() (*ethpb.Checkpoint, *ethpb.Checkpoint, error) {
+		uj, uf, err := precompute.UnrealizedCheckpoints(state)
+		if err == nil {
+			f.store.insertedUnrealizedJustified, f.store.insertedUnrealizedFinalized = uj, uf
+		}
+		return uj, uf, err
+	}, node, jc, fc)
 	return f.updateCheckpoints(ctx, jc, fc)
 }
 
@@ -333,6 +341,12 @@ func (f *ForkChoice) updateBalances() error {
 	return nil
 }
 
+// InsertedUnrealizedCheckpoints returns the unrealized justified and finalized checkpoints of the post state
+// of the last node inserted with InsertNode. They are nil if the store did not need to compute them.
+func (f *ForkChoice) InsertedUnrealizedCheckpoints() (*ethpb.Checkpoint, *ethpb.Checkpoint) {
+	return f.store.insertedUnrealizedJustified, f.store.insertedUnrealizedFinalized
+}
+
 // Tips returns a list of possible heads from fork choice store, it returns the
 // roots and the slots of the leaf nodes.
 func (f *ForkChoice) Tips() ([][32]byte, []primitives.Slot) {
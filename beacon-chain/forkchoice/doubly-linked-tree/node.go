@@ -128,13 +128,9 @@ func (n *Node) setNodeAndParentValidated(ctx context.Context) error {
 
 // arrivedEarly returns whether this node was inserted before the first
 // threshold to orphan a block.
-// Note that genesisTime has seconds granularity, therefore we use a strict
-// inequality < here. For example a block that arrives 3.9999 seconds into the
-// slot will have secs = 3 below.
 func (n *Node) arrivedEarly(genesisTime uint64) (bool, error) {
-	secs, err := slots.SecondsSinceSlotStart(n.slot, genesisTime, n.timestamp)
-	votingWindow := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot
-	return secs < votingWindow, err
+	arrival, err := slots.SinceSlotStart(n.slot, genesisTime, n.timestamp)
+	return arrival < params.BeaconConfig().ReorgLateBlockCutoff(), err
 }
 
 // arrivedAfterOrphanCheck returns whether this block was inserted after the
@@ -143,7 +139,7 @@ func (n *Node) arrivedEarly(genesisTime uint64) (bool, error) {
 // inequality >= here. For example a block that arrives 10.00001 seconds into the
 // slot will have secs = 10 below.
 func (n *Node) arrivedAfterOrphanCheck(genesisTime uint64) (bool, error) {
-	secs, err := slots.SecondsSinceSlotStart(n.slot, genesisTime, n.timestamp)
+	secs, err := slots.SecondsSinceSlotStart(n.slot, genesisTime, uint64(n.timestamp.Unix()))
 	return secs >= ProcessAttestationsThreshold, err
 }
 
@@ -168,7 +164,7 @@ func (n *Node) nodeTreeDump(ctx context.Context, nodes []*forkchoice2.Node) ([]*
 		Weight:                   n.weight,
 		ExecutionOptimistic:      n.optimistic,
 		ExecutionBlockHash:       n.payloadHash[:],
-		Timestamp:                n.timestamp,
+		Timestamp:                uint64(n.timestamp.Unix()),
 	}
 	if n.optimistic {
 		thisNode.Validity = forkchoice2.Optimistic
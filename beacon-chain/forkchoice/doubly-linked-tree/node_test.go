@@ -3,12 +3,14 @@ package doublylinkedtree
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 func TestNode_ApplyWeightChanges_PositiveChange(t *testing.T) {
@@ -254,7 +256,7 @@ func TestNode_SetFullyValidated(t *testing.T) {
 		require.Equal(t, storeNodes[i].unrealizedJustifiedEpoch, respNode.UnrealizedJustifiedEpoch)
 		require.Equal(t, storeNodes[i].finalizedEpoch, respNode.FinalizedEpoch)
 		require.Equal(t, storeNodes[i].unrealizedFinalizedEpoch, respNode.UnrealizedFinalizedEpoch)
-		require.Equal(t, storeNodes[i].timestamp, respNode.Timestamp)
+		require.Equal(t, uint64(storeNodes[i].timestamp.Unix()), respNode.Timestamp)
 	}
 }
 
@@ -321,9 +323,28 @@ func TestNode_TimeStampsChecks(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, root, headRoot)
 	early, err = f.store.headNode.arrivedEarly(f.store.genesisTime)
-	require.ErrorContains(t, "invalid timestamp", err)
+	require.ErrorContains(t, "invalid time", err)
 	require.Equal(t, true, early)
 	late, err = f.store.headNode.arrivedAfterOrphanCheck(f.store.genesisTime)
 	require.ErrorContains(t, "invalid timestamp", err)
 	require.Equal(t, false, late)
 }
+
+func TestNode_ArrivedEarly_Millis(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ReorgLateBlockCutoffMillis = 4500
+	params.OverrideBeaconConfig(cfg)
+
+	genesisTime := uint64(time.Now().Unix()) - 10*params.BeaconConfig().SecondsPerSlot
+	slotStart := slots.StartTime(genesisTime, 1)
+	n := &Node{slot: 1, timestamp: slotStart.Add(4400 * time.Millisecond)}
+	early, err := n.arrivedEarly(genesisTime)
+	require.NoError(t, err)
+	require.Equal(t, true, early)
+	// The block arrives after the cutoff, within the same second.
+	n.timestamp = slotStart.Add(4700 * time.Millisecond)
+	early, err = n.arrivedEarly(genesisTime)
+	require.NoError(t, err)
+	require.Equal(t, false, early)
+}
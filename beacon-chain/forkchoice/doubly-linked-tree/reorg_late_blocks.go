@@ -3,13 +3,21 @@ package doublylinkedtree
 import (
 	"time"
 
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
-// orphanLateBlockProposingEarly determines the maximum threshold that we
-// consider the node is proposing early and sure to receive proposer boost
-const orphanLateBlockProposingEarly = 2
+// Reasons for which a late head block is not orphaned.
+const (
+	reasonEpochBoundary  = "next slot starts an epoch"
+	reasonNotFinalizing  = "chain is not finalizing"
+	reasonNoParent       = "parent is not known"
+	reasonNotSingleBlock = "parent is not from the previous slot"
+	reasonHeadStrong     = "head weight is above the threshold"
+	reasonParentWeak     = "parent weight is below the threshold"
+	reasonProposingLate  = "proposing after the cutoff"
+)
 
 // ShouldOverrideFCU returns whether the current forkchoice head is weak
 // and thus may be reorged when proposing the next block.
@@ -31,71 +39,42 @@ const orphanLateBlockProposingEarly = 2
 // the engine's view of head with the parent block or the incoming block. It
 // does not guarantee an attempted reorg. This will only be decided later at
 // proposal time by calling GetProposerHead.
-func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
-	override = false
+func (f *ForkChoice) ShouldOverrideFCU() bool {
+	d := f.ShouldOverrideFCUDecision()
+	return d != nil && d.Reorg
+}
 
+// ShouldOverrideFCUDecision returns the decision taken by ShouldOverrideFCU, with its inputs.
+// It returns nil when the head is not a block of the current slot which arrived late.
+func (f *ForkChoice) ShouldOverrideFCUDecision() *forkchoicetypes.LateBlockReorgDecision {
 	// We only need to override FCU if our current head is from the current
 	// slot. This differs from the spec implementation in that we assume
 	// that we will call this function in the previous slot to proposing.
 	head := f.store.headNode
-	if head == nil {
-		return
+	if head == nil || head.slot != slots.CurrentSlot(f.store.genesisTime) {
+		return nil
 	}
-
-	if head.slot != slots.CurrentSlot(f.store.genesisTime) {
-		return
+	d := f.lateBlockReorgDecision(head)
+	if d == nil || d.Reason != "" {
+		return d
 	}
 
-	// Do not reorg on epoch boundaries
-	if (head.slot+1)%params.BeaconConfig().SlotsPerEpoch == 0 {
-		return
-	}
-	// Only reorg blocks that arrive late
-	early, err := head.arrivedEarly(f.store.genesisTime)
-	if err != nil {
-		log.WithError(err).Error("could not check if block arrived early")
-		return
-	}
-	if early {
-		return
-	}
-	// Only reorg if we have been finalizing
-	finalizedEpoch := f.store.finalizedCheckpoint.Epoch
-	if slots.ToEpoch(head.slot+1) > finalizedEpoch+params.BeaconConfig().ReorgMaxEpochsSinceFinalization {
-		return
-	}
-	// Only orphan a single block
-	parent := head.parent
-	if parent == nil {
-		return
-	}
-	if head.slot > parent.slot+1 {
-		return
-	}
-	// Do not orphan a block that has higher justification than the parent
-	// if head.unrealizedJustifiedEpoch > parent.unrealizedJustifiedEpoch {
-	//		return
-	// }
-
-	// Only orphan a block if the head LMD vote is weak
-	if head.weight*100 > f.store.committeeWeight*params.BeaconConfig().ReorgWeightThreshold {
-		return
-	}
-
-	// Return early if we are checking before 10 seconds into the slot
-	secs, err := slots.SecondsSinceSlotStart(head.slot, f.store.genesisTime, uint64(time.Now().Unix()))
+	// Only check the parent LMD vote once the attestations of the slot have
+	// been processed.
+	timeIntoSlot, err := slots.SinceSlotStart(head.slot, f.store.genesisTime, time.Now())
 	if err != nil {
 		log.WithError(err).Error("could not check current slot")
-		return true
-	}
-	if secs < ProcessAttestationsThreshold {
-		return true
+		d.Reorg = true
+		return d
 	}
-	// Only orphan a block if the parent LMD vote is strong
-	if parent.weight*100 < f.store.committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold {
-		return
+	d.TimeIntoSlot = timeIntoSlot
+	if timeIntoSlot >= ProcessAttestationsThreshold*time.Second &&
+		head.parent.weight*100 < f.store.committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold {
+		d.Reason = reasonParentWeak
+		return d
 	}
-	return true
+	d.Reorg = true
+	return d
 }
 
 // GetProposerHead returns the block root that has to be used as ParentRoot by a
@@ -110,56 +89,98 @@ func (f *ForkChoice) GetProposerHead() [32]byte {
 	if head == nil {
 		return [32]byte{}
 	}
+	if d := f.ProposerHeadDecision(); d != nil && d.Reorg {
+		return d.ParentRoot
+	}
+	return head.root
+}
 
+// ProposerHeadDecision returns the decision taken by GetProposerHead, with its inputs. It returns
+// nil when the head is not a block of the previous slot which arrived late.
+func (f *ForkChoice) ProposerHeadDecision() *forkchoicetypes.LateBlockReorgDecision {
 	// Only reorg blocks from the previous slot.
-	if head.slot+1 != slots.CurrentSlot(f.store.genesisTime) {
-		return head.root
+	head := f.store.headNode
+	if head == nil || head.slot+1 != slots.CurrentSlot(f.store.genesisTime) {
+		return nil
 	}
-	// Do not reorg on epoch boundaries
-	if (head.slot+1)%params.BeaconConfig().SlotsPerEpoch == 0 {
-		return head.root
+	d := f.lateBlockReorgDecision(head)
+	if d == nil {
+		return nil
 	}
+	d.Proposing = true
+	if d.Reason != "" {
+		return d
+	}
+
+	// Only orphan a block if the parent LMD vote is strong
+	if head.parent.weight*100 < f.store.committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold {
+		d.Reason = reasonParentWeak
+		return d
+	}
+
+	// Only reorg if we are proposing early
+	timeIntoSlot, err := slots.SinceSlotStart(head.slot+1, f.store.genesisTime, time.Now())
+	if err != nil {
+		log.WithError(err).Error("could not check if proposing early")
+		return nil
+	}
+	d.TimeIntoSlot = timeIntoSlot
+	if d.TimeIntoSlot >= params.BeaconConfig().ReorgProposerCutoff() {
+		d.Reason = reasonProposingLate
+		return d
+	}
+	d.Reorg = true
+	return d
+}
+
+// lateBlockReorgDecision applies the conditions shared by ShouldOverrideFCU and GetProposerHead to
+// the given head. It returns nil when the head did not arrive late, and otherwise a decision which
+// has a reason set if one of the conditions is not met.
+func (f *ForkChoice) lateBlockReorgDecision(head *Node) *forkchoicetypes.LateBlockReorgDecision {
 	// Only reorg blocks that arrive late
 	early, err := head.arrivedEarly(f.store.genesisTime)
 	if err != nil {
 		log.WithError(err).Error("could not check if block arrived early")
-		return head.root
+		return nil
 	}
 	if early {
-		return head.root
+		return nil
 	}
-	// Only reorg if we have been finalizing
-	finalizedEpoch := f.store.finalizedCheckpoint.Epoch
-	if slots.ToEpoch(head.slot+1) > finalizedEpoch+params.BeaconConfig().ReorgMaxEpochsSinceFinalization {
-		return head.root
+	arrival, err := slots.SinceSlotStart(head.slot, f.store.genesisTime, head.timestamp)
+	if err != nil {
+		return nil
 	}
-	// Only orphan a single block
-	parent := head.parent
-	if parent == nil {
-		return head.root
+	d := &forkchoicetypes.LateBlockReorgDecision{
+		HeadRoot:        head.root,
+		HeadSlot:        head.slot,
+		HeadWeight:      head.weight,
+		HeadArrival:     arrival,
+		CommitteeWeight: f.store.committeeWeight,
+		FinalizedEpoch:  f.store.finalizedCheckpoint.Epoch,
 	}
-	if head.slot > parent.slot+1 {
-		return head.root
+	parent := head.parent
+	if parent != nil {
+		d.ParentRoot = parent.root
+		d.ParentSlot = parent.slot
+		d.ParentWeight = parent.weight
 	}
 
+	cfg := params.BeaconConfig()
+	switch {
+	// Do not reorg on epoch boundaries
+	case (head.slot+1)%cfg.SlotsPerEpoch == 0:
+		d.Reason = reasonEpochBoundary
+	// Only reorg if we have been finalizing
+	case slots.ToEpoch(head.slot+1) > d.FinalizedEpoch+cfg.ReorgMaxEpochsSinceFinalization:
+		d.Reason = reasonNotFinalizing
+	// Only orphan a single block
+	case parent == nil:
+		d.Reason = reasonNoParent
+	case head.slot > parent.slot+1:
+		d.Reason = reasonNotSingleBlock
 	// Only orphan a block if the head LMD vote is weak
-	if head.weight*100 > f.store.committeeWeight*params.BeaconConfig().ReorgWeightThreshold {
-		return head.root
-	}
-
-	// Only orphan a block if the parent LMD vote is strong
-	if parent.weight*100 < f.store.committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold {
-		return head.root
-	}
-
-	// Only reorg if we are proposing early
-	secs, err := slots.SecondsSinceSlotStart(head.slot+1, f.store.genesisTime, uint64(time.Now().Unix()))
-	if err != nil {
-		log.WithError(err).Error("could not check if proposing early")
-		return head.root
-	}
-	if secs >= orphanLateBlockProposingEarly {
-		return head.root
+	case head.weight*100 > f.store.committeeWeight*cfg.ReorgWeightThreshold:
+		d.Reason = reasonHeadStrong
 	}
-	return parent.root
+	return d
 }
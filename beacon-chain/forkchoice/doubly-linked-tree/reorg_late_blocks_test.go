@@ -3,9 +3,12 @@ package doublylinkedtree
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 func TestForkChoice_ShouldOverrideFCU(t *testing.T) {
@@ -61,7 +64,7 @@ func TestForkChoice_ShouldOverrideFCU(t *testing.T) {
 	})
 	t.Run("head is early", func(t *testing.T) {
 		saved := f.store.headNode.timestamp
-		f.store.headNode.timestamp = saved - 2
+		f.store.headNode.timestamp = saved.Add(-2 * time.Second)
 		require.Equal(t, false, f.ShouldOverrideFCU())
 		f.store.headNode.timestamp = saved
 	})
@@ -135,7 +138,7 @@ func TestForkChoice_GetProposerHead(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, root, headRoot)
 	orphanLateBlockFirstThreshold := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot
-	f.store.headNode.timestamp -= params.BeaconConfig().SecondsPerSlot - orphanLateBlockFirstThreshold
+	f.store.headNode.timestamp = f.store.headNode.timestamp.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot-orphanLateBlockFirstThreshold) * time.Second)
 	t.Run("head is weak", func(t *testing.T) {
 		require.Equal(t, parentRoot, f.GetProposerHead())
 
@@ -161,7 +164,7 @@ func TestForkChoice_GetProposerHead(t *testing.T) {
 	})
 	t.Run("head is early", func(t *testing.T) {
 		saved := f.store.headNode.timestamp
-		f.store.headNode.timestamp = saved - 2
+		f.store.headNode.timestamp = saved.Add(-2 * time.Second)
 		require.Equal(t, childRoot, f.GetProposerHead())
 		f.store.headNode.timestamp = saved
 	})
@@ -196,3 +199,87 @@ func TestForkChoice_GetProposerHead(t *testing.T) {
 		require.Equal(t, childRoot, f.GetProposerHead())
 	})
 }
+
+func TestForkChoice_ProposerHeadDecision(t *testing.T) {
+	f := setup(0, 0)
+	f.numActiveValidators = 640
+	f.justifiedBalances = make([]uint64, f.numActiveValidators)
+	for i := range f.justifiedBalances {
+		f.justifiedBalances[i] = uint64(10)
+		f.store.committeeWeight += uint64(10)
+	}
+	f.store.committeeWeight /= uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	driftGenesisTime(f, 1, 0)
+	parentRoot := [32]byte{'a'}
+	st, root, err := prepareForkchoiceState(ctx, 1, parentRoot, [32]byte{}, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	attesters := make([]uint64, f.numActiveValidators-64)
+	for i := range attesters {
+		attesters[i] = uint64(i + 64)
+	}
+	f.ProcessAttestation(ctx, attesters, root, 0)
+
+	driftGenesisTime(f, 3, 1)
+	childRoot := [32]byte{'b'}
+	st, root, err = prepareForkchoiceState(ctx, 2, childRoot, [32]byte{'a'}, [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	_, err = f.Head(ctx)
+	require.NoError(t, err)
+	// The head arrives 5 seconds into its slot.
+	f.store.headNode.timestamp = slots.StartTime(f.store.genesisTime, 2).Add(5 * time.Second)
+
+	t.Run("reorg", func(t *testing.T) {
+		d := f.ProposerHeadDecision()
+		require.NotNil(t, d)
+		require.Equal(t, true, d.Proposing)
+		require.Equal(t, true, d.Reorg)
+		require.Equal(t, "", d.Reason)
+		require.Equal(t, childRoot, d.HeadRoot)
+		require.Equal(t, primitives.Slot(2), d.HeadSlot)
+		require.Equal(t, parentRoot, d.ParentRoot)
+		require.Equal(t, primitives.Slot(1), d.ParentSlot)
+		require.Equal(t, f.store.committeeWeight, d.CommitteeWeight)
+		require.Equal(t, 5*time.Second, d.HeadArrival)
+		require.Equal(t, time.Second, d.TimeIntoSlot.Truncate(time.Second))
+	})
+	t.Run("head is strong", func(t *testing.T) {
+		saved := f.store.headNode.weight
+		f.store.headNode.weight = f.store.committeeWeight
+		d := f.ProposerHeadDecision()
+		require.NotNil(t, d)
+		require.Equal(t, false, d.Reorg)
+		require.Equal(t, reasonHeadStrong, d.Reason)
+		f.store.headNode.weight = saved
+	})
+	t.Run("parent is weak", func(t *testing.T) {
+		saved := f.store.headNode.parent.weight
+		f.store.headNode.parent.weight = 0
+		d := f.ProposerHeadDecision()
+		require.NotNil(t, d)
+		require.Equal(t, false, d.Reorg)
+		require.Equal(t, reasonParentWeak, d.Reason)
+		f.store.headNode.parent.weight = saved
+	})
+	t.Run("proposing after the cutoff", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgProposerCutoffMillis = 500
+		params.OverrideBeaconConfig(cfg)
+		d := f.ProposerHeadDecision()
+		require.NotNil(t, d)
+		require.Equal(t, false, d.Reorg)
+		require.Equal(t, reasonProposingLate, d.Reason)
+		require.Equal(t, childRoot, f.GetProposerHead())
+	})
+	t.Run("head arrived before the late block cutoff", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ReorgLateBlockCutoffMillis = 6000
+		params.OverrideBeaconConfig(cfg)
+		require.Equal(t, true, f.ProposerHeadDecision() == nil)
+		require.Equal(t, childRoot, f.GetProposerHead())
+	})
+}
@@ -0,0 +1,179 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/recorder"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// ReplayedHead is a head computed while replaying recorded fork choice inputs.
+type ReplayedHead struct {
+	// Slot at which the head was computed.
+	Slot primitives.Slot
+	Root [32]byte
+	// RecordedRoot is the head computed by the recorded store.
+	RecordedRoot        [32]byte
+	JustifiedCheckpoint *forkchoicetypes.Checkpoint
+	FinalizedCheckpoint *forkchoicetypes.Checkpoint
+	// Tips are the leaves of the block tree, the candidate heads.
+	Tips []*ReplayedTip
+}
+
+// ReplayedTip is a leaf of the block tree, with the weight of the votes for it.
+type ReplayedTip struct {
+	Root   [32]byte
+	Slot   primitives.Slot
+	Weight uint64
+}
+
+// Replay feeds the fork choice inputs recorded by a recorder.Recorder, read from r, to a new
+// store, calling onHead with the head computed by the new store wherever the recorded store
+// computed one. Before each input, the genesis time of the store is shifted so that the store
+// sees the time at which the input was recorded. The recorded proposer boost is applied as is.
+func Replay(ctx context.Context, r io.Reader, onHead func(*ReplayedHead)) error {
+	f := New()
+	balances := make(map[[32]byte][]uint64)
+	f.SetBalancesByRooter(func(_ context.Context, root [32]byte) ([]uint64, error) {
+		b, ok := balances[root]
+		if !ok {
+			return nil, errors.Errorf("no recorded balances for root %#x", root)
+		}
+		return b, nil
+	})
+
+	var genesisTime uint64
+	dec := json.NewDecoder(r)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		e := &recorder.Event{}
+		if err := dec.Decode(e); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "could not decode recorded event")
+		}
+		if e.Type == recorder.EventGenesisTime {
+			genesisTime = e.GenesisTime
+		}
+		if genesisTime != 0 {
+			f.SetGenesisTime(genesisTime + uint64(time.Now().Unix()) - uint64(e.Time/1000))
+		}
+		if e.Type == recorder.EventBalances {
+			balances[bytesutil.ToBytes32(e.Root)] = e.Balances
+			continue
+		}
+		if err := f.replayEvent(ctx, e, onHead); err != nil {
+			log.WithError(err).WithField("type", e.Type).Warn("Could not replay recorded event")
+		}
+	}
+}
+
+func (f *ForkChoice) replayEvent(ctx context.Context, e *recorder.Event, onHead func(*ReplayedHead)) error {
+	root := bytesutil.ToBytes32(e.Root)
+	switch e.Type {
+	case recorder.EventGenesisTime:
+		return nil
+	case recorder.EventOriginRoot:
+		f.SetOriginRoot(root)
+	case recorder.EventInsertNode:
+		err := f.replayInsertNode(ctx, e.Block)
+		f.store.proposerBoostRoot = bytesutil.ToBytes32(e.ProposerBoost)
+		return err
+	case recorder.EventInsertChain:
+		for _, b := range e.Chain {
+			if b.Justified == nil || b.Finalized == nil {
+				return errInvalidNilCheckpoint
+			}
+			if _, err := f.store.insert(ctx, b.Slot, bytesutil.ToBytes32(b.Root), bytesutil.ToBytes32(b.ParentRoot),
+				bytesutil.ToBytes32(b.PayloadHash), b.Justified.Epoch, b.Finalized.Epoch); err != nil {
+				return err
+			}
+			if err := f.updateCheckpoints(ctx, b.Justified.Proto(), b.Finalized.Proto()); err != nil {
+				return err
+			}
+		}
+	case recorder.EventAttestation:
+		f.ProcessAttestation(ctx, e.Indices, root, e.Epoch)
+	case recorder.EventNewSlot:
+		return f.NewSlot(ctx, e.Slot)
+	case recorder.EventJustifiedCheckpoint:
+		if e.Checkpoint == nil {
+			return errInvalidNilCheckpoint
+		}
+		return f.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Epoch: e.Checkpoint.Epoch, Root: bytesutil.ToBytes32(e.Checkpoint.Root)})
+	case recorder.EventFinalizedCheckpoint:
+		if e.Checkpoint == nil {
+			return errInvalidNilCheckpoint
+		}
+		return f.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Epoch: e.Checkpoint.Epoch, Root: bytesutil.ToBytes32(e.Checkpoint.Root)})
+	case recorder.EventSlashedIndex:
+		f.InsertSlashedIndex(ctx, e.ValidatorIndex)
+	case recorder.EventOptimisticToValid:
+		return f.SetOptimisticToValid(ctx, root)
+	case recorder.EventOptimisticToInvalid:
+		_, err := f.SetOptimisticToInvalid(ctx, root, bytesutil.ToBytes32(e.ParentRoot), bytesutil.ToBytes32(e.PayloadHash))
+		return err
+	case recorder.EventHead:
+		head, err := f.Head(ctx)
+		if err != nil {
+			return err
+		}
+		onHead(f.replayedHead(head, root))
+	default:
+		return errors.Errorf("unknown event type %s", e.Type)
+	}
+	return nil
+}
+
+// Inserts a recorded block as InsertNode does, with the recorded unrealized checkpoints of its post state.
+func (f *ForkChoice) replayInsertNode(ctx context.Context, b *recorder.Block) error {
+	if b == nil {
+		return errNilBlockHeader
+	}
+	if b.Justified == nil || b.Finalized == nil {
+		return errInvalidNilCheckpoint
+	}
+	node, err := f.store.insert(ctx, b.Slot, bytesutil.ToBytes32(b.Root), bytesutil.ToBytes32(b.ParentRoot),
+		bytesutil.ToBytes32(b.PayloadHash), b.Justified.Epoch, b.Finalized.Epoch)
+	if err != nil {
+		return err
+	}
+	jc, fc := f.store.pullTips(b.Slot, func() (*ethpb.Checkpoint, *ethpb.Checkpoint, error) {
+		if b.UnrealizedJustified == nil || b.UnrealizedFinalized == nil {
+			return nil, nil, errors.New("unrealized checkpoints were not recorded")
+		}
+		return b.UnrealizedJustified.Proto(), b.UnrealizedFinalized.Proto(), nil
+	}, node, b.Justified.Proto(), b.Finalized.Proto())
+	return f.updateCheckpoints(ctx, jc, fc)
+}
+
+func (f *ForkChoice) replayedHead(head, recordedHead [32]byte) *ReplayedHead {
+	roots, tipSlots := f.Tips()
+	tips := make([]*ReplayedTip, 0, len(roots))
+	for i, root := range roots {
+		weight, err := f.Weight(root)
+		if err != nil {
+			continue
+		}
+		tips = append(tips, &ReplayedTip{Root: root, Slot: tipSlots[i], Weight: weight})
+	}
+	return &ReplayedHead{
+		Slot:                slots.CurrentSlot(f.store.genesisTime),
+		Root:                head,
+		RecordedRoot:        recordedHead,
+		JustifiedCheckpoint: f.JustifiedCheckpoint(),
+		FinalizedCheckpoint: f.FinalizedCheckpoint(),
+		Tips:                tips,
+	}
+}
@@ -0,0 +1,74 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/recorder"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "forkchoice.json")
+	r, err := recorder.New(New(), path)
+	require.NoError(t, err)
+	balances := make([]uint64, 128)
+	for i := range balances {
+		balances[i] = 10
+	}
+	r.SetBalancesByRooter(func(_ context.Context, _ [32]byte) ([]uint64, error) {
+		return balances, nil
+	})
+	r.SetGenesisTime(uint64(time.Now().Unix()) - 3*params.BeaconConfig().SecondsPerSlot)
+	require.NoError(t, r.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Root: params.BeaconConfig().ZeroHash}))
+
+	zeroHash := params.BeaconConfig().ZeroHash
+	st, root, err := prepareForkchoiceState(ctx, 0, zeroHash, [32]byte{}, zeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, r.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 1, indexToHash(1), zeroHash, zeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, r.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, indexToHash(2), zeroHash, zeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, r.InsertNode(ctx, st, root))
+
+	// The vote makes the block of slot 1 the head, until the timely block of the current slot
+	// is boosted.
+	r.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 0)
+	var recorded [][32]byte
+	head, err := r.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(1), head)
+	recorded = append(recorded, head)
+	st, root, err = prepareForkchoiceState(ctx, 3, indexToHash(3), indexToHash(2), zeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, r.InsertNode(ctx, st, root))
+	head, err = r.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(3), head)
+	recorded = append(recorded, head)
+	require.NoError(t, r.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, file.Close())
+	}()
+	var replayed []*ReplayedHead
+	require.NoError(t, Replay(ctx, file, func(h *ReplayedHead) {
+		replayed = append(replayed, h)
+	}))
+	require.Equal(t, len(recorded), len(replayed))
+	for i, h := range replayed {
+		require.Equal(t, recorded[i], h.RecordedRoot)
+		require.Equal(t, recorded[i], h.Root)
+	}
+	require.Equal(t, 2, len(replayed[1].Tips))
+}
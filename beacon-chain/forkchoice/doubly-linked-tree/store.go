@@ -85,7 +85,7 @@ func (s *Store) insert(ctx context.Context,
 		unrealizedFinalizedEpoch: finalizedEpoch,
 		optimistic:               true,
 		payloadHash:              payloadHash,
-		timestamp:                uint64(time.Now().Unix()),
+		timestamp:                time.Now(),
 	}
 
 	// Set the node's target checkpoint
@@ -247,7 +247,7 @@ func (f *ForkChoice) HighestReceivedBlockDelay() primitives.Slot {
 	if n == nil {
 		return 0
 	}
-	secs, err := slots.SecondsSinceSlotStart(n.slot, f.store.genesisTime, n.timestamp)
+	secs, err := slots.SecondsSinceSlotStart(n.slot, f.store.genesisTime, uint64(n.timestamp.Unix()))
 	if err != nil {
 		return 0
 	}
@@ -2,11 +2,13 @@ package doublylinkedtree
 
 import (
 	"sync"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 // ForkChoice defines the overall fork choice store which includes all block nodes, validator's latest votes and balances.
@@ -41,6 +43,8 @@ type Store struct {
 	highestReceivedNode           *Node                                      // The highest slot node.
 	receivedBlocksLastEpoch       [fieldparams.SlotsPerEpoch]primitives.Slot // Using `highestReceivedSlot`. The slot of blocks received in the last epoch.
 	allTipsAreInvalid             bool                                       // tracks if all tips are not viable for head
+	insertedUnrealizedJustified   *ethpb.Checkpoint                          // unrealized justified checkpoint computed for the last node inserted with InsertNode.
+	insertedUnrealizedFinalized   *ethpb.Checkpoint                          // unrealized finalized checkpoint computed for the last node inserted with InsertNode.
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -60,7 +64,7 @@ type Node struct {
 	weight                   uint64                       // weight of this node: the total balance including children
 	bestDescendant           *Node                        // bestDescendant node of this node.
 	optimistic               bool                         // whether the block has been fully validated or not
-	timestamp                uint64                       // The timestamp when the node was inserted.
+	timestamp                time.Time                    // The time when the node was inserted.
 }
 
 // Vote defines an individual validator's vote.
@@ -7,7 +7,10 @@ import (
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -272,6 +275,10 @@ func TestStore_PullTips_Heuristics(t *testing.T) {
 		require.NoError(tt, f.InsertNode(ctx, st, root))
 		require.Equal(tt, primitives.Epoch(2), f.store.nodeByRoot[[32]byte{'h'}].unrealizedJustifiedEpoch)
 		require.Equal(tt, primitives.Epoch(1), f.store.nodeByRoot[[32]byte{'h'}].unrealizedFinalizedEpoch)
+		// The unrealized checkpoints of the post state were not computed.
+		uj, uf := f.InsertedUnrealizedCheckpoints()
+		require.Equal(tt, true, uj == nil)
+		require.Equal(tt, true, uf == nil)
 	})
 
 	t.Run("Previous Epoch is justified and too early for current", func(tt *testing.T) {
@@ -339,3 +346,35 @@ func TestStore_PullTips_Heuristics(t *testing.T) {
 		require.Equal(tt, primitives.Epoch(2), f.store.nodeByRoot[[32]byte{'h'}].unrealizedJustifiedEpoch)
 	})
 }
+
+func TestForkChoice_InsertedUnrealizedCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	st, root, err := prepareForkchoiceState(ctx, 95, [32]byte{'p'}, [32]byte{}, [32]byte{}, 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	driftGenesisTime(f, 127, 0)
+
+	// The unrealized checkpoints of a block late in the epoch are computed.
+	st, _ = util.DeterministicGenesisStateBellatrix(t, 64)
+	require.NoError(t, st.SetSlot(127))
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{ParentRoot: bytesutil.PadTo([]byte{'p'}, 32)}))
+	require.NoError(t, st.SetCurrentJustifiedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)}))
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)}))
+	require.NoError(t, f.InsertNode(ctx, st, [32]byte{'h'}))
+	uj, uf := f.InsertedUnrealizedCheckpoints()
+	require.NotNil(t, uj)
+	require.NotNil(t, uf)
+	require.Equal(t, f.store.nodeByRoot[[32]byte{'h'}].unrealizedJustifiedEpoch, uj.Epoch)
+	require.Equal(t, f.store.nodeByRoot[[32]byte{'h'}].unrealizedFinalizedEpoch, uf.Epoch)
+
+	// They are reset when the next block does not need them.
+	st, root, err = prepareForkchoiceState(ctx, 128, [32]byte{'i'}, [32]byte{'h'}, [32]byte{}, 1, 1)
+	require.NoError(t, err)
+	f.store.nodeByRoot[[32]byte{'h'}].unrealizedJustifiedEpoch = 4
+	driftGenesisTime(f, 128, 0)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	uj, uf = f.InsertedUnrealizedCheckpoints()
+	require.Equal(t, true, uj == nil)
+	require.Equal(t, true, uf == nil)
+}
@@ -8,6 +8,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 // BalancesByRooter is a handler to obtain the effective balances of the state
@@ -36,6 +37,7 @@ type RLocker interface {
 type HeadRetriever interface {
 	Head(context.Context) ([32]byte, error)
 	GetProposerHead() [32]byte
+	ProposerHeadDecision() *forkchoicetypes.LateBlockReorgDecision
 	CachedHeadRoot() [32]byte
 }
 
@@ -57,6 +59,7 @@ type Getter interface {
 	CommonAncestor(ctx context.Context, root1 [32]byte, root2 [32]byte) ([32]byte, primitives.Slot, error)
 	ForkChoiceDump(context.Context) (*forkchoice2.Dump, error)
 	Tips() ([][32]byte, []primitives.Slot)
+	InsertedUnrealizedCheckpoints() (*ethpb.Checkpoint, *ethpb.Checkpoint)
 }
 
 type FastGetter interface {
@@ -76,6 +79,7 @@ type FastGetter interface {
 	ProposerBoost() [fieldparams.RootLength]byte
 	ReceivedBlocksLastEpoch() (uint64, error)
 	ShouldOverrideFCU() bool
+	ShouldOverrideFCUDecision() *forkchoicetypes.LateBlockReorgDecision
 	Slot([32]byte) (primitives.Slot, error)
 	TargetRootForEpoch([32]byte, primitives.Epoch) ([32]byte, error)
 	UnrealizedJustifiedPayloadBlockHash() [32]byte
@@ -0,0 +1,94 @@
+package recorder
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// EventType is the fork choice input recorded by an event.
+type EventType string
+
+const (
+	// EventGenesisTime records the genesis time set with SetGenesisTime.
+	EventGenesisTime EventType = "genesis_time"
+	// EventOriginRoot records the origin root set with SetOriginRoot.
+	EventOriginRoot EventType = "origin_root"
+	// EventBalances records the balances returned by the balances by rooter for a justified root.
+	EventBalances EventType = "balances"
+	// EventInsertNode records a block inserted with InsertNode.
+	EventInsertNode EventType = "insert_node"
+	// EventInsertChain records the blocks inserted with InsertChain.
+	EventInsertChain EventType = "insert_chain"
+	// EventAttestation records the votes processed with ProcessAttestation.
+	EventAttestation EventType = "attestation"
+	// EventNewSlot records a slot started with NewSlot.
+	EventNewSlot EventType = "new_slot"
+	// EventJustifiedCheckpoint records a checkpoint set with UpdateJustifiedCheckpoint.
+	EventJustifiedCheckpoint EventType = "justified_checkpoint"
+	// EventFinalizedCheckpoint records a checkpoint set with UpdateFinalizedCheckpoint.
+	EventFinalizedCheckpoint EventType = "finalized_checkpoint"
+	// EventSlashedIndex records a slashed validator inserted with InsertSlashedIndex.
+	EventSlashedIndex EventType = "slashed_index"
+	// EventOptimisticToValid records a block set valid with SetOptimisticToValid.
+	EventOptimisticToValid EventType = "optimistic_to_valid"
+	// EventOptimisticToInvalid records a block set invalid with SetOptimisticToInvalid.
+	EventOptimisticToInvalid EventType = "optimistic_to_invalid"
+	// EventHead records a head computed with Head.
+	EventHead EventType = "head"
+)
+
+// Event is a fork choice input, recorded as a line of JSON. Only the fields of its type are set.
+type Event struct {
+	Type EventType `json:"type"`
+	// Time is the unix time in milliseconds at which the input was received.
+	Time int64 `json:"time"`
+
+	GenesisTime    uint64                    `json:"genesis_time,omitempty"`
+	Root           hexutil.Bytes             `json:"root,omitempty"`
+	ParentRoot     hexutil.Bytes             `json:"parent_root,omitempty"`
+	PayloadHash    hexutil.Bytes             `json:"payload_hash,omitempty"`
+	Balances       []uint64                  `json:"balances,omitempty"`
+	Block          *Block                    `json:"block,omitempty"`
+	Chain          []*Block                  `json:"chain,omitempty"`
+	ProposerBoost  hexutil.Bytes             `json:"proposer_boost,omitempty"`
+	Indices        []uint64                  `json:"indices,omitempty"`
+	Slot           primitives.Slot           `json:"slot,omitempty"`
+	Epoch          primitives.Epoch          `json:"epoch,omitempty"`
+	Checkpoint     *Checkpoint               `json:"checkpoint,omitempty"`
+	ValidatorIndex primitives.ValidatorIndex `json:"validator_index,omitempty"`
+}
+
+// Block is a block inserted in fork choice.
+type Block struct {
+	Slot        primitives.Slot `json:"slot"`
+	Root        hexutil.Bytes   `json:"root"`
+	ParentRoot  hexutil.Bytes   `json:"parent_root"`
+	PayloadHash hexutil.Bytes   `json:"payload_hash"`
+	Justified   *Checkpoint     `json:"justified"`
+	Finalized   *Checkpoint     `json:"finalized"`
+	// The unrealized checkpoints of the post state of the block are only recorded for the blocks
+	// inserted with InsertNode, when the store computed them.
+	UnrealizedJustified *Checkpoint `json:"unrealized_justified,omitempty"`
+	UnrealizedFinalized *Checkpoint `json:"unrealized_finalized,omitempty"`
+}
+
+// Checkpoint is a recorded checkpoint.
+type Checkpoint struct {
+	Epoch primitives.Epoch `json:"epoch"`
+	Root  hexutil.Bytes    `json:"root"`
+}
+
+// Proto returns the checkpoint as a protobuf checkpoint.
+func (c *Checkpoint) Proto() *ethpb.Checkpoint {
+	root := bytesutil.ToBytes32(c.Root)
+	return &ethpb.Checkpoint{Epoch: c.Epoch, Root: root[:]}
+}
+
+func checkpointFromProto(c *ethpb.Checkpoint) *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &Checkpoint{Epoch: c.Epoch, Root: bytesutil.SafeCopyBytes(c.Root)}
+}
@@ -0,0 +1,5 @@
+package recorder
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "forkchoice-recorder")
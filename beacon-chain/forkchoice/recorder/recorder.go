@@ -0,0 +1,259 @@
+// Package recorder records every input received by a fork choice store to a file, so that the
+// head decisions of the store can be reproduced step by step by replaying them into a new store.
+package recorder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+var _ forkchoice.ForkChoicer = &Recorder{}
+
+// The recorded events are buffered, and written to the record file whenever the buffer is full or
+// every flushInterval.
+const bufferSize = 1 << 20
+
+var flushInterval = 5 * time.Second
+
+// Recorder is a fork choice store which records the inputs it receives, one JSON event per line,
+// before passing them to the wrapped store.
+type Recorder struct {
+	forkchoice.ForkChoicer
+	lock    sync.Mutex
+	file    *os.File
+	w       *bufio.Writer
+	enc     *json.Encoder
+	quit    chan struct{}
+	flushed chan struct{}
+}
+
+// New returns a recorder wrapping the given fork choice store, which records to the file at the
+// given path. An existing file is truncated.
+func New(fc forkchoice.ForkChoicer, path string) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open fork choice record file")
+	}
+	w := bufio.NewWriterSize(f, bufferSize)
+	r := &Recorder{
+		ForkChoicer: fc,
+		file:        f,
+		w:           w,
+		enc:         json.NewEncoder(w),
+		quit:        make(chan struct{}),
+		flushed:     make(chan struct{}),
+	}
+	go r.flushPeriodically()
+	return r, nil
+}
+
+// Close flushes the recorded events and closes the record file.
+func (r *Recorder) Close() error {
+	close(r.quit)
+	<-r.flushed
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.w.Flush(); err != nil {
+		return err
+	}
+	return r.file.Close()
+}
+
+func (r *Recorder) flushPeriodically() {
+	defer close(r.flushed)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.lock.Lock()
+			err := r.w.Flush()
+			r.lock.Unlock()
+			if err != nil {
+				log.WithError(err).Error("Could not write fork choice record file")
+			}
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// Events are written once the wrapped store returns, so that the balances fetched by the store
+// while processing an input are recorded before it.
+func (r *Recorder) record(e *Event) {
+	e.Time = time.Now().UnixMilli()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.enc.Encode(e); err != nil {
+		log.WithError(err).WithField("type", e.Type).Error("Could not record fork choice input")
+	}
+}
+
+// SetGenesisTime records the genesis time and sets it in the wrapped store.
+func (r *Recorder) SetGenesisTime(genesisTime uint64) {
+	r.ForkChoicer.SetGenesisTime(genesisTime)
+	r.record(&Event{Type: EventGenesisTime, GenesisTime: genesisTime})
+}
+
+// SetOriginRoot records the origin root and sets it in the wrapped store.
+func (r *Recorder) SetOriginRoot(root [32]byte) {
+	r.ForkChoicer.SetOriginRoot(root)
+	r.record(&Event{Type: EventOriginRoot, Root: root[:]})
+}
+
+// SetBalancesByRooter sets a balances by rooter in the wrapped store, which records the balances
+// returned by the given one.
+func (r *Recorder) SetBalancesByRooter(handler forkchoice.BalancesByRooter) {
+	r.ForkChoicer.SetBalancesByRooter(func(ctx context.Context, root [32]byte) ([]uint64, error) {
+		balances, err := handler(ctx, root)
+		if err == nil {
+			r.record(&Event{Type: EventBalances, Root: root[:], Balances: balances})
+		}
+		return balances, err
+	})
+}
+
+// InsertNode records the block of the given post state, and inserts it in the wrapped store.
+func (r *Recorder) InsertNode(ctx context.Context, st state.BeaconState, root [32]byte) error {
+	err := r.ForkChoicer.InsertNode(ctx, st, root)
+	b, recordErr := blockFromState(st, root)
+	if recordErr != nil {
+		log.WithError(recordErr).Error("Could not record inserted block")
+		return err
+	}
+	// The store only computes the unrealized checkpoints of the post state when it needs them,
+	// and they are only recorded then.
+	uj, uf := r.ForkChoicer.InsertedUnrealizedCheckpoints()
+	b.UnrealizedJustified = checkpointFromProto(uj)
+	b.UnrealizedFinalized = checkpointFromProto(uf)
+	boost := r.ForkChoicer.ProposerBoost()
+	r.record(&Event{Type: EventInsertNode, Block: b, ProposerBoost: boost[:]})
+	return err
+}
+
+// InsertChain records the blocks of the given chain, and inserts them in the wrapped store.
+// As in the store, the last block of the chain is not inserted.
+func (r *Recorder) InsertChain(ctx context.Context, chain []*forkchoicetypes.BlockAndCheckpoints) error {
+	err := r.ForkChoicer.InsertChain(ctx, chain)
+	recorded := make([]*Block, 0, len(chain))
+	for i := len(chain) - 1; i > 0; i-- {
+		b := chain[i].Block
+		root := chain[i-1].Block.ParentRoot()
+		parentRoot := b.ParentRoot()
+		payloadHash, hashErr := blocks.GetBlockPayloadHash(b)
+		if hashErr != nil {
+			log.WithError(hashErr).Error("Could not record inserted chain")
+			return err
+		}
+		recorded = append(recorded, &Block{
+			Slot:        b.Slot(),
+			Root:        root[:],
+			ParentRoot:  parentRoot[:],
+			PayloadHash: payloadHash[:],
+			Justified:   checkpointFromProto(chain[i].JustifiedCheckpoint),
+			Finalized:   checkpointFromProto(chain[i].FinalizedCheckpoint),
+		})
+	}
+	r.record(&Event{Type: EventInsertChain, Chain: recorded})
+	return err
+}
+
+// ProcessAttestation records the votes of the given validators, and processes them in the wrapped store.
+func (r *Recorder) ProcessAttestation(ctx context.Context, indices []uint64, root [32]byte, targetEpoch primitives.Epoch) {
+	r.ForkChoicer.ProcessAttestation(ctx, indices, root, targetEpoch)
+	r.record(&Event{Type: EventAttestation, Indices: indices, Root: root[:], Epoch: targetEpoch})
+}
+
+// NewSlot records the start of the given slot, and starts it in the wrapped store.
+func (r *Recorder) NewSlot(ctx context.Context, slot primitives.Slot) error {
+	err := r.ForkChoicer.NewSlot(ctx, slot)
+	r.record(&Event{Type: EventNewSlot, Slot: slot})
+	return err
+}
+
+// UpdateJustifiedCheckpoint records the given checkpoint, and sets it in the wrapped store.
+func (r *Recorder) UpdateJustifiedCheckpoint(ctx context.Context, cp *forkchoicetypes.Checkpoint) error {
+	err := r.ForkChoicer.UpdateJustifiedCheckpoint(ctx, cp)
+	r.record(&Event{Type: EventJustifiedCheckpoint, Checkpoint: &Checkpoint{Epoch: cp.Epoch, Root: cp.Root[:]}})
+	return err
+}
+
+// UpdateFinalizedCheckpoint records the given checkpoint, and sets it in the wrapped store.
+func (r *Recorder) UpdateFinalizedCheckpoint(cp *forkchoicetypes.Checkpoint) error {
+	err := r.ForkChoicer.UpdateFinalizedCheckpoint(cp)
+	r.record(&Event{Type: EventFinalizedCheckpoint, Checkpoint: &Checkpoint{Epoch: cp.Epoch, Root: cp.Root[:]}})
+	return err
+}
+
+// InsertSlashedIndex records the given slashed validator, and inserts it in the wrapped store.
+func (r *Recorder) InsertSlashedIndex(ctx context.Context, index primitives.ValidatorIndex) {
+	r.ForkChoicer.InsertSlashedIndex(ctx, index)
+	r.record(&Event{Type: EventSlashedIndex, ValidatorIndex: index})
+}
+
+// SetOptimisticToValid records the given valid block, and sets it valid in the wrapped store.
+func (r *Recorder) SetOptimisticToValid(ctx context.Context, root [fieldparams.RootLength]byte) error {
+	err := r.ForkChoicer.SetOptimisticToValid(ctx, root)
+	r.record(&Event{Type: EventOptimisticToValid, Root: root[:]})
+	return err
+}
+
+// SetOptimisticToInvalid records the given invalid block, and sets it invalid in the wrapped store.
+func (r *Recorder) SetOptimisticToInvalid(
+	ctx context.Context, root, parentRoot, payloadHash [fieldparams.RootLength]byte,
+) ([][32]byte, error) {
+	invalidRoots, err := r.ForkChoicer.SetOptimisticToInvalid(ctx, root, parentRoot, payloadHash)
+	r.record(&Event{Type: EventOptimisticToInvalid, Root: root[:], ParentRoot: parentRoot[:], PayloadHash: payloadHash[:]})
+	return invalidRoots, err
+}
+
+// Head computes the head in the wrapped store, and records it.
+func (r *Recorder) Head(ctx context.Context) ([32]byte, error) {
+	head, err := r.ForkChoicer.Head(ctx)
+	if err == nil {
+		r.record(&Event{Type: EventHead, Root: head[:]})
+	}
+	return head, err
+}
+
+// Reads the block inserted in fork choice from its post state, as the store does.
+func blockFromState(st state.BeaconState, root [32]byte) (*Block, error) {
+	bh := st.LatestBlockHeader()
+	if bh == nil {
+		return nil, errors.New("nil latest block header")
+	}
+	var payloadHash [32]byte
+	if st.Version() >= version.Bellatrix {
+		ph, err := st.LatestExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		if ph != nil {
+			copy(payloadHash[:], ph.BlockHash())
+		}
+	}
+	b := &Block{
+		Slot:        st.Slot(),
+		Root:        root[:],
+		ParentRoot:  bytesutil.SafeCopyBytes(bh.ParentRoot),
+		PayloadHash: payloadHash[:],
+		Justified:   checkpointFromProto(st.CurrentJustifiedCheckpoint()),
+		Finalized:   checkpointFromProto(st.FinalizedCheckpoint()),
+	}
+	return b, nil
+}
@@ -0,0 +1,148 @@
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockForkChoicer struct {
+	forkchoice.ForkChoicer
+	head                [32]byte
+	balances            forkchoice.BalancesByRooter
+	unrealizedJustified *ethpb.Checkpoint
+	unrealizedFinalized *ethpb.Checkpoint
+}
+
+func (m *mockForkChoicer) InsertNode(_ context.Context, _ state.BeaconState, _ [32]byte) error {
+	return nil
+}
+
+func (m *mockForkChoicer) InsertedUnrealizedCheckpoints() (*ethpb.Checkpoint, *ethpb.Checkpoint) {
+	return m.unrealizedJustified, m.unrealizedFinalized
+}
+
+func (m *mockForkChoicer) ProposerBoost() [32]byte {
+	return [32]byte{}
+}
+
+func (m *mockForkChoicer) SetBalancesByRooter(handler forkchoice.BalancesByRooter) {
+	m.balances = handler
+}
+
+func (m *mockForkChoicer) ProcessAttestation(_ context.Context, _ []uint64, _ [32]byte, _ primitives.Epoch) {
+}
+
+func (m *mockForkChoicer) Head(ctx context.Context) ([32]byte, error) {
+	if _, err := m.balances(ctx, m.head); err != nil {
+		return [32]byte{}, err
+	}
+	return m.head, nil
+}
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "forkchoice.json")
+	fc := &mockForkChoicer{head: [32]byte{'a'}}
+	r, err := New(fc, path)
+	require.NoError(t, err)
+	r.SetBalancesByRooter(func(_ context.Context, _ [32]byte) ([]uint64, error) {
+		return []uint64{1, 2}, nil
+	})
+	r.ProcessAttestation(ctx, []uint64{3, 4}, [32]byte{'b'}, 5)
+	head, err := r.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, fc.head, head)
+	require.NoError(t, r.Close())
+
+	enc, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(enc)), "\n")
+	require.Equal(t, 3, len(lines))
+	events := make([]*Event, len(lines))
+	for i, line := range lines {
+		events[i] = &Event{}
+		require.NoError(t, json.Unmarshal([]byte(line), events[i]))
+		require.NotEqual(t, int64(0), events[i].Time)
+	}
+
+	require.Equal(t, EventAttestation, events[0].Type)
+	require.DeepEqual(t, []uint64{3, 4}, events[0].Indices)
+	require.Equal(t, primitives.Epoch(5), events[0].Epoch)
+	// The balances fetched while computing the head are recorded before it.
+	require.Equal(t, EventBalances, events[1].Type)
+	require.DeepEqual(t, []uint64{1, 2}, events[1].Balances)
+	require.Equal(t, EventHead, events[2].Type)
+	require.DeepEqual(t, fc.head[:], []byte(events[2].Root))
+}
+
+func TestRecorder_InsertNode(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "forkchoice.json")
+	fc := &mockForkChoicer{}
+	r, err := New(fc, path)
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(3))
+
+	// The unrealized checkpoints are only recorded when the store computed them.
+	require.NoError(t, r.InsertNode(ctx, st, [32]byte{'a'}))
+	fc.unrealizedJustified = &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)}
+	fc.unrealizedFinalized = &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)}
+	require.NoError(t, r.InsertNode(ctx, st, [32]byte{'b'}))
+	require.NoError(t, r.Close())
+
+	enc, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(enc)), "\n")
+	require.Equal(t, 2, len(lines))
+	events := make([]*Event, len(lines))
+	for i, line := range lines {
+		events[i] = &Event{}
+		require.NoError(t, json.Unmarshal([]byte(line), events[i]))
+		require.Equal(t, EventInsertNode, events[i].Type)
+		require.Equal(t, primitives.Slot(3), events[i].Block.Slot)
+	}
+	require.Equal(t, true, events[0].Block.UnrealizedJustified == nil)
+	require.Equal(t, true, events[0].Block.UnrealizedFinalized == nil)
+	require.Equal(t, primitives.Epoch(1), events[1].Block.UnrealizedJustified.Epoch)
+	require.Equal(t, primitives.Epoch(0), events[1].Block.UnrealizedFinalized.Epoch)
+}
+
+func TestRecorder_FlushInterval(t *testing.T) {
+	defer func(interval time.Duration) {
+		flushInterval = interval
+	}(flushInterval)
+	flushInterval = 10 * time.Millisecond
+
+	path := filepath.Join(t.TempDir(), "forkchoice.json")
+	r, err := New(&mockForkChoicer{}, path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	r.ProcessAttestation(context.Background(), []uint64{3, 4}, [32]byte{'b'}, 5)
+
+	// The buffered event is written to the file without waiting for the buffer to be full.
+	var enc []byte
+	for i := 0; i < 100 && len(enc) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		enc, err = os.ReadFile(path)
+		require.NoError(t, err)
+	}
+	e := &Event{}
+	require.NoError(t, json.Unmarshal(enc, e))
+	require.Equal(t, EventAttestation, e.Type)
+}
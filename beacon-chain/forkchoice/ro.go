@@ -149,6 +149,13 @@ func (ro *ROForkChoice) ShouldOverrideFCU() bool {
 	return ro.getter.ShouldOverrideFCU()
 }
 
+// ShouldOverrideFCUDecision delegates to the underlying forkchoice call, under a lock.
+func (ro *ROForkChoice) ShouldOverrideFCUDecision() *forkchoicetypes.LateBlockReorgDecision {
+	ro.l.RLock()
+	defer ro.l.RUnlock()
+	return ro.getter.ShouldOverrideFCUDecision()
+}
+
 // Slot delegates to the underlying forkchoice call, under a lock.
 func (ro *ROForkChoice) Slot(root [32]byte) (primitives.Slot, error) {
 	ro.l.RLock()
@@ -34,6 +34,7 @@ const (
 	weightCalled
 	isOptimisticCalled
 	shouldOverrideFCUCalled
+	shouldOverrideFCUDecisionCalled
 	slotCalled
 	lastRootCalled
 	targetRootForEpochCalled
@@ -140,6 +141,11 @@ func TestROLocking(t *testing.T) {
 			call: shouldOverrideFCUCalled,
 			cb:   func(g FastGetter) { g.ShouldOverrideFCU() },
 		},
+		{
+			name: "shouldOverrideFCUDecisionCalled",
+			call: shouldOverrideFCUDecisionCalled,
+			cb:   func(g FastGetter) { g.ShouldOverrideFCUDecision() },
+		},
 		{
 			name: "slotCalled",
 			call: slotCalled,
@@ -277,6 +283,11 @@ func (ro *mockROForkchoice) ShouldOverrideFCU() bool {
 	return false
 }
 
+func (ro *mockROForkchoice) ShouldOverrideFCUDecision() *forkchoicetypes.LateBlockReorgDecision {
+	ro.calls = append(ro.calls, shouldOverrideFCUDecisionCalled)
+	return nil
+}
+
 func (ro *mockROForkchoice) Slot(_ [32]byte) (primitives.Slot, error) {
 	ro.calls = append(ro.calls, slotCalled)
 	return 0, nil
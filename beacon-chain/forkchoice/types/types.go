@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -21,3 +23,26 @@ type BlockAndCheckpoints struct {
 	JustifiedCheckpoint *ethpb.Checkpoint
 	FinalizedCheckpoint *ethpb.Checkpoint
 }
+
+// LateBlockReorgDecision is the decision to orphan, or not, a head block which arrived late, with
+// the inputs it was taken from.
+type LateBlockReorgDecision struct {
+	// Proposing is set for the decision of the parent of a block to propose, and unset for the
+	// decision to override the forkchoice update sent to the execution client.
+	Proposing    bool
+	HeadRoot     [fieldparams.RootLength]byte
+	HeadSlot     primitives.Slot
+	HeadWeight   uint64
+	ParentRoot   [fieldparams.RootLength]byte
+	ParentSlot   primitives.Slot
+	ParentWeight uint64
+	// HeadArrival is the time into its slot at which the head block arrived.
+	HeadArrival time.Duration
+	// TimeIntoSlot is the time into the current slot at which the decision was taken.
+	TimeIntoSlot    time.Duration
+	CommitteeWeight uint64
+	FinalizedEpoch  primitives.Epoch
+	Reorg           bool
+	// Reason is why the head block is not orphaned, unset when it is.
+	Reason string
+}
@@ -0,0 +1,81 @@
+package monitor
+
+import (
+	"context"
+
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// epochPerformance returns the performance of a tracked validator in the given epoch, which is
+// kept in memory until the epoch is saved. It assumes the service Lock is held.
+func (s *Service) epochPerformance(idx primitives.ValidatorIndex, epoch primitives.Epoch) *monitortypes.EpochPerformance {
+	if s.history == nil {
+		s.history = make(map[primitives.Epoch]map[primitives.ValidatorIndex]*monitortypes.EpochPerformance)
+	}
+	performances, ok := s.history[epoch]
+	if !ok {
+		performances = make(map[primitives.ValidatorIndex]*monitortypes.EpochPerformance)
+		s.history[epoch] = performances
+	}
+	p, ok := performances[idx]
+	if !ok {
+		p = &monitortypes.EpochPerformance{ValidatorIndex: idx, Epoch: epoch}
+		performances[idx] = p
+	}
+	return p
+}
+
+// recordBalance records the balance of a tracked validator observed in a block of the given slot.
+// It assumes the service Lock is held.
+func (s *Service) recordBalance(idx primitives.ValidatorIndex, slot primitives.Slot, balance uint64, balanceChange int64) {
+	p := s.epochPerformance(idx, slots.ToEpoch(slot))
+	p.Balance = balance
+	p.BalanceChange += balanceChange
+}
+
+// saveHistory saves the performances of the epochs which can no longer change once a block of the
+// given epoch is processed, the attestations of an epoch being included until the end of the next
+// epoch. The performances older than the configured number of history epochs are then deleted.
+func (s *Service) saveHistory(ctx context.Context, epoch primitives.Epoch) {
+	s.Lock()
+	performances := make([]*monitortypes.EpochPerformance, 0)
+	for ; s.historyEpoch+2 <= epoch; s.historyEpoch++ {
+		e := s.historyEpoch
+		epochPerformances := s.history[e]
+		// The duties of the epochs fully observed by the monitor were missed by the validators
+		// without a performance.
+		if e > s.historyStartEpoch {
+			for idx := range s.TrackedValidators {
+				if _, ok := epochPerformances[idx]; !ok {
+					performances = append(performances, &monitortypes.EpochPerformance{ValidatorIndex: idx, Epoch: e})
+				}
+			}
+		}
+		for _, p := range epochPerformances {
+			performances = append(performances, p)
+		}
+		delete(s.history, e)
+	}
+	s.Unlock()
+
+	if len(performances) == 0 || s.config.BeaconDB == nil || s.config.HistoryEpochs == 0 {
+		return
+	}
+	if err := s.config.BeaconDB.SaveValidatorPerformances(ctx, performances); err != nil {
+		log.WithError(err).Error("Could not save validator performances")
+		return
+	}
+	if epoch > s.config.HistoryEpochs {
+		if err := s.config.BeaconDB.DeleteValidatorPerformancesBefore(ctx, epoch-s.config.HistoryEpochs); err != nil {
+			log.WithError(err).Error("Could not delete old validator performances")
+			return
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":           epoch,
+		"numPerformances": len(performances),
+	}).Debug("Saved validator performances")
+}
@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSaveHistory(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	beaconDB := s.config.BeaconDB
+	s.config.HistoryEpochs = 3
+	s.historyEpoch = 1
+	s.historyStartEpoch = 1
+
+	s.Lock()
+	for epoch := primitives.Epoch(1); epoch < 6; epoch++ {
+		p := s.epochPerformance(1, epoch)
+		p.Attested = true
+		p.AttestedSlot = primitives.Slot(epoch * 32)
+		p.InclusionSlot = primitives.Slot(epoch*32 + 1)
+		s.recordBalance(1, primitives.Slot(epoch*32), 32000000000, 10)
+	}
+	s.Unlock()
+
+	// The attestations of epoch 2 can still be included in epoch 3.
+	s.saveHistory(ctx, 3)
+	saved, err := beaconDB.ValidatorPerformances(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	require.DeepEqual(t, &monitortypes.EpochPerformance{
+		ValidatorIndex: 1,
+		Epoch:          1,
+		Attested:       true,
+		AttestedSlot:   32,
+		InclusionSlot:  33,
+		Balance:        32000000000,
+		BalanceChange:  10,
+	}, saved[0])
+	require.Equal(t, uint64(1), saved[0].InclusionDistance())
+	// The monitor started in epoch 1, so the validators without performance may have attested.
+	saved, err = beaconDB.ValidatorPerformances(ctx, 2, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(saved))
+
+	s.saveHistory(ctx, 5)
+	saved, err = beaconDB.ValidatorPerformances(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(saved))
+	require.Equal(t, primitives.Epoch(2), saved[0].Epoch)
+	require.Equal(t, primitives.Epoch(3), saved[1].Epoch)
+	// The validators without performance in a fully observed epoch missed their attestation.
+	saved, err = beaconDB.ValidatorPerformances(ctx, 2, 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*monitortypes.EpochPerformance{{ValidatorIndex: 2, Epoch: 2}, {ValidatorIndex: 2, Epoch: 3}}, saved)
+
+	s.Lock()
+	require.Equal(t, 2, len(s.history))
+	require.Equal(t, primitives.Epoch(4), s.historyEpoch)
+	s.Unlock()
+}
@@ -92,8 +92,9 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			latestPerf.inclusionSlot = state.Slot()
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
+			s.recordBalance(primitives.ValidatorIndex(idx), state.Slot(), balance, balanceChg)
 
-			if state.Version() == version.Altair {
+			if state.Version() >= version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
 				sourceIdx := params.BeaconConfig().TimelySourceFlagIndex
 				headIdx := params.BeaconConfig().TimelyHeadFlagIndex
@@ -146,6 +147,14 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 					aggregatedPerf.totalCorrectTarget++
 				}
 			}
+			epochPerf := s.epochPerformance(primitives.ValidatorIndex(idx), slots.ToEpoch(latestPerf.attestedSlot))
+			epochPerf.Attested = true
+			epochPerf.AttestedSlot = latestPerf.attestedSlot
+			epochPerf.InclusionSlot = latestPerf.inclusionSlot
+			epochPerf.CorrectSource = latestPerf.timelySource
+			epochPerf.CorrectTarget = latestPerf.timelyTarget
+			epochPerf.CorrectHead = latestPerf.timelyHead
+
 			logFields["correctHead"] = latestPerf.timelyHead
 			logFields["correctSource"] = latestPerf.timelySource
 			logFields["correctTarget"] = latestPerf.timelyTarget
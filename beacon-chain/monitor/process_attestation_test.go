@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/go-bitfield"
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -59,6 +60,17 @@ func TestProcessIncludedAttestationTwoTracked(t *testing.T) {
 	wanted2 := "\"Attestation included\" balanceChange=100000000 correctHead=true correctSource=true correctTarget=true head=0x68656c6c6f2d inclusionSlot=2 newBalance=32000000000 prefix=monitor slot=1 source=0x68656c6c6f2d target=0x68656c6c6f2d validatorIndex=12"
 	require.LogsContain(t, hook, wanted1)
 	require.LogsContain(t, hook, wanted2)
+	require.DeepEqual(t, &monitortypes.EpochPerformance{
+		ValidatorIndex: 2,
+		Epoch:          0,
+		Attested:       true,
+		AttestedSlot:   1,
+		InclusionSlot:  2,
+		CorrectSource:  true,
+		CorrectTarget:  true,
+		CorrectHead:    true,
+		Balance:        32000000000,
+	}, s.history[0][2])
 }
 
 func TestProcessUnaggregatedAttestationStateNotCached(t *testing.T) {
@@ -61,6 +61,7 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 	if blk.Slot()%(AggregateReportingPeriod*params.BeaconConfig().SlotsPerEpoch) == 0 {
 		s.logAggregatedPerformance()
 	}
+	s.saveHistory(ctx, currEpoch)
 }
 
 // processProposedBlock logs when the beacon node observes a beacon block from a tracked validator.
@@ -83,6 +84,9 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 		latestPerf.balanceChange = balanceChg
 		latestPerf.balance = balance
 		s.latestPerformance[blk.ProposerIndex()] = latestPerf
+		s.recordBalance(blk.ProposerIndex(), blk.Slot(), balance, balanceChg)
+		epochPerf := s.epochPerformance(blk.ProposerIndex(), slots.ToEpoch(blk.Slot()))
+		epochPerf.ProposedSlots = append(epochPerf.ProposedSlots, blk.Slot())
 
 		aggPerf := s.aggregatedPerformance[blk.ProposerIndex()]
 		aggPerf.totalProposedCount++
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
 )
 
@@ -60,6 +61,10 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.R
 			latestPerf.balanceChange = balanceChg
 			latestPerf.balance = balance
 			s.latestPerformance[validatorIdx] = latestPerf
+			s.recordBalance(validatorIdx, blk.Slot(), balance, balanceChg)
+			epochPerf := s.epochPerformance(validatorIdx, slots.ToEpoch(blk.Slot()))
+			epochPerf.SyncCommitteeExpected += uint64(len(committeeIndices))
+			epochPerf.SyncCommitteeIncluded += uint64(contrib)
 
 			aggPerf := s.aggregatedPerformance[validatorIdx]
 			aggPerf.totalSyncCommitteeContributions += uint64(contrib)
@@ -12,6 +12,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -59,6 +61,10 @@ type ValidatorMonitorConfig struct {
 	HeadFetcher         blockchain.HeadFetcher
 	StateGen            stategen.StateManager
 	InitialSyncComplete chan struct{}
+	// BeaconDB stores the performances of the tracked validators in each epoch, for the last
+	// HistoryEpochs epochs. The performances are not stored if HistoryEpochs is zero.
+	BeaconDB      db.NoHeadAccessDatabase
+	HistoryEpochs primitives.Epoch
 }
 
 // Service is the main structure that tracks validators and reports logs and
@@ -70,7 +76,7 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, lastSyncedEpoch and the history
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
@@ -78,6 +84,12 @@ type Service struct {
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
+
+	// history holds the performances of the epochs not saved yet, historyEpoch being the next
+	// epoch to save and historyStartEpoch the epoch at which the monitor started.
+	history           map[primitives.Epoch]map[primitives.ValidatorIndex]*monitortypes.EpochPerformance
+	historyEpoch      primitives.Epoch
+	historyStartEpoch primitives.Epoch
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -91,6 +103,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		latestPerformance:           make(map[primitives.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[primitives.ValidatorIndex]ValidatorAggregatedPerformance),
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
+		history:                     make(map[primitives.Epoch]map[primitives.ValidatorIndex]*monitortypes.EpochPerformance),
 		isLogging:                   false,
 	}
 	for _, idx := range tracked {
@@ -138,6 +151,8 @@ func (s *Service) run() {
 
 	s.Lock()
 	s.initializePerformanceStructures(st, epoch)
+	s.historyEpoch = epoch
+	s.historyStartEpoch = epoch
 	s.Unlock()
 
 	s.updateSyncCommitteeTrackedVals(st)
@@ -94,6 +94,7 @@ func setupService(t *testing.T) *Service {
 			HeadFetcher:         chainService,
 			AttestationNotifier: chainService.OperationNotifier(),
 			InitialSyncComplete: make(chan struct{}),
+			BeaconDB:            beaconDB,
 		},
 
 		ctx:                         context.Background(),
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// EpochPerformance is the outcome of the duties of a tracked validator in an epoch, as observed by
+// the validator monitor. The attestation fields are those of the attestation for the epoch, which
+// may be included in a block of the next epoch. The proposals, sync committee contributions and
+// balance are those observed in the blocks of the epoch.
+type EpochPerformance struct {
+	ValidatorIndex primitives.ValidatorIndex `json:"validator_index"`
+	Epoch          primitives.Epoch          `json:"epoch"`
+
+	// Attested is set when an attestation of the validator for the epoch was included in a block.
+	Attested      bool            `json:"attested"`
+	AttestedSlot  primitives.Slot `json:"attested_slot,omitempty"`
+	InclusionSlot primitives.Slot `json:"inclusion_slot,omitempty"`
+	CorrectSource bool            `json:"correct_source"`
+	CorrectTarget bool            `json:"correct_target"`
+	CorrectHead   bool            `json:"correct_head"`
+
+	ProposedSlots []primitives.Slot `json:"proposed_slots,omitempty"`
+
+	// SyncCommitteeExpected is the number of contributions expected from the validator in the sync
+	// aggregates of the blocks of the epoch, of which SyncCommitteeIncluded were included.
+	SyncCommitteeExpected uint64 `json:"sync_committee_expected"`
+	SyncCommitteeIncluded uint64 `json:"sync_committee_included"`
+
+	// Balance is the last balance observed in the epoch, and BalanceChange the sum of the changes
+	// observed in the epoch.
+	Balance       uint64 `json:"balance"`
+	BalanceChange int64  `json:"balance_change"`
+}
+
+// InclusionDistance returns the number of slots between the attestation and its inclusion.
+func (p *EpochPerformance) InclusionDistance() uint64 {
+	if !p.Attested || p.InclusionSlot < p.AttestedSlot {
+		return 0
+	}
+	return uint64(p.InclusionSlot - p.AttestedSlot)
+}
@@ -2,8 +2,10 @@ package node
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -211,3 +213,48 @@ func configureExecutionSetting(cliCtx *cli.Context) error {
 		" Default fee recipient will be used as a fall back", checksumAddress.Hex())
 	return params.SetActive(c)
 }
+
+// Applies the late block reorg flags to the beacon config, and validates the resulting policy,
+// whether it comes from the flags or from the chain config file.
+func configureLateBlockReorg(cliCtx *cli.Context) error {
+	c := params.BeaconConfig().Copy()
+	if cliCtx.IsSet(flags.ReorgWeightThresholdFlag.Name) {
+		c.ReorgWeightThreshold = cliCtx.Uint64(flags.ReorgWeightThresholdFlag.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgParentWeightThresholdFlag.Name) {
+		c.ReorgParentWeightThreshold = cliCtx.Uint64(flags.ReorgParentWeightThresholdFlag.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgMaxEpochsSinceFinalizationFlag.Name) {
+		c.ReorgMaxEpochsSinceFinalization = primitives.Epoch(cliCtx.Uint64(flags.ReorgMaxEpochsSinceFinalizationFlag.Name))
+	}
+	if cliCtx.IsSet(flags.ReorgLateBlockCutoffMillisFlag.Name) {
+		c.ReorgLateBlockCutoffMillis = cliCtx.Uint64(flags.ReorgLateBlockCutoffMillisFlag.Name)
+	}
+	if cliCtx.IsSet(flags.ReorgProposerCutoffMillisFlag.Name) {
+		c.ReorgProposerCutoffMillis = cliCtx.Uint64(flags.ReorgProposerCutoffMillisFlag.Name)
+	}
+	if err := validateLateBlockReorg(c); err != nil {
+		return err
+	}
+	return params.SetActive(c)
+}
+
+func validateLateBlockReorg(c *params.BeaconChainConfig) error {
+	if c.ReorgWeightThreshold > 100 {
+		return errors.Errorf("reorg weight threshold %d is not a percentage of the committee weight", c.ReorgWeightThreshold)
+	}
+	if c.ReorgParentWeightThreshold <= c.ReorgWeightThreshold {
+		return errors.Errorf("reorg parent weight threshold %d must be greater than the reorg weight threshold %d",
+			c.ReorgParentWeightThreshold, c.ReorgWeightThreshold)
+	}
+	slotDuration := time.Duration(c.SecondsPerSlot) * time.Second
+	if c.ReorgLateBlockCutoff() >= slotDuration {
+		return errors.Errorf("reorg late block cutoff %s must be shorter than a slot", c.ReorgLateBlockCutoff())
+	}
+	attestationDeadline := slotDuration / time.Duration(c.IntervalsPerSlot)
+	if c.ReorgProposerCutoff() > attestationDeadline {
+		return errors.Errorf("reorg proposer cutoff %s must not be after the attestation deadline %s",
+			c.ReorgProposerCutoff(), attestationDeadline)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
@@ -228,3 +229,74 @@ func TestConfigureInterop(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureLateBlockReorg(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.ReorgWeightThresholdFlag.Name, 0, "")
+	set.Uint64(flags.ReorgParentWeightThresholdFlag.Name, 0, "")
+	set.Uint64(flags.ReorgMaxEpochsSinceFinalizationFlag.Name, 0, "")
+	set.Uint64(flags.ReorgLateBlockCutoffMillisFlag.Name, 0, "")
+	set.Uint64(flags.ReorgProposerCutoffMillisFlag.Name, 0, "")
+	require.NoError(t, set.Set(flags.ReorgWeightThresholdFlag.Name, "30"))
+	require.NoError(t, set.Set(flags.ReorgParentWeightThresholdFlag.Name, "150"))
+	require.NoError(t, set.Set(flags.ReorgMaxEpochsSinceFinalizationFlag.Name, "3"))
+	require.NoError(t, set.Set(flags.ReorgLateBlockCutoffMillisFlag.Name, "3500"))
+	require.NoError(t, set.Set(flags.ReorgProposerCutoffMillisFlag.Name, "1500"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureLateBlockReorg(cliCtx))
+
+	c := params.BeaconConfig()
+	assert.Equal(t, uint64(30), c.ReorgWeightThreshold)
+	assert.Equal(t, uint64(150), c.ReorgParentWeightThreshold)
+	assert.Equal(t, primitives.Epoch(3), c.ReorgMaxEpochsSinceFinalization)
+	assert.Equal(t, 3500*time.Millisecond, c.ReorgLateBlockCutoff())
+	assert.Equal(t, 1500*time.Millisecond, c.ReorgProposerCutoff())
+}
+
+func TestConfigureLateBlockReorg_ShortSlotDefaults(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SecondsPerSlot = 3
+	params.OverrideBeaconConfig(cfg)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureLateBlockReorg(cliCtx))
+
+	c := params.BeaconConfig()
+	assert.Equal(t, time.Second, c.ReorgLateBlockCutoff())
+	assert.Equal(t, 500*time.Millisecond, c.ReorgProposerCutoff())
+}
+
+func TestConfigureLateBlockReorg_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		flag  string
+		value string
+		err   string
+	}{
+		{name: "weight threshold above 100", flag: flags.ReorgWeightThresholdFlag.Name, value: "101", err: "not a percentage"},
+		{name: "parent weight threshold too low", flag: flags.ReorgParentWeightThresholdFlag.Name, value: "20", err: "must be greater than"},
+		{name: "late block cutoff after slot", flag: flags.ReorgLateBlockCutoffMillisFlag.Name, value: "12000", err: "must be shorter than a slot"},
+		{name: "proposer cutoff after attestation deadline", flag: flags.ReorgProposerCutoffMillisFlag.Name, value: "4001", err: "must not be after the attestation deadline"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params.SetupTestConfigCleanup(t)
+
+			app := cli.App{}
+			set := flag.NewFlagSet("test", 0)
+			set.Uint64(tt.flag, 0, "")
+			require.NoError(t, set.Set(tt.flag, tt.value))
+			cliCtx := cli.NewContext(&app, set, nil)
+
+			require.ErrorContains(t, tt.err, configureLateBlockReorg(cliCtx))
+		})
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/recorder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/gateway"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/node/registration"
@@ -115,6 +116,7 @@ type BeaconNode struct {
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
 	forkChoicer             forkchoice.ForkChoicer
+	forkChoiceRecorder      *recorder.Recorder
 	clockWaiter             startup.ClockWaiter
 	BackfillOpts            []backfill.ServiceOption
 	initialSyncComplete     chan struct{}
@@ -169,6 +171,16 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 	synchronizer := startup.NewClockSynchronizer()
 	beacon.clockWaiter = synchronizer
 	beacon.forkChoicer = doublylinkedtree.New()
+	if cliCtx.IsSet(flags.ForkChoiceRecordFileFlag.Name) {
+		path := cliCtx.String(flags.ForkChoiceRecordFileFlag.Name)
+		r, err := recorder.New(beacon.forkChoicer, path)
+		if err != nil {
+			return nil, err
+		}
+		log.WithField("path", path).Info("Recording fork choice inputs")
+		beacon.forkChoiceRecorder = r
+		beacon.forkChoicer = r
+	}
 
 	depositAddress, err := execution.DepositContractAddress()
 	if err != nil {
@@ -277,6 +289,10 @@ func configureBeacon(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not configure execution setting")
 	}
 
+	if err := configureLateBlockReorg(cliCtx); err != nil {
+		return errors.Wrap(err, "could not configure late block reorg")
+	}
+
 	return nil
 }
 
@@ -470,6 +486,11 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.WithError(err).Error("Failed to close database")
 	}
+	if b.forkChoiceRecorder != nil {
+		if err := b.forkChoiceRecorder.Close(); err != nil {
+			log.WithError(err).Error("Failed to close fork choice record file")
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
@@ -686,28 +707,35 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:          cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:          slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		Discv5BootStrapAddrs: p2p.ParseBootStrapAddrs(bootstrapNodeAddrs),
-		RelayNodeAddr:        cliCtx.String(cmd.RelayNode.Name),
-		DataDir:              dataDir,
-		LocalIP:              cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:          cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:              cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:           cliCtx.String(cmd.P2PPrivKey.Name),
-		StaticPeerID:         cliCtx.Bool(cmd.P2PStaticID.Name),
-		MetaDataDir:          cliCtx.String(cmd.P2PMetadata.Name),
-		QUICPort:             cliCtx.Uint(cmd.P2PQUICPort.Name),
-		TCPPort:              cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:              cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:             cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		QueueSize:            cliCtx.Uint(cmd.PubsubQueueSize.Name),
-		AllowListCIDR:        cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:         slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:           cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		StateNotifier:        b,
-		DB:                   b.db,
-		ClockWaiter:          b.clockWaiter,
+		NoDiscovery:            cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:            slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		Sentries:               slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PSentry.Name)),
+		PrivatePeers:           slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PPrivatePeerID.Name)),
+		Discv5BootStrapAddrs:   p2p.ParseBootStrapAddrs(bootstrapNodeAddrs),
+		RelayNodeAddr:          cliCtx.String(cmd.RelayNode.Name),
+		DataDir:                dataDir,
+		LocalIP:                cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:            cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:                cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:             cliCtx.String(cmd.P2PPrivKey.Name),
+		StaticPeerID:           cliCtx.Bool(cmd.P2PStaticID.Name),
+		MetaDataDir:            cliCtx.String(cmd.P2PMetadata.Name),
+		QUICPort:               cliCtx.Uint(cmd.P2PQUICPort.Name),
+		TCPPort:                cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:                cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:               cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		QueueSize:              cliCtx.Uint(cmd.PubsubQueueSize.Name),
+		PubsubTraceFile:        cliCtx.String(cmd.PubsubTraceFile.Name),
+		PubsubTraceMaxSize:     cliCtx.Uint64(cmd.PubsubTraceFileMaxSize.Name) * 1024 * 1024,
+		AllowListCIDR:          cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:           slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		InboundIPv4PrefixLimit: cliCtx.Uint(cmd.P2PInboundIPv4PrefixLimit.Name),
+		InboundIPv6PrefixLimit: cliCtx.Uint(cmd.P2PInboundIPv6PrefixLimit.Name),
+		DisablePeerPersistence: cliCtx.Bool(cmd.P2PDisablePeerPersistence.Name),
+		EnableUPnP:             cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		StateNotifier:          b,
+		DB:                     b.db,
+		ClockWaiter:            b.clockWaiter,
 	})
 	if err != nil {
 		return err
@@ -715,7 +743,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	return b.services.RegisterService(svc)
 }
 
-func (b *BeaconNode) fetchP2P() p2p.P2P {
+func (b *BeaconNode) fetchP2P() *p2p.Service {
 	var p *p2p.Service
 	if err := b.services.FetchService(&p); err != nil {
 		panic(err)
@@ -835,6 +863,14 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 		return err
 	}
 
+	var rateLimits *regularsync.RateLimitsConfig
+	if path := b.cliCtx.String(flags.ReqRespRateLimitsFile.Name); path != "" {
+		var err error
+		if rateLimits, err = regularsync.LoadRateLimitsConfig(path); err != nil {
+			return err
+		}
+	}
+
 	rs := regularsync.NewService(
 		b.ctx,
 		regularsync.WithDatabase(b.db),
@@ -859,6 +895,7 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 		regularsync.WithBlobStorage(b.BlobStorage),
 		regularsync.WithVerifierWaiter(b.verifyInitWaiter),
 		regularsync.WithAvailableBlocker(bFillStore),
+		regularsync.WithRateLimitsConfig(rateLimits),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -899,7 +936,7 @@ func (b *BeaconNode) registerSlasherService() error {
 		return err
 	}
 
-	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
+	slasherCfg := &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
 		Database:                b.slasherDB,
@@ -910,7 +947,12 @@ func (b *BeaconNode) registerSlasherService() error {
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
 		ClockWaiter:             b.clockWaiter,
-	})
+	}
+	if b.cliCtx.IsSet(flags.SlasherRescanFromEpochFlag.Name) {
+		slasherCfg.BeaconDB = b.db
+		slasherCfg.RescanFromEpoch = primitives.Epoch(b.cliCtx.Uint64(flags.SlasherRescanFromEpochFlag.Name))
+	}
+	slasherSrv, err := slasher.New(b.ctx, slasherCfg)
 	if err != nil {
 		return err
 	}
@@ -933,11 +975,13 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		return err
 	}
 
-	var slasherService *slasher.Service
+	var slasherQuerier slasher.Querier
 	if features.Get().EnableSlasher {
+		var slasherService *slasher.Service
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slasherQuerier = slasherService
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -965,6 +1009,11 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := !b.cliCtx.Bool(flags.DisableDebugRPCEndpoints.Name)
 
+	var regularSyncService *regularsync.Service
+	if err := b.services.FetchService(&regularSyncService); err != nil {
+		return err
+	}
+
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		ExecutionEngineCaller:         web3Service,
@@ -979,6 +1028,10 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		Broadcaster:                   p2pService,
 		PeersFetcher:                  p2pService,
 		PeerManager:                   p2pService,
+		ConnectionFilterManager:       p2pService,
+		BandwidthProvider:             p2pService,
+		CrawledNodesProvider:          p2pService,
+		RateLimitReporter:             regularSyncService,
 		MetadataProvider:              p2pService,
 		ChainInfoFetcher:              chainService,
 		HeadFetcher:                   chainService,
@@ -1011,11 +1064,16 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
 		MaxMsgSize:                    maxMsgSize,
 		BlockBuilder:                  b.fetchBuilderService(),
+		RelayHealthManager:            b.fetchBuilderService(),
+		SlasherQuerier:                slasherQuerier,
+		SlasherAttestationsFeed:       b.slasherAttestationsFeed,
+		SlasherBlockHeadersFeed:       b.slasherBlockHeadersFeed,
 		Router:                        router,
 		ClockWaiter:                   b.clockWaiter,
 		BlobStorage:                   b.BlobStorage,
 		TrackedValidatorsCache:        b.trackedValidatorsCache,
 		PayloadIDCache:                b.payloadIDCache,
+		PayloadDecisionHistoryEpochs:  primitives.Epoch(b.cliCtx.Uint64(flags.PayloadDecisionHistoryEpochs.Name)),
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -1133,6 +1191,8 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 		StateGen:            b.stateGen,
 		HeadFetcher:         chainService,
 		InitialSyncComplete: initialSyncComplete,
+		BeaconDB:            b.db,
+		HistoryEpochs:       primitives.Epoch(b.cliCtx.Uint64(cmd.ValidatorMonitorHistoryEpochsFlag.Name)),
 	}
 	svc, err := monitor.NewService(b.ctx, monitorConfig, tracked)
 	if err != nil {
@@ -0,0 +1,123 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+// bandwidthIdleTrim is the duration after which the meters of idle protocols, topics and peers are removed.
+const bandwidthIdleTrim = time.Hour
+
+// BandwidthUsage is the traffic of a protocol or a gossip topic. Totals are in bytes,
+// rates are in bytes per second.
+type BandwidthUsage struct {
+	TotalIn  int64
+	TotalOut int64
+	RateIn   float64
+	RateOut  float64
+}
+
+// BandwidthStats holds the traffic of the node, in total, for each libp2p protocol and for each gossip topic.
+// Gossip messages are accounted for both in the gossipsub protocol and in their topic.
+type BandwidthStats struct {
+	Total     BandwidthUsage
+	Protocols map[string]BandwidthUsage
+	Topics    map[string]BandwidthUsage
+}
+
+// topicBandwidth accounts the gossip traffic of each topic. Inbound messages are only
+// accounted for joined topics, to avoid tracking topics made up by peers.
+type topicBandwidth struct {
+	counter *metrics.BandwidthCounter
+	lock    sync.RWMutex
+	joined  map[string]bool
+}
+
+func newTopicBandwidth() *topicBandwidth {
+	return &topicBandwidth{
+		counter: metrics.NewBandwidthCounter(),
+		joined:  make(map[string]bool),
+	}
+}
+
+func (t *topicBandwidth) join(topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.joined[topic] = true
+}
+
+func (t *topicBandwidth) leave(topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.joined, topic)
+}
+
+// logRecv accounts a received gossip message. The sender of a received RPC is not exposed to
+// tracers, so inbound traffic is not attributed to a peer.
+func (t *topicBandwidth) logRecv(topic string, size int) {
+	t.lock.RLock()
+	joined := t.joined[topic]
+	t.lock.RUnlock()
+	if !joined {
+		return
+	}
+	t.counter.LogRecvMessageStream(int64(size), protocol.ID(topic), "")
+	p2pTopicBandwidth.WithLabelValues(topic, "inbound").Add(float64(size))
+}
+
+func (t *topicBandwidth) logSent(topic string, size int, p peer.ID) {
+	t.counter.LogSentMessageStream(int64(size), protocol.ID(topic), p)
+	p2pTopicBandwidth.WithLabelValues(topic, "outbound").Add(float64(size))
+}
+
+// BandwidthStats returns the traffic of the node, in total, for each protocol and for each gossip topic.
+func (s *Service) BandwidthStats() *BandwidthStats {
+	stats := &BandwidthStats{
+		Protocols: make(map[string]BandwidthUsage),
+		Topics:    make(map[string]BandwidthUsage),
+	}
+	if s.bandwidthCounter != nil {
+		stats.Total = bandwidthUsage(s.bandwidthCounter.GetBandwidthTotals())
+		for p, st := range s.bandwidthCounter.GetBandwidthByProtocol() {
+			stats.Protocols[string(p)] = bandwidthUsage(st)
+		}
+	}
+	if s.topicBandwidth != nil {
+		for topic, st := range s.topicBandwidth.counter.GetBandwidthByProtocol() {
+			stats.Topics[string(topic)] = bandwidthUsage(st)
+		}
+	}
+	return stats
+}
+
+// updateBandwidthMetrics exports the protocol traffic and trims the meters which have been idle for a while.
+func (s *Service) updateBandwidthMetrics() {
+	if s.bandwidthCounter == nil {
+		return
+	}
+	totals := s.bandwidthCounter.GetBandwidthTotals()
+	p2pBandwidthRate.WithLabelValues("inbound").Set(totals.RateIn)
+	p2pBandwidthRate.WithLabelValues("outbound").Set(totals.RateOut)
+	for p, st := range s.bandwidthCounter.GetBandwidthByProtocol() {
+		p2pProtocolBandwidth.WithLabelValues(string(p), "inbound").Set(float64(st.TotalIn))
+		p2pProtocolBandwidth.WithLabelValues(string(p), "outbound").Set(float64(st.TotalOut))
+	}
+	idleSince := time.Now().Add(-bandwidthIdleTrim)
+	s.bandwidthCounter.TrimIdle(idleSince)
+	if s.topicBandwidth != nil {
+		s.topicBandwidth.counter.TrimIdle(idleSince)
+	}
+}
+
+func bandwidthUsage(st metrics.Stats) BandwidthUsage {
+	return BandwidthUsage{
+		TotalIn:  st.TotalIn,
+		TotalOut: st.TotalOut,
+		RateIn:   st.RateIn,
+		RateOut:  st.RateOut,
+	}
+}
@@ -0,0 +1,47 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_BandwidthStats_Topics(t *testing.T) {
+	s := &Service{
+		bandwidthCounter: metrics.NewBandwidthCounter(),
+		topicBandwidth:   newTopicBandwidth(),
+	}
+	tracer := gossipTracer{bandwidth: s.topicBandwidth}
+	joined := "/eth2/6a95a1a9/beacon_block/ssz_snappy"
+	unknown := "/eth2/6a95a1a9/made_up/ssz_snappy"
+	tracer.Join(joined)
+
+	msg := func(topic string, size int) *pubsubpb.Message {
+		return &pubsubpb.Message{Topic: &topic, Data: make([]byte, size)}
+	}
+	tracer.RecvRPC(&pubsub.RPC{RPC: pubsubpb.RPC{Publish: []*pubsubpb.Message{msg(joined, 100), msg(unknown, 1000)}}})
+	tracer.SendRPC(&pubsub.RPC{RPC: pubsubpb.RPC{Publish: []*pubsubpb.Message{msg(joined, 40)}}}, testPeerID(t))
+
+	// Meter totals are updated asynchronously.
+	var stats *BandwidthStats
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		stats = s.BandwidthStats()
+		if usage := stats.Topics[joined]; usage.TotalIn == 100 && usage.TotalOut == 40 {
+			break
+		}
+	}
+	assert.Equal(t, int64(100), stats.Topics[joined].TotalIn)
+	assert.Equal(t, int64(40), stats.Topics[joined].TotalOut)
+	_, ok := stats.Topics[unknown]
+	assert.Equal(t, false, ok, "messages on topics which were not joined should not be accounted")
+
+	tracer.Leave(joined)
+	s.topicBandwidth.lock.RLock()
+	defer s.topicBandwidth.lock.RUnlock()
+	require.Equal(t, 0, len(s.topicBandwidth.joined))
+}
@@ -12,28 +12,35 @@ const defaultPubsubQueueSize = 600
 // Config for the p2p service. These parameters are set from application level flags
 // to initialize the p2p service.
 type Config struct {
-	NoDiscovery          bool
-	EnableUPnP           bool
-	StaticPeerID         bool
-	StaticPeers          []string
-	Discv5BootStrapAddrs []string
-	RelayNodeAddr        string
-	LocalIP              string
-	HostAddress          string
-	HostDNS              string
-	PrivateKey           string
-	DataDir              string
-	MetaDataDir          string
-	QUICPort             uint
-	TCPPort              uint
-	UDPPort              uint
-	MaxPeers             uint
-	QueueSize            uint
-	AllowListCIDR        string
-	DenyListCIDR         []string
-	StateNotifier        statefeed.Notifier
-	DB                   db.ReadOnlyDatabase
-	ClockWaiter          startup.ClockWaiter
+	NoDiscovery            bool
+	EnableUPnP             bool
+	StaticPeerID           bool
+	StaticPeers            []string
+	Sentries               []string
+	PrivatePeers           []string
+	Discv5BootStrapAddrs   []string
+	RelayNodeAddr          string
+	LocalIP                string
+	HostAddress            string
+	HostDNS                string
+	PrivateKey             string
+	DataDir                string
+	MetaDataDir            string
+	QUICPort               uint
+	TCPPort                uint
+	UDPPort                uint
+	MaxPeers               uint
+	QueueSize              uint
+	PubsubTraceFile        string
+	PubsubTraceMaxSize     uint64
+	AllowListCIDR          string
+	DenyListCIDR           []string
+	InboundIPv4PrefixLimit uint
+	InboundIPv6PrefixLimit uint
+	DisablePeerPersistence bool
+	StateNotifier          statefeed.Notifier
+	DB                     db.ReadOnlyDatabase
+	ClockWaiter            startup.ClockWaiter
 }
 
 // validateConfig validates whether the values provided are accurate and will set
@@ -25,8 +25,12 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (*Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	if !s.topology.allowed(pid) {
+		return false
+	}
+	denied, _ := s.peerFilter.matchPeer(pid)
+	return !denied
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
@@ -36,6 +40,14 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 	if s.peers.IsBad(pid) {
 		return false
 	}
+	peerDenied, peerAllowed := s.peerFilter.matchPeer(pid)
+	addrDenied, addrAllowed := s.peerFilter.matchAddr(m)
+	if peerDenied || addrDenied {
+		return false
+	}
+	if peerAllowed || addrAllowed {
+		return true
+	}
 	return filterConnections(s.addrFilter, m)
 }
 
@@ -53,17 +65,46 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
-	if s.isPeerAtLimit(true /* inbound */) {
+	// A sentry checks its peer limit once the peer is known, to always accept its private nodes.
+	if !s.topology.sentry() && s.isPeerAtLimit(true /* inbound */) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
 		return false
 	}
+	denied, allowed := s.peerFilter.matchAddr(n.RemoteMultiaddr())
+	if denied {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "denied by connection filter"}).Trace("Not accepting inbound dial")
+		return false
+	}
+	if allowed {
+		return true
+	}
+	if s.isPrefixAtLimit(n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "at prefix limit"}).Trace("Not accepting inbound dial")
+		return false
+	}
 	return filterConnections(s.addrFilter, n.RemoteMultiaddr())
 }
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (*Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(direction network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	if !s.topology.allowed(pid) {
+		log.WithFields(logrus.Fields{"peer": pid,
+			"reason": "not a sentry"}).Trace("Not accepting connection in private mode")
+		return false
+	}
+	denied, _ := s.peerFilter.matchPeer(pid)
+	if denied {
+		return false
+	}
+	if s.topology.sentry() && direction == network.DirInbound && !s.topology.isPrivatePeer(pid) && s.isPeerAtLimit(true /* inbound */) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
+		return false
+	}
 	return true
 }
 
@@ -85,6 +126,43 @@ func (s *Service) validateDial(addr multiaddr.Multiaddr) bool {
 	return true
 }
 
+// isPrefixAtLimit checks whether the number of inbound peers sharing the /24 (IPv4) or /48 (IPv6)
+// prefix of the address has reached the configured limit. Loopback and private addresses are exempt.
+func (s *Service) isPrefixAtLimit(addr multiaddr.Multiaddr) bool {
+	if s.cfg == nil || manet.IsIPLoopback(addr) || manet.IsPrivateAddr(addr) {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	prefix, limit := s.inboundPrefix(ip)
+	if limit == 0 {
+		return false
+	}
+	var peersInPrefix uint
+	for _, pid := range s.peers.InboundConnected() {
+		peerAddr, err := s.peers.Address(pid)
+		if err != nil || peerAddr == nil {
+			continue
+		}
+		if addrInNet(peerAddr, prefix) {
+			peersInPrefix++
+		}
+	}
+	return peersInPrefix >= limit
+}
+
+// inboundPrefix returns the /24 or /48 prefix of the IP and the inbound peer limit for it.
+func (s *Service) inboundPrefix(ip net.IP) (*net.IPNet, uint) {
+	if ip4 := ip.To4(); ip4 != nil {
+		mask := net.CIDRMask(24, 8*net.IPv4len)
+		return &net.IPNet{IP: ip4.Mask(mask), Mask: mask}, s.cfg.InboundIPv4PrefixLimit
+	}
+	mask := net.CIDRMask(48, 8*net.IPv6len)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}, s.cfg.InboundIPv6PrefixLimit
+}
+
 var privateCIDRList = []string{
 	// Private ip addresses specified by rfc-1918.
 	// See: https://tools.ietf.org/html/rfc1918
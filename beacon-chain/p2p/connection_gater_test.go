@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
@@ -509,3 +510,43 @@ func (c *maEndpoints) LocalMultiaddr() ma.Multiaddr {
 func (c *maEndpoints) RemoteMultiaddr() ma.Multiaddr {
 	return c.raddr
 }
+
+func TestService_InterceptAccept_PrefixLimit(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+		host:    mockp2p.NewTestP2P(t).BHost,
+		cfg:     &Config{MaxPeers: 30, InboundIPv4PrefixLimit: 2, InboundIPv6PrefixLimit: 1},
+		started: true,
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+
+	addInbound := func(addr string) {
+		id := addPeer(t, s.peers, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		s.peers.Add(nil, id, ma.StringCast(addr), network.DirInbound)
+	}
+	addInbound("/ip4/212.67.10.1/tcp/3000")
+	addInbound("/ip4/212.67.10.2/tcp/3000")
+	addInbound("/ip6/2001:db8:1:2::1/tcp/3000")
+
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: ma.StringCast("/ip4/212.67.10.3/tcp/3000")}), "/24 prefix is at its limit")
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: ma.StringCast("/ip4/212.67.11.3/tcp/3000")}), "other /24 prefix")
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: ma.StringCast("/ip6/2001:db8:1:3::1/tcp/3000")}), "/48 prefix is at its limit")
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: ma.StringCast("/ip6/2001:db8:2::1/tcp/3000")}), "other /48 prefix")
+	// Private addresses are exempt.
+	for i := 1; i <= 3; i++ {
+		addInbound(fmt.Sprintf("/ip4/192.168.0.%d/tcp/3000", i))
+	}
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: ma.StringCast("/ip4/192.168.0.4/tcp/3000")}))
+
+	// Allowed addresses bypass the limit.
+	s.peerFilter = &peerFilter{}
+	_, err = s.peerFilter.add(&PeerFilterRule{Action: PeerFilterAllow, Target: "212.67.10.3"})
+	require.NoError(t, err)
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: ma.StringCast("/ip4/212.67.10.3/tcp/3000")}))
+}
@@ -0,0 +1,421 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+const (
+	// crawlerTableSize is the maximum number of nodes kept in the crawler table.
+	crawlerTableSize = 10000
+	// crawledNodeExpiry is the duration after which a node which was not found again is removed from the crawler table.
+	crawledNodeExpiry = 24 * time.Hour
+	// crawlerBatchSize is the number of nodes read from the network before the crawler pauses for crawlerBatchInterval.
+	crawlerBatchSize     = 64
+	crawlerBatchInterval = 10 * time.Second
+	// crawlerPruneInterval is how often expired nodes are removed and the clients of the crawled nodes are updated.
+	crawlerPruneInterval = time.Minute
+)
+
+// CrawledNode is a node found by the discovery crawler, as described by its latest ENR.
+type CrawledNode struct {
+	ID              enode.ID
+	PeerID          peer.ID
+	ENR             string
+	Seq             uint64
+	IP              net.IP
+	TCP             uint
+	UDP             uint
+	QUIC            uint
+	ForkDigest      [4]byte
+	NextForkVersion [4]byte
+	NextForkEpoch   primitives.Epoch
+	Attnets         bitfield.Bitvector64
+	Syncnets        bitfield.Bitvector4
+	// Client is only known for the nodes we have been connected to.
+	Client    string
+	FirstSeen time.Time
+	LastSeen  time.Time
+
+	node *enode.Node
+}
+
+// CrawledNodeFilter selects crawled nodes. Unset fields match any node.
+type CrawledNodeFilter struct {
+	Attnet     *uint64
+	Syncnet    *uint64
+	ForkDigest *[4]byte
+	Client     string
+}
+
+// newCrawledNode describes a node from its ENR. Nodes without an eth2 entry are not beacon nodes and are rejected.
+func newCrawledNode(node *enode.Node) (*CrawledNode, error) {
+	forkID, err := forkEntry(node.Record())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve fork entry")
+	}
+	info, _, err := convertToAddrInfo(node)
+	if err != nil {
+		return nil, err
+	}
+	n := &CrawledNode{
+		ID:              node.ID(),
+		ENR:             node.String(),
+		Seq:             node.Seq(),
+		IP:              node.IP(),
+		ForkDigest:      bytesutil.ToBytes4(forkID.CurrentForkDigest),
+		NextForkVersion: bytesutil.ToBytes4(forkID.NextForkVersion),
+		NextForkEpoch:   forkID.NextForkEpoch,
+		node:            node,
+	}
+	if info != nil {
+		n.PeerID = info.ID
+	}
+	if port, ok, err := getPort(node, tcp); err == nil && ok {
+		n.TCP = port
+	}
+	if port, ok, err := getPort(node, udp); err == nil && ok {
+		n.UDP = port
+	}
+	if port, ok, err := getPort(node, quic); err == nil && ok {
+		n.QUIC = port
+	}
+	if attnets, err := attBitvector(node.Record()); err == nil {
+		n.Attnets = attnets
+	}
+	if syncnets, err := syncBitvector(node.Record()); err == nil {
+		n.Syncnets = syncnets
+	}
+	return n, nil
+}
+
+func (n *CrawledNode) copy() *CrawledNode {
+	c := *n
+	c.IP = append(net.IP(nil), n.IP...)
+	c.Attnets = bytesutil.SafeCopyBytes(n.Attnets)
+	c.Syncnets = bytesutil.SafeCopyBytes(n.Syncnets)
+	return &c
+}
+
+// crawlerTable holds the crawled nodes, indexed by subnets, fork digest and client.
+type crawlerTable struct {
+	lock        sync.RWMutex
+	nodes       map[enode.ID]*CrawledNode
+	attnets     map[uint64]map[enode.ID]bool
+	syncnets    map[uint64]map[enode.ID]bool
+	forkDigests map[[4]byte]map[enode.ID]bool
+	clients     map[string]map[enode.ID]bool
+}
+
+func newCrawlerTable() *crawlerTable {
+	return &crawlerTable{
+		nodes:       make(map[enode.ID]*CrawledNode),
+		attnets:     make(map[uint64]map[enode.ID]bool),
+		syncnets:    make(map[uint64]map[enode.ID]bool),
+		forkDigests: make(map[[4]byte]map[enode.ID]bool),
+		clients:     make(map[string]map[enode.ID]bool),
+	}
+}
+
+// add records a node found at the given time. A node already in the table is only updated
+// when its ENR is at least as recent.
+func (t *crawlerTable) add(node *enode.Node, seen time.Time) error {
+	crawled, err := newCrawledNode(node)
+	if err != nil {
+		return err
+	}
+	crawled.FirstSeen = seen
+	crawled.LastSeen = seen
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if existing, ok := t.nodes[crawled.ID]; ok {
+		if crawled.Seq < existing.Seq {
+			existing.LastSeen = seen
+			return nil
+		}
+		crawled.FirstSeen = existing.FirstSeen
+		crawled.Client = existing.Client
+		t.remove(existing)
+	} else if len(t.nodes) >= crawlerTableSize {
+		t.evictOldest()
+	}
+	t.insert(crawled)
+	return nil
+}
+
+// setClient records the client of the node with the given peer ID.
+func (t *crawlerTable) setClient(pid peer.ID, client string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, n := range t.nodes {
+		if n.PeerID != pid || n.Client == client {
+			continue
+		}
+		removeFromIndex(t.clients, n.Client, n.ID)
+		n.Client = client
+		addToIndex(t.clients, n.Client, n.ID)
+	}
+}
+
+// prune removes the nodes last seen before the given time.
+func (t *crawlerTable) prune(before time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, n := range t.nodes {
+		if n.LastSeen.Before(before) {
+			t.remove(n)
+		}
+	}
+}
+
+// query returns copies of the nodes matching the filter, from the most to the least recently seen.
+func (t *crawlerTable) query(filter CrawledNodeFilter) []*CrawledNode {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	// Start from the smallest index matching the filter.
+	var candidates map[enode.ID]bool
+	narrow := func(index map[enode.ID]bool) {
+		if candidates == nil || len(index) < len(candidates) {
+			candidates = index
+		}
+	}
+	filtered := false
+	if filter.Attnet != nil {
+		narrow(t.attnets[*filter.Attnet])
+		filtered = true
+	}
+	if filter.Syncnet != nil {
+		narrow(t.syncnets[*filter.Syncnet])
+		filtered = true
+	}
+	if filter.ForkDigest != nil {
+		narrow(t.forkDigests[*filter.ForkDigest])
+		filtered = true
+	}
+	if filter.Client != "" {
+		narrow(t.clients[filter.Client])
+		filtered = true
+	}
+
+	var nodes []*CrawledNode
+	if !filtered {
+		nodes = make([]*CrawledNode, 0, len(t.nodes))
+		for _, n := range t.nodes {
+			nodes = append(nodes, n.copy())
+		}
+	} else {
+		nodes = make([]*CrawledNode, 0, len(candidates))
+		for id := range candidates {
+			if n := t.nodes[id]; n != nil && filter.matches(n) {
+				nodes = append(nodes, n.copy())
+			}
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].LastSeen.Equal(nodes[j].LastSeen) {
+			return nodes[i].LastSeen.After(nodes[j].LastSeen)
+		}
+		return nodes[i].ID.String() < nodes[j].ID.String()
+	})
+	return nodes
+}
+
+func (t *crawlerTable) size() int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return len(t.nodes)
+}
+
+// peerIDs returns the peer IDs of the crawled nodes.
+func (t *crawlerTable) peerIDs() []peer.ID {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	pids := make([]peer.ID, 0, len(t.nodes))
+	for _, n := range t.nodes {
+		if n.PeerID != "" {
+			pids = append(pids, n.PeerID)
+		}
+	}
+	return pids
+}
+
+// The following methods must be called with the table lock held.
+
+func (t *crawlerTable) insert(n *CrawledNode) {
+	t.nodes[n.ID] = n
+	for _, i := range n.Attnets.BitIndices() {
+		addToIndex(t.attnets, uint64(i), n.ID)
+	}
+	for _, i := range n.Syncnets.BitIndices() {
+		addToIndex(t.syncnets, uint64(i), n.ID)
+	}
+	addToIndex(t.forkDigests, n.ForkDigest, n.ID)
+	if n.Client != "" {
+		addToIndex(t.clients, n.Client, n.ID)
+	}
+}
+
+func (t *crawlerTable) remove(n *CrawledNode) {
+	delete(t.nodes, n.ID)
+	for _, i := range n.Attnets.BitIndices() {
+		removeFromIndex(t.attnets, uint64(i), n.ID)
+	}
+	for _, i := range n.Syncnets.BitIndices() {
+		removeFromIndex(t.syncnets, uint64(i), n.ID)
+	}
+	removeFromIndex(t.forkDigests, n.ForkDigest, n.ID)
+	removeFromIndex(t.clients, n.Client, n.ID)
+}
+
+func (t *crawlerTable) evictOldest() {
+	var oldest *CrawledNode
+	for _, n := range t.nodes {
+		if oldest == nil || n.LastSeen.Before(oldest.LastSeen) {
+			oldest = n
+		}
+	}
+	if oldest != nil {
+		t.remove(oldest)
+	}
+}
+
+func (f CrawledNodeFilter) matches(n *CrawledNode) bool {
+	if f.Attnet != nil && (*f.Attnet >= n.Attnets.Len() || !n.Attnets.BitAt(*f.Attnet)) {
+		return false
+	}
+	if f.Syncnet != nil && (*f.Syncnet >= n.Syncnets.Len() || !n.Syncnets.BitAt(*f.Syncnet)) {
+		return false
+	}
+	if f.ForkDigest != nil && *f.ForkDigest != n.ForkDigest {
+		return false
+	}
+	if f.Client != "" && f.Client != n.Client {
+		return false
+	}
+	return true
+}
+
+func addToIndex[K comparable](index map[K]map[enode.ID]bool, key K, id enode.ID) {
+	ids, ok := index[key]
+	if !ok {
+		ids = make(map[enode.ID]bool)
+		index[key] = ids
+	}
+	ids[id] = true
+}
+
+func removeFromIndex[K comparable](index map[K]map[enode.ID]bool, key K, id enode.ID) {
+	ids, ok := index[key]
+	if !ok {
+		return
+	}
+	delete(ids, id)
+	if len(ids) == 0 {
+		delete(index, key)
+	}
+}
+
+// CrawledNodes returns the nodes found by the discovery crawler which match the filter,
+// from the most to the least recently seen.
+func (s *Service) CrawledNodes(filter CrawledNodeFilter) []*CrawledNode {
+	return s.crawledNodes.query(filter)
+}
+
+// crawlNodes walks the discovery DHT in the background and records every beacon node found in the crawler table.
+func (s *Service) crawlNodes() {
+	iterator := s.dv5Listener.RandomNodes()
+	defer iterator.Close()
+
+	lastPrune := time.Now()
+	for {
+		nodes := enode.ReadNodes(iterator, crawlerBatchSize)
+		now := time.Now()
+		for _, node := range nodes {
+			if s.topology.sentry() {
+				if info, _, err := convertToAddrInfo(node); err == nil && info != nil && s.topology.isPrivatePeer(info.ID) {
+					continue
+				}
+			}
+			if err := s.crawledNodes.add(node, now); err != nil {
+				log.WithError(err).Trace("Could not record crawled node")
+			}
+		}
+		if time.Since(lastPrune) >= crawlerPruneInterval {
+			s.pruneCrawledNodes()
+			lastPrune = time.Now()
+		}
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(crawlerBatchInterval):
+		}
+	}
+}
+
+// pruneCrawledNodes removes the expired nodes from the crawler table and records the client
+// of the nodes we have been connected to.
+func (s *Service) pruneCrawledNodes() {
+	s.crawledNodes.prune(time.Now().Add(-crawledNodeExpiry))
+	for _, pid := range s.crawledNodes.peerIDs() {
+		if client := agentFromPid(pid, s.host.Peerstore()); client != "unknown" {
+			s.crawledNodes.setClient(pid, client)
+		}
+	}
+	p2pCrawledNodes.Set(float64(s.crawledNodes.size()))
+}
+
+// connectWithCrawledNodes dials up to wanted nodes of the crawler table which match the filter
+// and pass the check, waits for the dials to complete and returns the number of nodes connected.
+func (s *Service) connectWithCrawledNodes(ctx context.Context, filter CrawledNodeFilter, check func(*enode.Node) bool, wanted int) int {
+	// Restrict dials if limit is applied.
+	if flags.MaxDialIsActive() {
+		wanted = min(wanted, flags.Get().MaxConcurrentDials)
+	}
+	if wanted <= 0 {
+		return 0
+	}
+	var (
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		connected int
+	)
+	for _, crawled := range s.crawledNodes.query(filter) {
+		if wanted == 0 {
+			break
+		}
+		if !check(crawled.node) {
+			continue
+		}
+		info, _, err := convertToAddrInfo(crawled.node)
+		if err != nil || info == nil {
+			continue
+		}
+		wanted--
+		wg.Add(1)
+		go func(info peer.AddrInfo) {
+			defer wg.Done()
+			if err := s.connectWithPeer(ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with crawled peer %s", info.String())
+				return
+			}
+			lock.Lock()
+			connected++
+			lock.Unlock()
+		}(*info)
+	}
+	wg.Wait()
+	return connected
+}
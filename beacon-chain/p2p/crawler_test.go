@@ -0,0 +1,140 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/go-bitfield"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func crawlerTestNode(t *testing.T, digest [4]byte, attnets bitfield.Bitvector64, syncnets bitfield.Bitvector4) *enode.LocalNode {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+	localNode := enode.NewLocalNode(db, key)
+	localNode.SetStaticIP(net.ParseIP("192.0.2.1"))
+	localNode.Set(enr.TCP(13000))
+	localNode.Set(enr.UDP(12000))
+	if digest != [4]byte{} {
+		enc, err := (&pb.ENRForkID{
+			CurrentForkDigest: digest[:],
+			NextForkVersion:   []byte{1, 0, 0, 0},
+			NextForkEpoch:     100,
+		}).MarshalSSZ()
+		require.NoError(t, err)
+		localNode.Set(enr.WithEntry(eth2ENRKey, enc))
+	}
+	localNode.Set(enr.WithEntry(attSubnetEnrKey, &attnets))
+	localNode.Set(enr.WithEntry(syncCommsSubnetEnrKey, &syncnets))
+	return localNode
+}
+
+func TestCrawlerTable_AddQuery(t *testing.T) {
+	digest := [4]byte{0xaa, 0xbb, 0xcc, 0xdd}
+	otherDigest := [4]byte{0x01, 0x02, 0x03, 0x04}
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(5, true)
+	syncnets := bitfield.Bitvector4{0x00}
+	syncnets.SetBitAt(2, true)
+
+	table := newCrawlerTable()
+	now := time.Now()
+	subnetNode := crawlerTestNode(t, digest, attnets, syncnets)
+	require.NoError(t, table.add(subnetNode.Node(), now))
+	otherNode := crawlerTestNode(t, otherDigest, bitfield.NewBitvector64(), bitfield.Bitvector4{0x00})
+	require.NoError(t, table.add(otherNode.Node(), now.Add(time.Second)))
+	nonBeaconNode := crawlerTestNode(t, [4]byte{}, attnets, syncnets)
+	require.ErrorContains(t, "could not retrieve fork entry", table.add(nonBeaconNode.Node(), now))
+
+	all := table.query(CrawledNodeFilter{})
+	require.Equal(t, 2, len(all))
+	assert.Equal(t, otherNode.ID(), all[0].ID, "most recently seen node should be first")
+
+	attnet, syncnet, missing := uint64(5), uint64(2), uint64(6)
+	nodes := table.query(CrawledNodeFilter{Attnet: &attnet, ForkDigest: &digest})
+	require.Equal(t, 1, len(nodes))
+	crawled := nodes[0]
+	assert.Equal(t, subnetNode.ID(), crawled.ID)
+	assert.Equal(t, digest, crawled.ForkDigest)
+	assert.Equal(t, [4]byte{1, 0, 0, 0}, crawled.NextForkVersion)
+	assert.Equal(t, uint(13000), crawled.TCP)
+	assert.Equal(t, uint(12000), crawled.UDP)
+	assert.Equal(t, "192.0.2.1", crawled.IP.String())
+	assert.NotEqual(t, "", crawled.PeerID.String())
+	assert.Equal(t, subnetNode.Node().String(), crawled.ENR)
+
+	assert.Equal(t, 1, len(table.query(CrawledNodeFilter{Syncnet: &syncnet})))
+	assert.Equal(t, 0, len(table.query(CrawledNodeFilter{Attnet: &missing})))
+	assert.Equal(t, 0, len(table.query(CrawledNodeFilter{Attnet: &attnet, ForkDigest: &otherDigest})))
+	assert.Equal(t, 1, len(table.query(CrawledNodeFilter{ForkDigest: &otherDigest})))
+
+	// Returned nodes are copies.
+	crawled.Attnets.SetBitAt(6, true)
+	assert.Equal(t, 0, len(table.query(CrawledNodeFilter{Attnet: &missing})))
+}
+
+func TestCrawlerTable_Update(t *testing.T) {
+	digest := [4]byte{0xaa, 0xbb, 0xcc, 0xdd}
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(5, true)
+	table := newCrawlerTable()
+	localNode := crawlerTestNode(t, digest, attnets, bitfield.Bitvector4{0x00})
+	oldRecord := localNode.Node()
+	firstSeen := time.Now().Add(-time.Hour)
+	require.NoError(t, table.add(oldRecord, firstSeen))
+
+	// The node moves to another subnet.
+	newAttnets := bitfield.NewBitvector64()
+	newAttnets.SetBitAt(7, true)
+	localNode.Set(enr.WithEntry(attSubnetEnrKey, &newAttnets))
+	seen := time.Now()
+	require.NoError(t, table.add(localNode.Node(), seen))
+
+	previous, current := uint64(5), uint64(7)
+	assert.Equal(t, 0, len(table.query(CrawledNodeFilter{Attnet: &previous})))
+	nodes := table.query(CrawledNodeFilter{Attnet: &current})
+	require.Equal(t, 1, len(nodes))
+	assert.Equal(t, true, nodes[0].FirstSeen.Equal(firstSeen))
+	assert.Equal(t, true, nodes[0].LastSeen.Equal(seen))
+
+	// An outdated record only refreshes the last time the node was seen.
+	later := seen.Add(time.Minute)
+	require.NoError(t, table.add(oldRecord, later))
+	nodes = table.query(CrawledNodeFilter{Attnet: &current})
+	require.Equal(t, 1, len(nodes))
+	assert.Equal(t, true, nodes[0].LastSeen.Equal(later))
+	assert.Equal(t, localNode.Node().Seq(), nodes[0].Seq)
+}
+
+func TestCrawlerTable_ClientAndPrune(t *testing.T) {
+	digest := [4]byte{0xaa, 0xbb, 0xcc, 0xdd}
+	table := newCrawlerTable()
+	recent := crawlerTestNode(t, digest, bitfield.NewBitvector64(), bitfield.Bitvector4{0x00})
+	old := crawlerTestNode(t, digest, bitfield.NewBitvector64(), bitfield.Bitvector4{0x00})
+	require.NoError(t, table.add(recent.Node(), time.Now()))
+	require.NoError(t, table.add(old.Node(), time.Now().Add(-2*crawledNodeExpiry)))
+
+	pids := table.peerIDs()
+	require.Equal(t, 2, len(pids))
+	crawled := table.query(CrawledNodeFilter{})
+	table.setClient(crawled[0].PeerID, "prysm")
+	nodes := table.query(CrawledNodeFilter{Client: "prysm"})
+	require.Equal(t, 1, len(nodes))
+	assert.Equal(t, recent.ID(), nodes[0].ID)
+	table.setClient(crawled[0].PeerID, "lighthouse")
+	assert.Equal(t, 0, len(table.query(CrawledNodeFilter{Client: "prysm"})))
+	assert.Equal(t, 1, len(table.query(CrawledNodeFilter{Client: "lighthouse"})))
+
+	table.prune(time.Now().Add(-crawledNodeExpiry))
+	assert.Equal(t, 1, table.size())
+	assert.Equal(t, 1, len(table.query(CrawledNodeFilter{ForkDigest: &digest})))
+}
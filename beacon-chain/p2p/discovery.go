@@ -344,6 +344,11 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 		return false
 	}
 
+	// Never record the ENR of private nodes, which dial their sentry.
+	if s.topology.isPrivatePeer(peerData.ID) {
+		return false
+	}
+
 	// Ignore nodes that are already active.
 	if s.peers.IsActive(peerData.ID) {
 		return false
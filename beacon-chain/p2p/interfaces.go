@@ -87,6 +87,23 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// ConnectionFilterManager edits the runtime allow and deny rules of the connection gater.
+type ConnectionFilterManager interface {
+	ConnectionFilterRules() []*PeerFilterRule
+	AddConnectionFilterRule(rule *PeerFilterRule) error
+	RemoveConnectionFilterRule(target string) (bool, error)
+}
+
+// BandwidthProvider provides the traffic of the node, per protocol and per gossip topic.
+type BandwidthProvider interface {
+	BandwidthStats() *BandwidthStats
+}
+
+// CrawledNodesProvider provides the beacon nodes found by the discovery crawler.
+type CrawledNodesProvider interface {
+	CrawledNodes(filter CrawledNodeFilter) []*CrawledNode
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
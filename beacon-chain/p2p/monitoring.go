@@ -36,6 +36,20 @@ var (
 	},
 		[]string{"agent"},
 	)
+	p2pProtocolBandwidth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_protocol_bandwidth_bytes",
+		Help: "The number of bytes received and sent on a particular libp2p protocol",
+	},
+		[]string{"protocol", "direction"})
+	p2pBandwidthRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_bandwidth_rate_bytes",
+		Help: "The rate, in bytes per second, at which the node receives and sends data",
+	},
+		[]string{"direction"})
+	p2pCrawledNodes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_crawled_nodes",
+		Help: "The number of beacon nodes in the table of the discovery crawler",
+	})
 	repeatPeerConnections = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_repeat_attempts",
 		Help: "The number of repeat attempts the connection handler is triggered for a peer.",
@@ -94,6 +108,15 @@ var (
 		Help: "The number of messages received for delivery of a particular topic",
 	},
 		[]string{"topic"})
+	p2pTopicBandwidth = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_topic_bandwidth_bytes_total",
+		Help: "The number of bytes of gossip messages received and sent on a particular topic",
+	},
+		[]string{"topic", "direction"})
+	pubsubTraceDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_pubsub_trace_dropped_total",
+		Help: "The number of gossip trace events dropped because the trace file writer fell behind",
+	})
 	pubsubMessageUndeliverable = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "p2p_pubsub_undeliverable_total",
 		Help: "The number of messages received which weren't able to be delivered of a particular topic",
@@ -196,6 +219,7 @@ func (s *Service) updateMetrics() {
 		avgScore := average(scoringData)
 		avgScoreConnectedClients.WithLabelValues(agent).Set(avgScore)
 	}
+	s.updateBandwidthMetrics()
 }
 
 func average(xs []float64) float64 {
@@ -97,6 +97,7 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) ([]libp2p.Op
 		libp2p.Muxer("/mplex/6.7.0", mplex.DefaultTransport),
 		libp2p.Security(noise.ID, noise.New),
 		libp2p.Ping(false), // Disable Ping Service.
+		libp2p.BandwidthReporter(s.bandwidthCounter),
 	}
 
 	if features.Get().EnableQUIC {
@@ -0,0 +1,296 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+// peerFilterFileName is the file, in the data directory, where runtime allow and deny rules are persisted.
+const peerFilterFileName = "peer_filter.json"
+
+// PeerFilterAction is the action taken on connections matching a peer filter rule.
+type PeerFilterAction string
+
+const (
+	// PeerFilterAllow always accepts matching connections, bypassing the static CIDR filters and the
+	// inbound prefix limits.
+	PeerFilterAllow PeerFilterAction = "allow"
+	// PeerFilterDeny always rejects matching connections. Deny rules take precedence over allow rules.
+	PeerFilterDeny PeerFilterAction = "deny"
+)
+
+// PeerFilterRule is a runtime allow or deny rule of the connection gater. The target is either
+// a peer ID, an IP address or a CIDR subnet.
+type PeerFilterRule struct {
+	Action PeerFilterAction `json:"action"`
+	Target string           `json:"target"`
+}
+
+// parsedRule is a validated rule, with its target normalized.
+type parsedRule struct {
+	action PeerFilterAction
+	pid    peer.ID
+	ipNet  *net.IPNet
+}
+
+func (r *parsedRule) rule() *PeerFilterRule {
+	if r.ipNet != nil {
+		return &PeerFilterRule{Action: r.action, Target: r.ipNet.String()}
+	}
+	return &PeerFilterRule{Action: r.action, Target: r.pid.String()}
+}
+
+// parsePeerFilterRule validates the rule and normalizes its target. A single IP address
+// is converted into a /32 or /128 subnet.
+func parsePeerFilterRule(rule *PeerFilterRule) (*parsedRule, error) {
+	if rule == nil {
+		return nil, errors.New("nil peer filter rule")
+	}
+	if rule.Action != PeerFilterAllow && rule.Action != PeerFilterDeny {
+		return nil, fmt.Errorf("invalid peer filter action %q, wanted %q or %q", rule.Action, PeerFilterAllow, PeerFilterDeny)
+	}
+	target := strings.TrimSpace(rule.Target)
+	if _, ipNet, err := net.ParseCIDR(target); err == nil {
+		return &parsedRule{action: rule.Action, ipNet: ipNet}, nil
+	}
+	if ip := net.ParseIP(target); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &parsedRule{action: rule.Action, ipNet: &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}}, nil
+	}
+	pid, err := peer.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("target %q is neither a peer ID, an IP address nor a CIDR subnet", rule.Target)
+	}
+	return &parsedRule{action: rule.Action, pid: pid}, nil
+}
+
+// peerFilter holds the runtime allow and deny rules of the connection gater.
+// Rules are persisted to disk when a path is set.
+type peerFilter struct {
+	path  string
+	lock  sync.RWMutex
+	rules []*parsedRule
+}
+
+// newPeerFilter creates a peer filter persisted in the given data directory, loading its existing rules.
+func newPeerFilter(dataDir string) (*peerFilter, error) {
+	f := &peerFilter{}
+	if dataDir == "" {
+		return f, nil
+	}
+	f.path = filepath.Join(dataDir, peerFilterFileName)
+	exists, err := file.Exists(f.path, file.Regular)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return f, nil
+	}
+	enc, err := file.ReadFileAsBytes(f.path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read peer filter file %s", f.path)
+	}
+	var rules []*PeerFilterRule
+	if err := json.Unmarshal(enc, &rules); err != nil {
+		return nil, errors.Wrapf(err, "could not decode peer filter file %s", f.path)
+	}
+	for _, r := range rules {
+		parsed, err := parsePeerFilterRule(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid rule in peer filter file %s", f.path)
+		}
+		f.rules = append(f.rules, parsed)
+	}
+	return f, nil
+}
+
+// list returns the current rules.
+func (f *peerFilter) list() []*PeerFilterRule {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	rules := make([]*PeerFilterRule, len(f.rules))
+	for i, r := range f.rules {
+		rules[i] = r.rule()
+	}
+	return rules
+}
+
+// add adds a rule, replacing any existing rule for the same target. The rules are left unchanged
+// if they cannot be persisted.
+func (f *peerFilter) add(rule *PeerFilterRule) (*parsedRule, error) {
+	parsed, err := parsePeerFilterRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	previous := f.rules
+	f.rules = append(f.withoutTarget(parsed.rule().Target), parsed)
+	if err := f.save(); err != nil {
+		f.rules = previous
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// remove removes the rule with the given target. It returns false if no such rule exists.
+// The rules are left unchanged if they cannot be persisted.
+func (f *peerFilter) remove(target string) (bool, error) {
+	parsed, err := parsePeerFilterRule(&PeerFilterRule{Action: PeerFilterDeny, Target: target})
+	if err != nil {
+		return false, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	rules := f.withoutTarget(parsed.rule().Target)
+	if len(rules) == len(f.rules) {
+		return false, nil
+	}
+	previous := f.rules
+	f.rules = rules
+	if err := f.save(); err != nil {
+		f.rules = previous
+		return false, err
+	}
+	return true, nil
+}
+
+func (f *peerFilter) withoutTarget(target string) []*parsedRule {
+	rules := make([]*parsedRule, 0, len(f.rules))
+	for _, r := range f.rules {
+		if r.rule().Target != target {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// save writes the rules to disk. The caller must hold the lock.
+func (f *peerFilter) save() error {
+	if f.path == "" {
+		return nil
+	}
+	rules := make([]*PeerFilterRule, len(f.rules))
+	for i, r := range f.rules {
+		rules[i] = r.rule()
+	}
+	enc, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := file.WriteFile(f.path, enc); err != nil {
+		return errors.Wrapf(err, "could not write peer filter file %s", f.path)
+	}
+	return nil
+}
+
+// matchPeer returns whether the peer ID is denied or allowed by a rule.
+func (f *peerFilter) matchPeer(pid peer.ID) (denied, allowed bool) {
+	if f == nil {
+		return false, false
+	}
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	for _, r := range f.rules {
+		if r.pid == "" || r.pid != pid {
+			continue
+		}
+		switch r.action {
+		case PeerFilterDeny:
+			denied = true
+		case PeerFilterAllow:
+			allowed = true
+		}
+	}
+	return denied, allowed
+}
+
+// matchAddr returns whether the IP of the multiaddress is denied or allowed by a rule.
+func (f *peerFilter) matchAddr(addr multiaddr.Multiaddr) (denied, allowed bool) {
+	if f == nil {
+		return false, false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false, false
+	}
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	for _, r := range f.rules {
+		if r.ipNet == nil || !r.ipNet.Contains(ip) {
+			continue
+		}
+		switch r.action {
+		case PeerFilterDeny:
+			denied = true
+		case PeerFilterAllow:
+			allowed = true
+		}
+	}
+	return denied, allowed
+}
+
+// ConnectionFilterRules returns the runtime allow and deny rules of the connection gater.
+func (s *Service) ConnectionFilterRules() []*PeerFilterRule {
+	return s.peerFilter.list()
+}
+
+// AddConnectionFilterRule adds a runtime allow or deny rule to the connection gater and persists it.
+// Connected peers matching a new deny rule are disconnected.
+func (s *Service) AddConnectionFilterRule(rule *PeerFilterRule) error {
+	parsed, err := s.peerFilter.add(rule)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"action": parsed.action,
+		"target": parsed.rule().Target,
+	}).Info("Added connection filter rule")
+	if parsed.action != PeerFilterDeny || s.host == nil {
+		return nil
+	}
+	for _, conn := range s.host.Network().Conns() {
+		pid := conn.RemotePeer()
+		if parsed.pid == pid || (parsed.ipNet != nil && addrInNet(conn.RemoteMultiaddr(), parsed.ipNet)) {
+			if err := s.Disconnect(pid); err != nil {
+				log.WithError(err).WithField("peer", pid).Debug("Could not disconnect denied peer")
+			}
+		}
+	}
+	return nil
+}
+
+// RemoveConnectionFilterRule removes the runtime rule for the given target. It returns false
+// if no rule exists for the target.
+func (s *Service) RemoveConnectionFilterRule(target string) (bool, error) {
+	removed, err := s.peerFilter.remove(target)
+	if err != nil {
+		return false, err
+	}
+	if removed {
+		log.WithField("target", target).Info("Removed connection filter rule")
+	}
+	return removed, nil
+}
+
+func addrInNet(addr multiaddr.Multiaddr, ipNet *net.IPNet) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	return ipNet.Contains(ip)
+}
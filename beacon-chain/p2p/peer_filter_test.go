@@ -0,0 +1,142 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func testPeerID(t *testing.T) peer.ID {
+	_, pub, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	return pid
+}
+
+func TestParsePeerFilterRule(t *testing.T) {
+	pid := testPeerID(t)
+	tests := []struct {
+		name       string
+		rule       *PeerFilterRule
+		wantTarget string
+		wantErr    string
+	}{
+		{name: "ipv4", rule: &PeerFilterRule{Action: PeerFilterDeny, Target: "212.67.10.122"}, wantTarget: "212.67.10.122/32"},
+		{name: "ipv6", rule: &PeerFilterRule{Action: PeerFilterDeny, Target: "2001:db8::1"}, wantTarget: "2001:db8::1/128"},
+		{name: "cidr", rule: &PeerFilterRule{Action: PeerFilterAllow, Target: "212.67.10.122/24"}, wantTarget: "212.67.10.0/24"},
+		{name: "peer id", rule: &PeerFilterRule{Action: PeerFilterDeny, Target: pid.String()}, wantTarget: pid.String()},
+		{name: "invalid action", rule: &PeerFilterRule{Action: "block", Target: "212.67.10.122"}, wantErr: "invalid peer filter action"},
+		{name: "invalid target", rule: &PeerFilterRule{Action: PeerFilterDeny, Target: "foo"}, wantErr: "neither a peer ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := parsePeerFilterRule(tt.rule)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTarget, parsed.rule().Target)
+		})
+	}
+}
+
+func TestPeerFilter_Persistence(t *testing.T) {
+	dir := t.TempDir()
+	pid := testPeerID(t)
+	f, err := newPeerFilter(dir)
+	require.NoError(t, err)
+	_, err = f.add(&PeerFilterRule{Action: PeerFilterDeny, Target: "212.67.10.0/24"})
+	require.NoError(t, err)
+	_, err = f.add(&PeerFilterRule{Action: PeerFilterAllow, Target: pid.String()})
+	require.NoError(t, err)
+	// Adding a rule for an existing target replaces it.
+	_, err = f.add(&PeerFilterRule{Action: PeerFilterAllow, Target: "212.67.10.0/24"})
+	require.NoError(t, err)
+
+	loaded, err := newPeerFilter(dir)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*PeerFilterRule{
+		{Action: PeerFilterAllow, Target: pid.String()},
+		{Action: PeerFilterAllow, Target: "212.67.10.0/24"},
+	}, loaded.list())
+
+	removed, err := loaded.remove(pid.String())
+	require.NoError(t, err)
+	assert.Equal(t, true, removed)
+	removed, err = loaded.remove(pid.String())
+	require.NoError(t, err)
+	assert.Equal(t, false, removed)
+
+	loaded, err = newPeerFilter(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(loaded.list()))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, peerFilterFileName), []byte(`[{"action":"deny","target":"foo"}]`), 0600))
+	_, err = newPeerFilter(dir)
+	require.ErrorContains(t, "invalid rule", err)
+}
+
+func TestPeerFilter_SaveFailure(t *testing.T) {
+	f, err := newPeerFilter(t.TempDir())
+	require.NoError(t, err)
+	_, err = f.add(&PeerFilterRule{Action: PeerFilterDeny, Target: "212.67.10.0/24"})
+	require.NoError(t, err)
+
+	// The rules are not changed in memory when they cannot be persisted.
+	f.path = t.TempDir()
+	_, err = f.add(&PeerFilterRule{Action: PeerFilterAllow, Target: "212.67.10.0/24"})
+	require.ErrorContains(t, "could not write peer filter file", err)
+	_, err = f.add(&PeerFilterRule{Action: PeerFilterDeny, Target: "10.0.0.1"})
+	require.ErrorContains(t, "could not write peer filter file", err)
+	removed, err := f.remove("212.67.10.0/24")
+	require.ErrorContains(t, "could not write peer filter file", err)
+	assert.Equal(t, false, removed)
+	require.DeepEqual(t, []*PeerFilterRule{{Action: PeerFilterDeny, Target: "212.67.10.0/24"}}, f.list())
+}
+
+func TestService_ConnectionFilterRules(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+		peerFilter: &peerFilter{},
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{DenyListCIDR: []string{"212.67.0.0/16"}})
+	require.NoError(t, err)
+	deniedPeer := testPeerID(t)
+	allowedAddr := ma.StringCast("/ip4/212.67.10.122/tcp/3000")
+	deniedAddr := ma.StringCast("/ip4/100.1.1.1/tcp/3000")
+
+	assert.Equal(t, false, s.InterceptAddrDial("", allowedAddr), "statically denied")
+	require.NoError(t, s.AddConnectionFilterRule(&PeerFilterRule{Action: PeerFilterAllow, Target: "212.67.10.0/24"}))
+	require.NoError(t, s.AddConnectionFilterRule(&PeerFilterRule{Action: PeerFilterDeny, Target: "100.1.1.1"}))
+	require.NoError(t, s.AddConnectionFilterRule(&PeerFilterRule{Action: PeerFilterDeny, Target: deniedPeer.String()}))
+	assert.Equal(t, 3, len(s.ConnectionFilterRules()))
+
+	assert.Equal(t, true, s.InterceptAddrDial("", allowedAddr), "allow rule overrides static deny list")
+	assert.Equal(t, false, s.InterceptAddrDial("", deniedAddr))
+	assert.Equal(t, false, s.InterceptPeerDial(deniedPeer))
+	assert.Equal(t, false, s.InterceptSecured(0, deniedPeer, nil))
+	assert.Equal(t, false, s.InterceptAddrDial(deniedPeer, allowedAddr), "deny rules take precedence")
+
+	removed, err := s.RemoveConnectionFilterRule(deniedPeer.String())
+	require.NoError(t, err)
+	assert.Equal(t, true, removed)
+	assert.Equal(t, true, s.InterceptPeerDial(deniedPeer))
+}
@@ -0,0 +1,98 @@
+package p2p
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	// peerRecordsFileName is the file, in the data directory, where the peer records are persisted.
+	peerRecordsFileName = "peers.json"
+	// peerRecordsSaveInterval is how often the peer records are persisted.
+	peerRecordsSaveInterval = 5 * time.Minute
+)
+
+// peerRecordsPath returns the path of the peer records file, or an empty string when
+// peer persistence is disabled.
+func (s *Service) peerRecordsPath() string {
+	if s.cfg.DisablePeerPersistence || s.cfg.DataDir == "" {
+		return ""
+	}
+	return filepath.Join(s.cfg.DataDir, peerRecordsFileName)
+}
+
+// restorePeerRecords loads the persisted peer records into the peer status. It returns the
+// restored peers which can be dialed, from the best to the worst score.
+func (s *Service) restorePeerRecords() ([]peer.AddrInfo, error) {
+	path := s.peerRecordsPath()
+	if path == "" {
+		return nil, nil
+	}
+	exists, err := file.Exists(path, file.Regular)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read peer records file %s", path)
+	}
+	var records []*peers.PeerRecord
+	if err := json.Unmarshal(enc, &records); err != nil {
+		return nil, errors.Wrapf(err, "could not decode peer records file %s", path)
+	}
+	dialable := s.peers.Restore(records)
+	log.WithField("records", len(records)).WithField("dialable", len(dialable)).Info("Restored persisted peers")
+	return dialable, nil
+}
+
+// savePeerRecords persists the peer records to the data directory.
+func (s *Service) savePeerRecords() error {
+	path := s.peerRecordsPath()
+	if path == "" {
+		return nil
+	}
+	records := s.peers.Records()
+	if s.topology.sentry() {
+		// The records of private nodes are never shared, not even with a future run of this node.
+		public := make([]*peers.PeerRecord, 0, len(records))
+		for _, r := range records {
+			if pid, err := peer.Decode(r.PeerID); err == nil && s.topology.isPrivatePeer(pid) {
+				continue
+			}
+			public = append(public, r)
+		}
+		records = public
+	}
+	enc, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	if err := file.WriteFile(path, enc); err != nil {
+		return errors.Wrapf(err, "could not write peer records file %s", path)
+	}
+	return nil
+}
+
+// connectWithPersistedPeers dials the best scored restored peers, as many as there are free peer slots.
+func (s *Service) connectWithPersistedPeers(infos []peer.AddrInfo) {
+	if wanted := s.wantedPeerDials(); len(infos) > wanted {
+		infos = infos[:wanted]
+	}
+	for _, info := range infos {
+		// make each dial non-blocking
+		go func(info peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with persisted peer %s", info.String())
+			}
+		}(info)
+	}
+}
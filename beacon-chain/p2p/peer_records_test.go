@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func newPeerRecordsTestService(dataDir string) *Service {
+	return &Service{
+		cfg: &Config{DataDir: dataDir, MaxPeers: 30},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{Threshold: maxBadResponses},
+			},
+		}),
+	}
+}
+
+func TestService_PeerRecordsPersistence(t *testing.T) {
+	dir := t.TempDir()
+	s := newPeerRecordsTestService(dir)
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	good := testPeerID(t)
+	s.peers.Add(nil, good, addr, network.DirOutbound)
+	s.peers.SetConnectionState(good, peers.PeerConnected)
+	bad := testPeerID(t)
+	s.peers.Add(nil, bad, addr, network.DirInbound)
+	s.peers.SetConnectionState(bad, peers.PeerConnected)
+	for i := 0; i < maxBadResponses; i++ {
+		s.peers.Scorers().BadResponsesScorer().Increment(bad)
+	}
+	require.NoError(t, s.savePeerRecords())
+	exists, err := file.Exists(filepath.Join(dir, peerRecordsFileName), file.Regular)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+
+	restarted := newPeerRecordsTestService(dir)
+	dialable, err := restarted.restorePeerRecords()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dialable))
+	assert.Equal(t, good, dialable[0].ID)
+	assert.Equal(t, true, restarted.peers.IsBad(bad), "banned peer should remain banned after a restart")
+
+	disabled := newPeerRecordsTestService(dir)
+	disabled.cfg.DisablePeerPersistence = true
+	dialable, err = disabled.restorePeerRecords()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(dialable))
+	assert.Equal(t, 0, len(disabled.peers.All()))
+}
+
+func TestService_RestorePeerRecords_NoFile(t *testing.T) {
+	s := newPeerRecordsTestService(t.TempDir())
+	dialable, err := s.restorePeerRecords()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(dialable))
+}
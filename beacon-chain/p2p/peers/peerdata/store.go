@@ -52,6 +52,7 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	LastSeen      time.Time
 	// Chain related data.
 	MetaData                  metadata.Metadata
 	ChainState                *ethpb.Status
@@ -62,9 +63,11 @@ type PeerData struct {
 	ProcessedBlocks      uint64
 	BlockProviderUpdated time.Time
 	// Gossip Scoring data.
-	TopicScores      map[string]*ethpb.TopicScoreSnapshot
-	GossipScore      float64
-	BehaviourPenalty float64
+	TopicScores        map[string]*ethpb.TopicScoreSnapshot
+	GossipScore        float64
+	BehaviourPenalty   float64
+	AppSpecificScore   float64
+	IPColocationFactor float64
 }
 
 // NewStore creates new peer data store.
@@ -0,0 +1,144 @@
+package peers
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+)
+
+// PeerRecordExpiry is the duration after which a peer record that was not seen, and is not banned, is discarded.
+const PeerRecordExpiry = 7 * 24 * time.Hour
+
+// PeerRecord is the persisted state of a peer, used to restore the knowledge about good and bad
+// peers across restarts.
+type PeerRecord struct {
+	PeerID           string    `json:"peer_id"`
+	ENR              string    `json:"enr,omitempty"`
+	Address          string    `json:"address,omitempty"`
+	Score            float64   `json:"score"`
+	BadResponses     int       `json:"bad_responses"`
+	ProcessedBlocks  uint64    `json:"processed_blocks"`
+	GossipScore      float64   `json:"gossip_score"`
+	BehaviourPenalty float64   `json:"behaviour_penalty"`
+	LastSeen         time.Time `json:"last_seen"`
+	Banned           bool      `json:"banned"`
+}
+
+// Records returns the records of the known peers which were seen at least once or which are banned.
+// Trusted peers are not included, as they are configured at startup.
+func (p *Status) Records() []*PeerRecord {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	records := make([]*PeerRecord, 0, len(p.store.Peers()))
+	for pid, peerData := range p.store.Peers() {
+		if p.store.IsTrustedPeer(pid) {
+			continue
+		}
+		banned := p.scorers.IsBadPeerNoLock(pid)
+		if peerData.LastSeen.IsZero() && !banned {
+			continue
+		}
+		record := &PeerRecord{
+			PeerID:           pid.String(),
+			Score:            p.scorers.ScoreNoLock(pid),
+			BadResponses:     peerData.BadResponses,
+			ProcessedBlocks:  peerData.ProcessedBlocks,
+			GossipScore:      peerData.GossipScore,
+			BehaviourPenalty: peerData.BehaviourPenalty,
+			LastSeen:         peerData.LastSeen,
+			Banned:           banned,
+		}
+		if peerData.Address != nil {
+			record.Address = peerData.Address.String()
+		}
+		if peerData.Enr != nil {
+			if node, err := enode.New(enode.ValidSchemes, peerData.Enr); err == nil {
+				record.ENR = node.String()
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// Restore adds the peers of the given records to the peer store, keeping banned peers banned.
+// Expired and invalid records are skipped, as well as records of already known peers. The records of
+// bad peers are always restored, the other ones are restored from the best to the worst score until
+// the peer store is full. It returns the restored peers which are not bad and have an address,
+// ordered from the best to the worst score.
+func (p *Status) Restore(records []*PeerRecord) []peer.AddrInfo {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	threshold := p.scorers.BadResponsesScorer().Params().Threshold
+	isBad := func(record *PeerRecord) bool {
+		return record.Banned || record.BadResponses >= threshold
+	}
+	records = slices.Clone(records)
+	sort.SliceStable(records, func(i, j int) bool {
+		if isBad(records[i]) != isBad(records[j]) {
+			return isBad(records[i])
+		}
+		if records[i].Score != records[j].Score {
+			return records[i].Score > records[j].Score
+		}
+		return records[i].LastSeen.After(records[j].LastSeen)
+	})
+
+	dialable := make([]peer.AddrInfo, 0, len(records))
+	for _, record := range records {
+		bad := isBad(record)
+		if !bad && len(p.store.Peers()) >= p.store.Config().MaxPeers {
+			break
+		}
+		if !bad && time.Since(record.LastSeen) > PeerRecordExpiry {
+			continue
+		}
+		pid, err := peer.Decode(record.PeerID)
+		if err != nil {
+			continue
+		}
+		if _, ok := p.store.PeerData(pid); ok {
+			continue
+		}
+		peerData := &peerdata.PeerData{
+			Direction:        network.DirUnknown,
+			ConnState:        PeerDisconnected,
+			BadResponses:     record.BadResponses,
+			ProcessedBlocks:  record.ProcessedBlocks,
+			GossipScore:      record.GossipScore,
+			BehaviourPenalty: record.BehaviourPenalty,
+			LastSeen:         record.LastSeen,
+		}
+		if bad && peerData.BadResponses < threshold {
+			// Bad responses decay over time, so the ban of a restored peer
+			// eventually expires, as it would have without a restart.
+			peerData.BadResponses = threshold
+		}
+		if record.Address != "" {
+			if addr, err := ma.NewMultiaddr(record.Address); err == nil {
+				peerData.Address = addr
+			}
+		}
+		if record.ENR != "" {
+			if node, err := enode.Parse(enode.ValidSchemes, record.ENR); err == nil {
+				peerData.Enr = node.Record()
+			}
+		}
+		p.store.SetPeerData(pid, peerData)
+		if peerData.Address != nil {
+			p.addIpToTracker(pid)
+			if !bad {
+				dialable = append(dialable, peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{peerData.Address}})
+			}
+		}
+	}
+	return dialable
+}
@@ -0,0 +1,160 @@
+package peers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func newRecordsTestStatus() *peers.Status {
+	return peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 3,
+			},
+		},
+	})
+}
+
+// addRecordPeer adds a peer with a valid, encodable, peer ID.
+func addRecordPeer(p *peers.Status, addr ma.Multiaddr, dir network.Direction, state peerdata.PeerConnectionState) peer.ID {
+	id := libp2ptest.GeneratePeerIDs(1)[0]
+	p.Add(nil, id, addr, dir)
+	p.SetConnectionState(id, state)
+	return id
+}
+
+func TestStatus_RecordsRestore(t *testing.T) {
+	p := newRecordsTestStatus()
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	otherAddr, err := ma.NewMultiaddr("/ip4/52.23.23.253/tcp/13000")
+	require.NoError(t, err)
+
+	good := addRecordPeer(p, addr, network.DirOutbound, peers.PeerConnected)
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(good, 64)
+	average := addRecordPeer(p, otherAddr, network.DirInbound, peers.PeerConnected)
+	p.SetConnectionState(average, peers.PeerDisconnected)
+	p.Scorers().BadResponsesScorer().Increment(average)
+	banned := addRecordPeer(p, otherAddr, network.DirInbound, peers.PeerConnected)
+	for i := 0; i < 3; i++ {
+		p.Scorers().BadResponsesScorer().Increment(banned)
+	}
+	trusted := addRecordPeer(p, addr, network.DirOutbound, peers.PeerConnected)
+	p.SetTrustedPeers([]peer.ID{trusted})
+	// Never connected peers are not persisted.
+	addRecordPeer(p, addr, network.DirOutbound, peers.PeerDisconnected)
+
+	records := p.Records()
+	require.Equal(t, 3, len(records))
+
+	restored := newRecordsTestStatus()
+	dialable := restored.Restore(records)
+	require.Equal(t, 2, len(dialable))
+	assert.Equal(t, good, dialable[0].ID, "best scored peer should be dialed first")
+	assert.Equal(t, average, dialable[1].ID)
+	require.Equal(t, 1, len(dialable[0].Addrs))
+	assert.Equal(t, true, addr.Equal(dialable[0].Addrs[0]))
+
+	assert.Equal(t, true, restored.IsBad(banned), "banned peer should remain banned")
+	assert.Equal(t, uint64(64), restored.Scorers().BlockProviderScorer().ProcessedBlocks(good))
+	state, err := restored.ConnectionState(good)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+	assert.Equal(t, 0, len(restored.Connected()))
+}
+
+func TestStatus_Restore_SkipsExpiredAndKnown(t *testing.T) {
+	p := newRecordsTestStatus()
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	known := addRecordPeer(p, addr, network.DirOutbound, peers.PeerConnected)
+
+	expiredID := addRecordPeer(newRecordsTestStatus(), addr, network.DirOutbound, peers.PeerConnected)
+	bannedID := addRecordPeer(newRecordsTestStatus(), addr, network.DirOutbound, peers.PeerConnected)
+	old := time.Now().Add(-peers.PeerRecordExpiry - time.Hour)
+	records := []*peers.PeerRecord{
+		{PeerID: known.String(), Address: addr.String(), LastSeen: time.Now(), BadResponses: 2},
+		{PeerID: expiredID.String(), Address: addr.String(), LastSeen: old},
+		{PeerID: bannedID.String(), Address: addr.String(), LastSeen: old, Banned: true},
+		{PeerID: "invalid", LastSeen: time.Now()},
+	}
+	dialable := p.Restore(records)
+	assert.Equal(t, 0, len(dialable))
+
+	count, err := p.Scorers().BadResponsesScorer().Count(known)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "known peer should not be overwritten")
+	_, err = p.ConnectionState(expiredID)
+	assert.NotNil(t, err, "expired peer should not be restored")
+	assert.Equal(t, true, p.IsBad(bannedID), "expired banned peer should remain banned")
+}
+
+func TestStatus_Restore_MoreRecordsThanMaxPeers(t *testing.T) {
+	p := newRecordsTestStatus()
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	const numBanned = 5
+	numRecords := p.MaxPeerLimit() + 10
+	ids := libp2ptest.GeneratePeerIDs(numRecords)
+	records := make([]*peers.PeerRecord, 0, numRecords)
+	// The records of the good peers come first, with increasing scores, followed by the banned ones.
+	for i, id := range ids {
+		record := &peers.PeerRecord{PeerID: id.String(), Address: addr.String(), LastSeen: time.Now(), Score: float64(i)}
+		if i >= numRecords-numBanned {
+			record.Score = -100
+			record.Banned = true
+		}
+		records = append(records, record)
+	}
+
+	dialable := p.Restore(records)
+	require.Equal(t, p.MaxPeerLimit()-numBanned, len(dialable))
+	assert.Equal(t, ids[numRecords-numBanned-1], dialable[0].ID, "best scored peer should be dialed first")
+	for _, id := range ids[numRecords-numBanned:] {
+		assert.Equal(t, true, p.IsBad(id), "banned peer should be restored")
+	}
+	for _, id := range ids[:numRecords-p.MaxPeerLimit()] {
+		_, err := p.ConnectionState(id)
+		assert.NotNil(t, err, "worst scored peers should not be restored")
+	}
+}
+
+func TestStatus_Prune_LowestScoredFirst(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnablePeerScorer: true})
+	defer resetCfg()
+	p := newRecordsTestStatus()
+	// Peers are given distinct addresses, so that none of them is bad because of IP collocation.
+	nextAddr := func() ma.Multiaddr {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/10.0.%d.%d/tcp/13000", len(p.All())/256, len(p.All())%256))
+		require.NoError(t, err)
+		return addr
+	}
+
+	good := addRecordPeer(p, nextAddr(), network.DirOutbound, peers.PeerDisconnected)
+	worse := addRecordPeer(p, nextAddr(), network.DirOutbound, peers.PeerDisconnected)
+	p.Scorers().BadResponsesScorer().Increment(worse)
+	for len(p.All()) <= p.MaxPeerLimit() {
+		addRecordPeer(p, nextAddr(), network.DirOutbound, peers.PeerConnected)
+	}
+
+	p.Prune()
+	_, err := p.ConnectionState(worse)
+	assert.NotNil(t, err, "lowest scored peer should be pruned")
+	_, err = p.ConnectionState(good)
+	assert.NoError(t, err, "best scored peer should be kept")
+}
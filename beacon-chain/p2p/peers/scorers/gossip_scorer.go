@@ -92,6 +92,28 @@ func (s *GossipScorer) SetGossipData(pid peer.ID, gScore float64,
 	peerData.TopicScores = topicScores
 }
 
+// SetScoreComponents sets the application specific score and the IP colocation factor of a peer,
+// as computed by the gossipsub router.
+func (s *GossipScorer) SetScoreComponents(pid peer.ID, appSpecificScore, ipColocationFactor float64) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	peerData := s.store.PeerDataGetOrCreate(pid)
+	peerData.AppSpecificScore = appSpecificScore
+	peerData.IPColocationFactor = ipColocationFactor
+}
+
+// ScoreComponents gets the application specific score and the IP colocation factor of a peer.
+// This will error if the peer does not exist.
+func (s *GossipScorer) ScoreComponents(pid peer.ID) (float64, float64, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	if peerData, ok := s.store.PeerData(pid); ok {
+		return peerData.AppSpecificScore, peerData.IPColocationFactor, nil
+	}
+	return 0, 0, peerdata.ErrPeerUnknown
+}
+
 // GossipData gets the gossip related information of the given remote peer.
 // This can return nil if there is no known gossip record the peer.
 // This will error if the peer does not exist.
@@ -50,6 +50,22 @@ func TestScorers_Gossip_Score(t *testing.T) {
 				assert.Equal(t, uint64(100), topicMap["a"].TimeInMesh, "incorrect time in mesh")
 			},
 		},
+		{
+			name: "score components",
+			update: func(scorer *scorers.GossipScorer) {
+				scorer.SetGossipData("peer1", -5.0, 2, nil)
+				scorer.SetScoreComponents("peer1", 1.5, 3)
+			},
+			check: func(scorer *scorers.GossipScorer) {
+				appScore, ipColocation, err := scorer.ScoreComponents("peer1")
+				assert.NoError(t, err)
+				assert.Equal(t, 1.5, appScore, "incorrect app specific score")
+				assert.Equal(t, 3.0, ipColocation, "incorrect IP colocation factor")
+				assert.Equal(t, -5.0, scorer.Score("peer1"), "Unexpected score")
+				_, _, err = scorer.ScoreComponents("peer2")
+				assert.ErrorContains(t, "peer unknown", err)
+			},
+		},
 	}
 
 	for _, tt := range tests {
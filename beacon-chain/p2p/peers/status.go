@@ -306,6 +306,9 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionSt
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	if state == PeerConnected || peerData.ConnState == PeerConnected {
+		peerData.LastSeen = prysmTime.Now()
+	}
 	peerData.ConnState = state
 }
 
@@ -613,12 +616,12 @@ func (p *Status) Prune() {
 		}
 	}
 
-	// Sort peers in descending order, so the peers with the
-	// highest score are pruned first. This
-	// is to protect the node from malicious/lousy peers so
-	// that their memory is still kept.
+	// Sort peers in ascending order, so the peers with the
+	// lowest score are pruned first. Bad peers are never
+	// pruned, to protect the node from malicious/lousy peers
+	// so that their memory is still kept.
 	sort.Slice(peersToPrune, func(i, j int) bool {
-		return peersToPrune[i].score > peersToPrune[j].score
+		return peersToPrune[i].score < peersToPrune[j].score
 	})
 
 	limitDiff := len(p.store.Peers()) - p.store.Config().MaxPeers
@@ -128,6 +128,7 @@ func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
 	for pid, snap := range peerMap {
 		s.peers.Scorers().GossipScorer().SetGossipData(pid, snap.Score,
 			snap.BehaviourPenalty, convertTopicScores(snap.Topics))
+		s.peers.Scorers().GossipScorer().SetScoreComponents(pid, snap.AppSpecificScore, snap.IPColocationFactor)
 	}
 }
 
@@ -146,15 +147,23 @@ func (s *Service) pubsubOptions() []pubsub.Option {
 		pubsub.WithPeerScore(peerScoringParams()),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
-		pubsub.WithRawTracer(gossipTracer{host: s.host}),
+		pubsub.WithRawTracer(gossipTracer{host: s.host, traceFile: s.pubsubTraceFile, bandwidth: s.topicBandwidth}),
 	}
 
+	var directPeersAddrInfos []peer.AddrInfo
 	if len(s.cfg.StaticPeers) > 0 {
-		directPeersAddrInfos, err := parsePeersEnr(s.cfg.StaticPeers)
+		infos, err := parsePeersEnr(s.cfg.StaticPeers)
 		if err != nil {
 			log.WithError(err).Error("Could not add direct peer option")
 			return psOpts
 		}
+		directPeersAddrInfos = append(directPeersAddrInfos, infos...)
+	}
+	// Private nodes are direct peers of their sentry, so that they always receive the gossip messages.
+	for _, pid := range s.topology.privatePeerIDs() {
+		directPeersAddrInfos = append(directPeersAddrInfos, peer.AddrInfo{ID: pid})
+	}
+	if len(directPeersAddrInfos) > 0 {
 		psOpts = append(psOpts, pubsub.WithDirectPeers(directPeersAddrInfos))
 	}
 
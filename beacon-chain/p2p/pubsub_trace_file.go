@@ -0,0 +1,255 @@
+package p2p
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+// Gossip trace event types written to the pubsub trace file.
+const (
+	GossipTraceGraft     = "graft"
+	GossipTracePrune     = "prune"
+	GossipTraceReceive   = "receive"
+	GossipTraceDeliver   = "deliver"
+	GossipTraceReject    = "reject"
+	GossipTraceDuplicate = "duplicate"
+)
+
+const (
+	// Number of rotated trace files kept next to the current one.
+	pubsubTraceFileBackups = 5
+	// Number of events buffered before new events are dropped.
+	pubsubTraceQueueSize = 4096
+	// Period at which buffered events are flushed to disk.
+	pubsubTraceFlushPeriod = time.Second
+)
+
+// GossipTraceEvent is a single gossipsub event, written as a JSON line to the pubsub trace file.
+// BlockRoot is only set on delivered beacon blocks. Other events of the same message can be
+// related to the block through the message ID.
+type GossipTraceEvent struct {
+	Time      time.Time `json:"time"`
+	Type      string    `json:"type"`
+	Topic     string    `json:"topic,omitempty"`
+	MessageID string    `json:"message_id,omitempty"`
+	Peer      string    `json:"peer,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	BlockRoot string    `json:"block_root,omitempty"`
+}
+
+// ReadGossipTrace reads the events of a pubsub trace file.
+func ReadGossipTrace(r io.Reader) ([]*GossipTraceEvent, error) {
+	var events []*GossipTraceEvent
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		ev := &GossipTraceEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), ev); err != nil {
+			return nil, errors.Wrapf(err, "could not decode trace event on line %d", line)
+		}
+		events = append(events, ev)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read trace file")
+	}
+	return events, nil
+}
+
+// gossipTraceFile writes gossip trace events as JSON lines to a file, which is rotated once it
+// reaches its maximum size. Events are written asynchronously so that tracing never blocks
+// the gossipsub router. Events are dropped when the writer falls behind.
+type gossipTraceFile struct {
+	path    string
+	maxSize int64
+	events  chan *GossipTraceEvent
+	quit    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+	lock    sync.RWMutex
+	closed  bool
+	f       *os.File
+	w       *bufio.Writer
+	size    int64
+}
+
+func newGossipTraceFile(path string, maxSize int64) (*gossipTraceFile, error) {
+	t := &gossipTraceFile{
+		path:    path,
+		maxSize: maxSize,
+		events:  make(chan *GossipTraceEvent, pubsubTraceQueueSize),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if err := t.open(); err != nil {
+		return nil, err
+	}
+	go t.run()
+	return t, nil
+}
+
+// record queues an event to be written to the trace file. Events recorded after the file
+// is closed are ignored, as the gossipsub router may still be running at that point.
+func (t *gossipTraceFile) record(ev *GossipTraceEvent) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	if t.closed {
+		return
+	}
+	select {
+	case t.events <- ev:
+	default:
+		pubsubTraceDropped.Inc()
+	}
+}
+
+// close writes the pending events and closes the trace file.
+func (t *gossipTraceFile) close() {
+	t.once.Do(func() {
+		t.lock.Lock()
+		t.closed = true
+		t.lock.Unlock()
+		close(t.quit)
+		<-t.done
+	})
+}
+
+func (t *gossipTraceFile) run() {
+	defer close(t.done)
+	ticker := time.NewTicker(pubsubTraceFlushPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case ev := <-t.events:
+			if err := t.write(ev); err != nil {
+				log.WithError(err).Error("Could not write pubsub trace event")
+			}
+		case <-ticker.C:
+			if err := t.w.Flush(); err != nil {
+				log.WithError(err).Error("Could not flush pubsub trace file")
+			}
+		case <-t.quit:
+			t.drain()
+			return
+		}
+	}
+}
+
+// drain writes the events queued before the file was closed, then flushes and closes the file.
+func (t *gossipTraceFile) drain() {
+	for {
+		select {
+		case ev := <-t.events:
+			if err := t.write(ev); err != nil {
+				log.WithError(err).Error("Could not write pubsub trace event")
+			}
+		default:
+			if err := t.w.Flush(); err != nil {
+				log.WithError(err).Error("Could not flush pubsub trace file")
+			}
+			if err := t.f.Close(); err != nil {
+				log.WithError(err).Error("Could not close pubsub trace file")
+			}
+			return
+		}
+	}
+}
+
+func (t *gossipTraceFile) write(ev *GossipTraceEvent) error {
+	enc, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	enc = append(enc, '\n')
+	if t.maxSize > 0 && t.size > 0 && t.size+int64(len(enc)) > t.maxSize {
+		if err := t.rotate(); err != nil {
+			return errors.Wrap(err, "could not rotate pubsub trace file")
+		}
+	}
+	n, err := t.w.Write(enc)
+	t.size += int64(n)
+	return err
+}
+
+func (t *gossipTraceFile) open() error {
+	f, err := os.OpenFile(t.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) // #nosec G304 -- path is set by the node operator.
+	if err != nil {
+		return errors.Wrapf(err, "could not open pubsub trace file %s", t.path)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "could not stat pubsub trace file %s", t.path)
+	}
+	t.f = f
+	t.w = bufio.NewWriter(f)
+	t.size = info.Size()
+	return nil
+}
+
+// rotate shifts the existing files, so that <path>.1 is the most recent backup, and opens a new file.
+func (t *gossipTraceFile) rotate() error {
+	if err := t.w.Flush(); err != nil {
+		return err
+	}
+	if err := t.f.Close(); err != nil {
+		return err
+	}
+	for i := pubsubTraceFileBackups - 1; i >= 1; i-- {
+		src := fmt.Sprintf("%s.%d", t.path, i)
+		if _, err := os.Stat(src); err != nil {
+			continue
+		}
+		if err := os.Rename(src, fmt.Sprintf("%s.%d", t.path, i+1)); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(t.path, t.path+".1"); err != nil {
+		return err
+	}
+	return t.open()
+}
+
+// messageTraceEvent creates a trace event for the given message.
+func messageTraceEvent(typ string, msg *pubsub.Message) *GossipTraceEvent {
+	ev := &GossipTraceEvent{
+		Time:      time.Now(),
+		Type:      typ,
+		MessageID: hexutil.Encode([]byte(msg.ID)),
+		Peer:      msg.ReceivedFrom.String(),
+	}
+	if msg.Topic != nil {
+		ev.Topic = *msg.Topic
+	}
+	return ev
+}
+
+// tracedBlockRoot returns the root of the beacon block carried by the delivered message, if any.
+func tracedBlockRoot(msg *pubsub.Message) string {
+	if msg.Topic == nil || !strings.Contains(*msg.Topic, GossipBlockMessage) || msg.ValidatorData == nil {
+		return ""
+	}
+	blk, err := blocks.NewSignedBeaconBlock(msg.ValidatorData)
+	if err != nil {
+		return ""
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return ""
+	}
+	return hexutil.Encode(root[:])
+}
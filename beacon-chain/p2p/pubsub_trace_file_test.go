@@ -0,0 +1,101 @@
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGossipTraceFile_RecordAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tf, err := newGossipTraceFile(path, 0)
+	require.NoError(t, err)
+
+	topic := "/eth2/abcdef00/beacon_block/ssz_snappy"
+	tracer := gossipTracer{traceFile: tf}
+	tracer.Graft("peer1", topic)
+	msg := &pubsub.Message{
+		Message:      &pubsubpb.Message{Topic: &topic},
+		ID:           "\x01\x02",
+		ReceivedFrom: peer.ID("peer2"),
+	}
+	tracer.ValidateMessage(msg)
+	tracer.RejectMessage(msg, pubsub.RejectValidationFailed)
+	tracer.DuplicateMessage(msg)
+	tf.close()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	events, err := ReadGossipTrace(f)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(events))
+	assert.Equal(t, GossipTraceGraft, events[0].Type)
+	assert.Equal(t, topic, events[0].Topic)
+	assert.Equal(t, GossipTraceReceive, events[1].Type)
+	assert.Equal(t, "0x0102", events[1].MessageID)
+	assert.Equal(t, peer.ID("peer2").String(), events[1].Peer)
+	assert.Equal(t, GossipTraceReject, events[2].Type)
+	assert.Equal(t, pubsub.RejectValidationFailed, events[2].Reason)
+	assert.Equal(t, GossipTraceDuplicate, events[3].Type)
+}
+
+func TestGossipTraceFile_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tf, err := newGossipTraceFile(path, 200)
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		tf.record(&GossipTraceEvent{Time: time.Now(), Type: GossipTraceGraft, Topic: "topic", Peer: "peer"})
+	}
+	tf.close()
+
+	for _, p := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(p)
+		require.NoError(t, err)
+		assert.Equal(t, true, info.Size() <= 200, "file %s exceeds max size", p)
+	}
+	_, err = os.Stat(path + ".6")
+	assert.Equal(t, true, os.IsNotExist(err))
+}
+
+func TestGossipTraceFile_RecordAfterClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tf, err := newGossipTraceFile(path, 0)
+	require.NoError(t, err)
+	tf.record(&GossipTraceEvent{Time: time.Now(), Type: GossipTraceGraft, Topic: "topic", Peer: "peer"})
+	tf.close()
+	// The gossipsub router may still deliver events while the service stops.
+	tf.record(&GossipTraceEvent{Time: time.Now(), Type: GossipTracePrune, Topic: "topic", Peer: "peer"})
+	tf.close()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	events, err := ReadGossipTrace(f)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, GossipTraceGraft, events[0].Type)
+}
+
+func TestReadGossipTrace_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{\"type\":\"graft\"}\nnot json\n"), 0600))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	_, err = ReadGossipTrace(f)
+	require.ErrorContains(t, "line 2", err)
+}
@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"time"
+
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -20,9 +22,12 @@ const (
 )
 
 // This tracer is used to implement metrics collection for messages received
-// and broadcasted through gossipsub.
+// and broadcasted through gossipsub. When a trace file is set, grafts, prunes and
+// the lifecycle of every message are also recorded in it.
 type gossipTracer struct {
-	host host.Host
+	host      host.Host
+	traceFile *gossipTraceFile
+	bandwidth *topicBandwidth
 }
 
 // AddPeer .
@@ -38,41 +43,69 @@ func (g gossipTracer) RemovePeer(p peer.ID) {
 // Join .
 func (g gossipTracer) Join(topic string) {
 	pubsubTopicsActive.WithLabelValues(topic).Set(1)
+	if g.bandwidth != nil {
+		g.bandwidth.join(topic)
+	}
 }
 
 // Leave .
 func (g gossipTracer) Leave(topic string) {
 	pubsubTopicsActive.WithLabelValues(topic).Set(0)
+	if g.bandwidth != nil {
+		g.bandwidth.leave(topic)
+	}
 }
 
 // Graft .
 func (g gossipTracer) Graft(p peer.ID, topic string) {
 	pubsubTopicsGraft.WithLabelValues(topic).Inc()
+	if g.traceFile != nil {
+		g.traceFile.record(&GossipTraceEvent{Time: time.Now(), Type: GossipTraceGraft, Topic: topic, Peer: p.String()})
+	}
 }
 
 // Prune .
 func (g gossipTracer) Prune(p peer.ID, topic string) {
 	pubsubTopicsPrune.WithLabelValues(topic).Inc()
+	if g.traceFile != nil {
+		g.traceFile.record(&GossipTraceEvent{Time: time.Now(), Type: GossipTracePrune, Topic: topic, Peer: p.String()})
+	}
 }
 
 // ValidateMessage .
 func (g gossipTracer) ValidateMessage(msg *pubsub.Message) {
 	pubsubMessageValidate.WithLabelValues(*msg.Topic).Inc()
+	if g.traceFile != nil {
+		g.traceFile.record(messageTraceEvent(GossipTraceReceive, msg))
+	}
 }
 
 // DeliverMessage .
 func (g gossipTracer) DeliverMessage(msg *pubsub.Message) {
 	pubsubMessageDeliver.WithLabelValues(*msg.Topic).Inc()
+	if g.traceFile != nil {
+		ev := messageTraceEvent(GossipTraceDeliver, msg)
+		ev.BlockRoot = tracedBlockRoot(msg)
+		g.traceFile.record(ev)
+	}
 }
 
 // RejectMessage .
 func (g gossipTracer) RejectMessage(msg *pubsub.Message, reason string) {
 	pubsubMessageReject.WithLabelValues(*msg.Topic, reason).Inc()
+	if g.traceFile != nil {
+		ev := messageTraceEvent(GossipTraceReject, msg)
+		ev.Reason = reason
+		g.traceFile.record(ev)
+	}
 }
 
 // DuplicateMessage .
 func (g gossipTracer) DuplicateMessage(msg *pubsub.Message) {
 	pubsubMessageDuplicate.WithLabelValues(*msg.Topic).Inc()
+	if g.traceFile != nil {
+		g.traceFile.record(messageTraceEvent(GossipTraceDuplicate, msg))
+	}
 }
 
 // UndeliverableMessage .
@@ -89,11 +122,21 @@ func (g gossipTracer) ThrottlePeer(p peer.ID) {
 // RecvRPC .
 func (g gossipTracer) RecvRPC(rpc *pubsub.RPC) {
 	g.setMetricFromRPC(recv, pubsubRPCSubRecv, pubsubRPCPubRecv, pubsubRPCRecv, rpc)
+	if g.bandwidth != nil {
+		for _, msg := range rpc.Publish {
+			g.bandwidth.logRecv(msg.GetTopic(), len(msg.Data))
+		}
+	}
 }
 
 // SendRPC .
 func (g gossipTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
 	g.setMetricFromRPC(send, pubsubRPCSubSent, pubsubRPCPubSent, pubsubRPCSent, rpc)
+	if g.bandwidth != nil {
+		for _, msg := range rpc.Publish {
+			g.bandwidth.logSent(msg.GetTopic(), len(msg.Data), p)
+		}
+	}
 }
 
 // DropRPC .
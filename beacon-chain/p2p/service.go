@@ -14,6 +14,7 @@ import (
 	"github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/metrics"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
@@ -78,6 +79,12 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	pubsubTraceFile       *gossipTraceFile
+	peerFilter            *peerFilter
+	bandwidthCounter      *metrics.BandwidthCounter
+	topicBandwidth        *topicBandwidth
+	crawledNodes          *crawlerTable
+	topology              *topology
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -87,6 +94,10 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	_ = cancel // govet fix for lost cancel. Cancel is handled in service.Stop().
 
 	cfg = validateConfig(cfg)
+	topology, err := newTopology(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p2p topology")
+	}
 	privKey, err := privKey(cfg)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to generate p2p private key")
@@ -104,19 +115,29 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 
+	pf, err := newPeerFilter(cfg.DataDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load peer filter")
+	}
+
 	ipLimiter := leakybucket.NewCollector(ipLimit, ipBurst, 30*time.Second, true /* deleteEmptyBuckets */)
 
 	s := &Service{
-		ctx:          ctx,
-		cancel:       cancel,
-		cfg:          cfg,
-		addrFilter:   addrFilter,
-		ipLimiter:    ipLimiter,
-		privKey:      privKey,
-		metaData:     metaData,
-		isPreGenesis: true,
-		joinedTopics: make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
-		subnetsLock:  make(map[uint64]*sync.RWMutex),
+		ctx:              ctx,
+		cancel:           cancel,
+		cfg:              cfg,
+		addrFilter:       addrFilter,
+		ipLimiter:        ipLimiter,
+		peerFilter:       pf,
+		bandwidthCounter: metrics.NewBandwidthCounter(),
+		topicBandwidth:   newTopicBandwidth(),
+		crawledNodes:     newCrawlerTable(),
+		topology:         topology,
+		privKey:          privKey,
+		metaData:         metaData,
+		isPreGenesis:     true,
+		joinedTopics:     make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
+		subnetsLock:      make(map[uint64]*sync.RWMutex),
 	}
 
 	ipAddr := prysmnetwork.IPAddr()
@@ -135,6 +156,14 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	s.host = h
 
+	if cfg.PubsubTraceFile != "" {
+		s.pubsubTraceFile, err = newGossipTraceFile(cfg.PubsubTraceFile, int64(cfg.PubsubTraceMaxSize))
+		if err != nil {
+			return nil, err
+		}
+		log.WithField("path", cfg.PubsubTraceFile).Info("Recording gossip messages to trace file")
+	}
+
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -210,10 +239,23 @@ func (s *Service) Start() {
 		}
 		s.dv5Listener = listener
 		go s.listenForNewNodes()
+		go s.crawlNodes()
 	}
 
 	s.started = true
 
+	persistedPeers, err := s.restorePeerRecords()
+	if err != nil {
+		log.WithError(err).Error("Could not restore persisted peers")
+	}
+	if !s.topology.private() {
+		s.connectWithPersistedPeers(persistedPeers)
+	}
+	if s.topology.sentry() {
+		// Private nodes dial their sentries, they are trusted so that they are never pruned.
+		s.peers.SetTrustedPeers(s.topology.privatePeerIDs())
+	}
+
 	if len(s.cfg.StaticPeers) > 0 {
 		addrs, err := PeersFromStringAddrs(s.cfg.StaticPeers)
 		if err != nil {
@@ -230,9 +272,14 @@ func (s *Service) Start() {
 
 	// Periodic functions.
 	async.RunEvery(s.ctx, params.BeaconConfig().TtfbTimeoutDuration(), func() {
-		ensurePeerConnections(s.ctx, s.host, s.peers, relayNodes...)
+		ensurePeerConnections(s.ctx, s.host, s.peers, s.topology.isPrivatePeer, relayNodes...)
 	})
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, peerRecordsSaveInterval, func() {
+		if err := s.savePeerRecords(); err != nil {
+			log.WithError(err).Error("Could not persist peers")
+		}
+	})
 	async.RunEvery(s.ctx, time.Duration(params.BeaconConfig().RespTimeout)*time.Second, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
@@ -282,6 +329,14 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	if s.pubsubTraceFile != nil {
+		s.pubsubTraceFile.close()
+	}
+	if s.peers != nil {
+		if err := s.savePeerRecords(); err != nil {
+			log.WithError(err).Error("Could not persist peers")
+		}
+	}
 	return nil
 }
 
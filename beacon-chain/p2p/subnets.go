@@ -65,16 +65,38 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 	}
 
 	topic += s.Encoding().ProtocolSuffix()
-	iterator := s.dv5Listener.RandomNodes()
-	defer iterator.Close()
+	var (
+		filter CrawledNodeFilter
+		check  func(*enode.Node) bool
+	)
 	switch {
 	case strings.Contains(topic, GossipAttestationMessage):
-		iterator = filterNodes(ctx, iterator, s.filterPeerForAttSubnet(index))
+		filter.Attnet = &index
+		check = s.filterPeerForAttSubnet(index)
 	case strings.Contains(topic, GossipSyncCommitteeMessage):
-		iterator = filterNodes(ctx, iterator, s.filterPeerForSyncSubnet(index))
+		filter.Syncnet = &index
+		check = s.filterPeerForSyncSubnet(index)
 	default:
 		return false, errors.New("no subnet exists for provided topic")
 	}
+	if digest, err := s.currentForkDigest(); err == nil {
+		filter.ForkDigest = &digest
+	}
+
+	// Dial the nodes of the crawler table advertising the subnet first, the
+	// network is only searched when they are not enough. The crawled peers are
+	// counted as soon as they are connected, as their subscriptions to the topic
+	// are only received afterwards.
+	currNum := len(s.pubsub.ListPeers(topic))
+	if currNum >= threshold {
+		return true, nil
+	}
+	if currNum+s.connectWithCrawledNodes(ctx, filter, check, threshold-currNum) >= threshold {
+		return true, nil
+	}
+
+	iterator := filterNodes(ctx, s.dv5Listener.RandomNodes(), check)
+	defer iterator.Close()
 
 	wg := new(sync.WaitGroup)
 	for {
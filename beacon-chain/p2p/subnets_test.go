@@ -6,17 +6,24 @@ import (
 	"encoding/hex"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	ecdsaprysm "github.com/prysmaticlabs/prysm/v5/crypto/ecdsa"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -184,6 +191,57 @@ func TestStartDiscV5_FindPeersWithSubnet(t *testing.T) {
 	}
 }
 
+func TestFindPeersWithSubnet_CrawledPeersMeetThreshold(t *testing.T) {
+	localHost, _, _ := createHost(t, 0)
+	remoteHost, remoteKey, ipAddr := createHost(t, 0)
+	t.Cleanup(func() {
+		require.NoError(t, localHost.Close())
+		require.NoError(t, remoteHost.Close())
+	})
+	ps, err := pubsub.NewGossipSub(context.Background(), localHost)
+	require.NoError(t, err)
+
+	// Record the remote host in the crawler table as a node of the subnet.
+	const subnet = uint64(5)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+	remoteNode := enode.NewLocalNode(db, remoteKey)
+	remoteNode.SetStaticIP(ipAddr)
+	tcpPort, err := remoteHost.Addrs()[0].ValueForProtocol(ma.P_TCP)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(tcpPort)
+	require.NoError(t, err)
+	remoteNode.Set(enr.TCP(port))
+	enc, err := (&ethpb.ENRForkID{CurrentForkDigest: []byte{1, 2, 3, 4}, NextForkVersion: []byte{1, 0, 0, 0}}).MarshalSSZ()
+	require.NoError(t, err)
+	remoteNode.Set(enr.WithEntry(eth2ENRKey, enc))
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(subnet, true)
+	remoteNode.Set(enr.WithEntry(attSubnetEnrKey, &attnets))
+	crawledNodes := newCrawlerTable()
+	require.NoError(t, crawledNodes.add(remoteNode.Node(), time.Now()))
+
+	// The discovery listener panics when searched, as the crawled peer alone meets the threshold.
+	s := &Service{
+		ctx:          context.Background(),
+		host:         localHost,
+		pubsub:       ps,
+		dv5Listener:  mockListener{},
+		crawledNodes: crawledNodes,
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	topic := fmt.Sprintf(AttestationSubnetTopicFormat, [4]byte{1, 2, 3, 4}, subnet)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	found, err := s.FindPeersWithSubnet(ctx, topic, subnet, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, network.Connected, localHost.Network().Connectedness(remoteHost.ID()))
+}
+
 func Test_AttSubnets(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	tests := []struct {
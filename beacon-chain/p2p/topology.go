@@ -0,0 +1,97 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+)
+
+// topology restricts the peers of a node shielding validators from the network.
+//
+// In private mode, the node only connects to and accepts connections from its sentries. Discovery is
+// disabled and the sentries are trusted peers, which are never pruned.
+//
+// In sentry mode, the node protects the private nodes behind it: they are trusted peers, always
+// accepted even when the node is at its peer limit, and their records are never shared through
+// discovery, the crawler or the persisted peers.
+type topology struct {
+	sentries     map[peer.ID]bool
+	privatePeers map[peer.ID]bool
+}
+
+// newTopology validates the sentries and private peers of the configuration. In private mode, the
+// sentries become the static peers of the node and discovery is disabled.
+func newTopology(cfg *Config) (*topology, error) {
+	t := &topology{
+		sentries:     make(map[peer.ID]bool, len(cfg.Sentries)),
+		privatePeers: make(map[peer.ID]bool, len(cfg.PrivatePeers)),
+	}
+	if len(cfg.Sentries) > 0 && len(cfg.PrivatePeers) > 0 {
+		return nil, errors.New("a node cannot both be private and the sentry of private nodes")
+	}
+	for _, rawID := range cfg.PrivatePeers {
+		pid, err := peer.Decode(rawID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid private peer ID %s", rawID)
+		}
+		t.privatePeers[pid] = true
+	}
+	if len(cfg.Sentries) == 0 {
+		return t, nil
+	}
+
+	if len(cfg.StaticPeers) > 0 {
+		return nil, errors.New("static peers cannot be used in private mode, configure them as sentries instead")
+	}
+	if cfg.RelayNodeAddr != "" {
+		return nil, errors.New("a relay node cannot be used in private mode")
+	}
+	addrs, err := PeersFromStringAddrs(cfg.Sentries)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sentry address")
+	}
+	for _, addr := range addrs {
+		info, err := peer.AddrInfoFromP2pAddr(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "sentry address %s does not contain a peer ID", addr)
+		}
+		t.sentries[info.ID] = true
+	}
+	if !cfg.NoDiscovery {
+		log.Info("Running in private mode, disabling discovery")
+	}
+	cfg.NoDiscovery = true
+	cfg.StaticPeers = cfg.Sentries
+	return t, nil
+}
+
+// private returns true when the node only connects to its sentries.
+func (t *topology) private() bool {
+	return t != nil && len(t.sentries) > 0
+}
+
+// sentry returns true when the node shields private nodes.
+func (t *topology) sentry() bool {
+	return t != nil && len(t.privatePeers) > 0
+}
+
+// allowed returns false if the peer is not a sentry of a private node.
+func (t *topology) allowed(pid peer.ID) bool {
+	return !t.private() || t.sentries[pid]
+}
+
+// isPrivatePeer returns true if the peer is a private node behind this sentry.
+func (t *topology) isPrivatePeer(pid peer.ID) bool {
+	return t != nil && t.privatePeers[pid]
+}
+
+// privatePeerIDs returns the IDs of the private nodes behind this sentry.
+func (t *topology) privatePeerIDs() []peer.ID {
+	if t == nil {
+		return nil
+	}
+	pids := make([]peer.ID, 0, len(t.privatePeers))
+	for pid := range t.privatePeers {
+		pids = append(pids, pid)
+	}
+	return pids
+}
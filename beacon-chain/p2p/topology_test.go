@@ -0,0 +1,175 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	bhost "github.com/libp2p/go-libp2p/p2p/host/blank"
+	swarmt "github.com/libp2p/go-libp2p/p2p/net/swarm/testing"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestNewTopology(t *testing.T) {
+	sentry := mockp2p.NewTestP2P(t)
+	sentryAddr := fmt.Sprintf("%s/p2p/%s", sentry.BHost.Addrs()[0], sentry.PeerID())
+	privateID := mockp2p.NewTestP2P(t).PeerID()
+
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantErr string
+	}{
+		{
+			name:    "private and sentry",
+			cfg:     &Config{Sentries: []string{sentryAddr}, PrivatePeers: []string{privateID.String()}},
+			wantErr: "cannot both be private and the sentry",
+		},
+		{
+			name:    "invalid private peer ID",
+			cfg:     &Config{PrivatePeers: []string{"foo"}},
+			wantErr: "invalid private peer ID foo",
+		},
+		{
+			name:    "static peers in private mode",
+			cfg:     &Config{Sentries: []string{sentryAddr}, StaticPeers: []string{sentryAddr}},
+			wantErr: "static peers cannot be used in private mode",
+		},
+		{
+			name:    "relay node in private mode",
+			cfg:     &Config{Sentries: []string{sentryAddr}, RelayNodeAddr: sentryAddr},
+			wantErr: "relay node cannot be used in private mode",
+		},
+		{
+			name:    "sentry without peer ID",
+			cfg:     &Config{Sentries: []string{sentry.BHost.Addrs()[0].String()}},
+			wantErr: "does not contain a peer ID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTopology(tt.cfg)
+			require.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+
+	t.Run("private mode", func(t *testing.T) {
+		cfg := &Config{Sentries: []string{sentryAddr}}
+		topology, err := newTopology(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, true, topology.private())
+		assert.Equal(t, false, topology.sentry())
+		assert.Equal(t, true, cfg.NoDiscovery)
+		assert.DeepEqual(t, []string{sentryAddr}, cfg.StaticPeers)
+		assert.Equal(t, true, topology.allowed(sentry.PeerID()))
+		assert.Equal(t, false, topology.allowed(privateID))
+	})
+	t.Run("sentry mode", func(t *testing.T) {
+		cfg := &Config{PrivatePeers: []string{privateID.String()}}
+		topology, err := newTopology(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, false, topology.private())
+		assert.Equal(t, true, topology.sentry())
+		assert.Equal(t, false, cfg.NoDiscovery)
+		assert.Equal(t, true, topology.isPrivatePeer(privateID))
+		assert.Equal(t, true, topology.allowed(sentry.PeerID()))
+		assert.DeepEqual(t, []peer.ID{privateID}, topology.privatePeerIDs())
+	})
+	t.Run("default", func(t *testing.T) {
+		topology, err := newTopology(&Config{})
+		require.NoError(t, err)
+		assert.Equal(t, false, topology.private())
+		assert.Equal(t, false, topology.sentry())
+		assert.Equal(t, true, topology.allowed(privateID))
+	})
+}
+
+// newTopologyTestService returns a started service whose host enforces the given topology.
+func newTopologyTestService(t *testing.T, cfg *Config) *Service {
+	topology, err := newTopology(cfg)
+	require.NoError(t, err)
+	s := &Service{
+		cfg:       cfg,
+		topology:  topology,
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    int(cfg.MaxPeers),
+			ScorerParams: &scorers.Config{},
+		}),
+		started: true,
+	}
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	// Use the same transports as the hosts of the p2p testing harness.
+	s.host = bhost.NewBlankHost(swarmt.GenSwarm(t, swarmt.OptDisableQUIC, swarmt.OptConnGater(s)))
+	return s
+}
+
+func connectHosts(from, to host.Host) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return from.Connect(ctx, peer.AddrInfo{ID: to.ID(), Addrs: to.Addrs()})
+}
+
+func TestService_PrivateMode(t *testing.T) {
+	sentry := mockp2p.NewTestP2P(t)
+	other := mockp2p.NewTestP2P(t)
+	sentryAddr := fmt.Sprintf("%s/p2p/%s", sentry.BHost.Addrs()[0], sentry.PeerID())
+	s := newTopologyTestService(t, &Config{Sentries: []string{sentryAddr}, MaxPeers: 30})
+
+	require.NotNil(t, connectHosts(other.BHost, s.host), "only sentries can connect to a private node")
+	require.NotNil(t, connectHosts(s.host, other.BHost), "a private node must only dial its sentries")
+	require.NoError(t, connectHosts(sentry.BHost, s.host))
+	assert.Equal(t, network.Connected, s.host.Network().Connectedness(sentry.PeerID()))
+	assert.Equal(t, network.NotConnected, s.host.Network().Connectedness(other.PeerID()))
+}
+
+func TestService_SentryMode(t *testing.T) {
+	private := mockp2p.NewTestP2P(t)
+	other := mockp2p.NewTestP2P(t)
+	s := newTopologyTestService(t, &Config{PrivatePeers: []string{private.PeerID().String()}, MaxPeers: 0})
+	// Fill the sentry up to its inbound limit.
+	for i := 0; i < highWatermarkBuffer; i++ {
+		addPeer(t, s.peers, peers.PeerConnected)
+	}
+
+	require.NotNil(t, connectHosts(other.BHost, s.host), "a sentry at its peer limit should refuse public peers")
+	require.NoError(t, connectHosts(private.BHost, s.host), "a sentry should always accept its private nodes")
+	assert.Equal(t, network.Connected, s.host.Network().Connectedness(private.PeerID()))
+}
+
+func TestService_SentryMode_PrivatePeerRecords(t *testing.T) {
+	dir := t.TempDir()
+	private := testPeerID(t)
+	public := testPeerID(t)
+	s := newPeerRecordsTestService(dir)
+	s.cfg.PrivatePeers = []string{private.String()}
+	var err error
+	s.topology, err = newTopology(s.cfg)
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	for _, pid := range []peer.ID{private, public} {
+		s.peers.Add(nil, pid, addr, network.DirInbound)
+		s.peers.SetConnectionState(pid, peers.PeerConnected)
+	}
+	require.NoError(t, s.savePeerRecords())
+
+	restarted := newPeerRecordsTestService(dir)
+	_, err = restarted.restorePeerRecords()
+	require.NoError(t, err)
+	_, err = restarted.peers.Address(public)
+	assert.NoError(t, err)
+	_, err = restarted.peers.Address(private)
+	assert.NotNil(t, err, "the record of a private node should never be persisted")
+}
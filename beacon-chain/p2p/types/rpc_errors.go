@@ -1,6 +1,10 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"strings"
+	"time"
+)
 
 var (
 	ErrWrongForkDigestVersion = errors.New("wrong fork digest version")
@@ -16,3 +20,43 @@ var (
 	ErrMaxBlobReqExceeded  = errors.New("requested more than MAX_REQUEST_BLOB_SIDECARS")
 	ErrResourceUnavailable = errors.New("resource requested unavailable")
 )
+
+// retryAfterHint separates an error message from the delay after which the request may be retried.
+const retryAfterHint = ", retry after "
+
+// ErrorMessageWithRetryAfter returns the error message of a req/resp error response, telling
+// the requester to wait for the given delay before retrying its request.
+func ErrorMessageWithRetryAfter(err error, retryAfter time.Duration) string {
+	if retryAfter <= 0 {
+		return err.Error()
+	}
+	// Round up, so that a retry after the hinted delay is never too early.
+	if rounded := retryAfter.Round(time.Millisecond); rounded < retryAfter {
+		retryAfter = rounded + time.Millisecond
+	} else {
+		retryAfter = rounded
+	}
+	return err.Error() + retryAfterHint + retryAfter.String()
+}
+
+// RetryAfter returns the delay hinted by the error response of a peer, after which the
+// request may be retried.
+func RetryAfter(err error) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+	msg := err.Error()
+	i := strings.LastIndex(msg, retryAfterHint)
+	if i < 0 {
+		return 0, false
+	}
+	hint := msg[i+len(retryAfterHint):]
+	if end := strings.IndexAny(hint, " :,;"); end >= 0 {
+		hint = hint[:end]
+	}
+	d, parseErr := time.ParseDuration(hint)
+	if parseErr != nil || d <= 0 {
+		return 0, false
+	}
+	return d, true
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestRetryAfter(t *testing.T) {
+	msg := ErrorMessageWithRetryAfter(ErrRateLimited, 1500*time.Millisecond+time.Microsecond)
+	assert.Equal(t, "rate limited, retry after 1.501s", msg)
+	assert.Equal(t, ErrRateLimited.Error(), ErrorMessageWithRetryAfter(ErrRateLimited, 0))
+
+	d, ok := RetryAfter(errors.Wrap(errors.New(msg), "could not request blocks"))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1501*time.Millisecond, d)
+
+	d, ok = RetryAfter(errors.New("rate limited, retry after 2s: stream reset"))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2*time.Second, d)
+
+	_, ok = RetryAfter(ErrRateLimited)
+	assert.Equal(t, false, ok)
+	_, ok = RetryAfter(errors.New("rate limited, retry after soon"))
+	assert.Equal(t, false, ok)
+	_, ok = RetryAfter(nil)
+	assert.Equal(t, false, ok)
+}
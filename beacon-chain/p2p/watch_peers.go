@@ -10,8 +10,9 @@ import (
 )
 
 // ensurePeerConnections will attempt to reestablish connection to the peers
-// if there are currently no connections to that peer.
-func ensurePeerConnections(ctx context.Context, h host.Host, peers *peers.Status, relayNodes ...string) {
+// if there are currently no connections to that peer. Trusted peers for which
+// skip returns true are expected to dial us and are not reconnected.
+func ensurePeerConnections(ctx context.Context, h host.Host, peers *peers.Status, skip func(peer.ID) bool, relayNodes ...string) {
 	// every time reset peersToWatch, add RelayNodes and trust peers
 	var peersToWatch []*peer.AddrInfo
 
@@ -31,6 +32,9 @@ func ensurePeerConnections(ctx context.Context, h host.Host, peers *peers.Status
 	// add trusted peers
 	trustedPeers := peers.GetTrustedPeers()
 	for _, trustedPeer := range trustedPeers {
+		if skip != nil && skip(trustedPeer) {
+			continue
+		}
 		maddr, err := peers.Address(trustedPeer)
 
 		// avoid invalid trusted peers
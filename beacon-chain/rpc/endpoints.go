@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	beaconprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/beacon"
 	nodeprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/node"
+	slasherprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/slasher"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	validatorprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
@@ -71,6 +72,8 @@ func (s *Service) endpoints(
 	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater, coreService)...)
 	endpoints = append(endpoints, s.prysmNodeEndpoints()...)
 	endpoints = append(endpoints, s.prysmValidatorEndpoints(stater, coreService)...)
+	endpoints = append(endpoints, s.prysmSlasherEndpoints()...)
+	endpoints = append(endpoints, s.prysmConfigEndpoints()...)
 	if enableDebug {
 		endpoints = append(endpoints, s.debugEndpoints(stater)...)
 	}
@@ -690,6 +693,16 @@ func (s *Service) beaconEndpoints(
 			handler: server.SubmitAttesterSlashing,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/eth/v2/beacon/pool/attester_slashings",
+			name:     namespace + ".SubmitAttesterSlashingV2",
+			middleware: []mux.MiddlewareFunc{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.SubmitAttesterSlashingV2,
+			methods: []string{http.MethodPost},
+		},
 		{
 			template: "/eth/v1/beacon/pool/proposer_slashings",
 			name:     namespace + ".GetProposerSlashings",
@@ -905,11 +918,13 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 
 func (s *Service) eventsEndpoints() []endpoint {
 	server := &events.Server{
-		StateNotifier:          s.cfg.StateNotifier,
-		OperationNotifier:      s.cfg.OperationNotifier,
-		HeadFetcher:            s.cfg.HeadFetcher,
-		ChainInfoFetcher:       s.cfg.ChainInfoFetcher,
-		TrackedValidatorsCache: s.cfg.TrackedValidatorsCache,
+		StateNotifier:           s.cfg.StateNotifier,
+		OperationNotifier:       s.cfg.OperationNotifier,
+		HeadFetcher:             s.cfg.HeadFetcher,
+		ChainInfoFetcher:        s.cfg.ChainInfoFetcher,
+		TrackedValidatorsCache:  s.cfg.TrackedValidatorsCache,
+		SlasherAttestationsFeed: s.cfg.SlasherAttestationsFeed,
+		SlasherBlockHeadersFeed: s.cfg.SlasherBlockHeadersFeed,
 	}
 
 	const namespace = "events"
@@ -1004,6 +1019,11 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 		GenesisTimeFetcher:        s.cfg.GenesisTimeFetcher,
 		PeersFetcher:              s.cfg.PeersFetcher,
 		PeerManager:               s.cfg.PeerManager,
+		ConnectionFilterManager:   s.cfg.ConnectionFilterManager,
+		BandwidthProvider:         s.cfg.BandwidthProvider,
+		CrawledNodesProvider:      s.cfg.CrawledNodesProvider,
+		RelayHealthManager:        s.cfg.RelayHealthManager,
+		RateLimitReporter:         s.cfg.RateLimitReporter,
 		MetadataProvider:          s.cfg.MetadataProvider,
 		HeadFetcher:               s.cfg.HeadFetcher,
 		ExecutionChainInfoFetcher: s.cfg.ExecutionChainInfoFetcher,
@@ -1067,6 +1087,97 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 			handler: server.RemoveTrustedPeer,
 			methods: []string{http.MethodDelete},
 		},
+		{
+			template: "/prysm/v1/node/execution_client_version",
+			name:     namespace + ".GetExecutionClientVersion",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetExecutionClientVersion,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/connection_filter",
+			name:     namespace + ".ListConnectionFilterRules",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListConnectionFilterRules,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/connection_filter",
+			name:     namespace + ".AddConnectionFilterRule",
+			middleware: []mux.MiddlewareFunc{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.AddConnectionFilterRule,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/node/connection_filter",
+			name:     namespace + ".RemoveConnectionFilterRule",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.RemoveConnectionFilterRule,
+			methods: []string{http.MethodDelete},
+		},
+		{
+			template: "/prysm/v1/node/peer_scores",
+			name:     namespace + ".GetPeerScores",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPeerScores,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/bandwidth",
+			name:     namespace + ".GetBandwidth",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBandwidth,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/rate_limits",
+			name:     namespace + ".GetRateLimits",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetRateLimits,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/crawled_nodes",
+			name:     namespace + ".GetCrawledNodes",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetCrawledNodes,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/builder_relays",
+			name:     namespace + ".GetBuilderRelays",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBuilderRelays,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/builder_relays/blacklist",
+			name:     namespace + ".ResetBuilderRelayBlacklist",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ResetBuilderRelayBlacklist,
+			methods: []string{http.MethodDelete},
+		},
 	}
 }
 
@@ -1075,6 +1186,7 @@ func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *cor
 		ChainInfoFetcher: s.cfg.ChainInfoFetcher,
 		Stater:           stater,
 		CoreService:      coreService,
+		BeaconDB:         s.cfg.BeaconDB,
 	}
 
 	const namespace = "prysm.validator"
@@ -1117,5 +1229,86 @@ func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *cor
 			handler: server.GetActiveSetChanges,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/validator/proposals/{slot}/payload_decision",
+			name:     namespace + ".GetPayloadDecision",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPayloadDecision,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/monitor/{index}",
+			name:     namespace + ".GetValidatorMonitorHistory",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetValidatorMonitorHistory,
+			methods: []string{http.MethodGet},
+		},
+	}
+}
+
+func (s *Service) prysmSlasherEndpoints() []endpoint {
+	server := &slasherprysm.Server{
+		SlasherQuerier: s.cfg.SlasherQuerier,
+	}
+
+	const namespace = "prysm.slasher"
+	return []endpoint{
+		{
+			template: "/prysm/v1/slasher/slashings",
+			name:     namespace + ".GetDetectedSlashings",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetDetectedSlashings,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/slasher/attestations/slashable",
+			name:     namespace + ".IsSlashableAttestation",
+			middleware: []mux.MiddlewareFunc{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.IsSlashableAttestation,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/slasher/blocks/slashable",
+			name:     namespace + ".IsSlashableBlock",
+			middleware: []mux.MiddlewareFunc{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.IsSlashableBlock,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/slasher/validators/{validator_index}/spans",
+			name:     namespace + ".GetValidatorSpans",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetValidatorSpans,
+			methods: []string{http.MethodGet},
+		},
+	}
+}
+
+func (*Service) prysmConfigEndpoints() []endpoint {
+	const namespace = "prysm.config"
+	return []endpoint{
+		{
+			template: "/prysm/v1/config/late_block_reorg",
+			name:     namespace + ".GetLateBlockReorgConfig",
+			middleware: []mux.MiddlewareFunc{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: config.GetLateBlockReorgConfig,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
@@ -40,6 +40,7 @@ func Test_endpoints(t *testing.T) {
 		"/eth/v1/beacon/blinded_blocks/{block_id}":                   {http.MethodGet},
 		"/eth/v1/beacon/pool/attestations":                           {http.MethodGet, http.MethodPost},
 		"/eth/v1/beacon/pool/attester_slashings":                     {http.MethodGet, http.MethodPost},
+		"/eth/v2/beacon/pool/attester_slashings":                     {http.MethodPost},
 		"/eth/v1/beacon/pool/proposer_slashings":                     {http.MethodGet, http.MethodPost},
 		"/eth/v1/beacon/pool/sync_committees":                        {http.MethodPost},
 		"/eth/v1/beacon/pool/voluntary_exits":                        {http.MethodGet, http.MethodPost},
@@ -118,22 +119,43 @@ func Test_endpoints(t *testing.T) {
 	}
 
 	prysmNodeRoutes := map[string][]string{
-		"/prysm/node/trusted_peers":              {http.MethodGet, http.MethodPost},
-		"/prysm/v1/node/trusted_peers":           {http.MethodGet, http.MethodPost},
-		"/prysm/node/trusted_peers/{peer_id}":    {http.MethodDelete},
-		"/prysm/v1/node/trusted_peers/{peer_id}": {http.MethodDelete},
+		"/prysm/node/trusted_peers":               {http.MethodGet, http.MethodPost},
+		"/prysm/v1/node/trusted_peers":            {http.MethodGet, http.MethodPost},
+		"/prysm/node/trusted_peers/{peer_id}":     {http.MethodDelete},
+		"/prysm/v1/node/trusted_peers/{peer_id}":  {http.MethodDelete},
+		"/prysm/v1/node/execution_client_version": {http.MethodGet},
+		"/prysm/v1/node/connection_filter":        {http.MethodGet, http.MethodPost, http.MethodDelete},
+		"/prysm/v1/node/peer_scores":              {http.MethodGet},
+		"/prysm/v1/node/bandwidth":                {http.MethodGet},
+		"/prysm/v1/node/rate_limits":              {http.MethodGet},
+		"/prysm/v1/node/crawled_nodes":            {http.MethodGet},
+		"/prysm/v1/node/builder_relays":           {http.MethodGet},
+		"/prysm/v1/node/builder_relays/blacklist": {http.MethodDelete},
 	}
 
 	prysmValidatorRoutes := map[string][]string{
-		"/prysm/validators/performance":           {http.MethodPost},
-		"/prysm/v1/validators/performance":        {http.MethodPost},
-		"/prysm/v1/validators/participation":      {http.MethodGet},
-		"/prysm/v1/validators/active_set_changes": {http.MethodGet},
+		"/prysm/validators/performance":                         {http.MethodPost},
+		"/prysm/v1/validators/performance":                      {http.MethodPost},
+		"/prysm/v1/validators/participation":                    {http.MethodGet},
+		"/prysm/v1/validators/active_set_changes":               {http.MethodGet},
+		"/prysm/v1/validator/proposals/{slot}/payload_decision": {http.MethodGet},
+		"/prysm/v1/validators/monitor/{index}":                  {http.MethodGet},
+	}
+
+	prysmSlasherRoutes := map[string][]string{
+		"/prysm/v1/slasher/slashings":                          {http.MethodGet},
+		"/prysm/v1/slasher/attestations/slashable":             {http.MethodPost},
+		"/prysm/v1/slasher/blocks/slashable":                   {http.MethodPost},
+		"/prysm/v1/slasher/validators/{validator_index}/spans": {http.MethodGet},
+	}
+
+	prysmConfigRoutes := map[string][]string{
+		"/prysm/v1/config/late_block_reorg": {http.MethodGet},
 	}
 
 	s := &Service{cfg: &Config{}}
 
-	routesMap := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmBeaconRoutes)
+	routesMap := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmBeaconRoutes, prysmSlasherRoutes, prysmConfigRoutes)
 	actual := s.endpoints(true, nil, nil, nil, nil, nil, nil)
 	for _, e := range actual {
 		methods, ok := routesMap[e.template]
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
@@ -489,27 +490,75 @@ func (s *Server) SubmitAttesterSlashing(w http.ResponseWriter, r *http.Request)
 	defer span.End()
 
 	var req structs.AttesterSlashing
-	err := json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+	if !decodeAttesterSlashing(w, r, &req) {
 		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+	}
+	slashing, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
 		return
 	}
+	s.submitAttesterSlashing(ctx, w, slashing)
+}
 
-	slashing, err := req.ToConsensus()
+// SubmitAttesterSlashingV2 submits an attester slashing object of the fork given by the
+// Eth-Consensus-Version header to node's pool and if passes validation node MUST broadcast it to network.
+func (s *Server) SubmitAttesterSlashingV2(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttesterSlashingV2")
+	defer span.End()
+
+	versionHeader := r.Header.Get(api.VersionHeader)
+	if versionHeader == "" {
+		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
+		return
+	}
+	v, err := version.FromString(versionHeader)
+	if err != nil {
+		httputil.HandleError(w, "Invalid version: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var slashing eth.AttSlashing
+	if v >= version.Electra {
+		var req structs.AttesterSlashingElectra
+		if !decodeAttesterSlashing(w, r, &req) {
+			return
+		}
+		slashing, err = req.ToConsensus()
+	} else {
+		var req structs.AttesterSlashing
+		if !decodeAttesterSlashing(w, r, &req) {
+			return
+		}
+		slashing, err = req.ToConsensus()
+	}
 	if err != nil {
 		httputil.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
 		return
 	}
+	s.submitAttesterSlashing(ctx, w, slashing)
+}
+
+func decodeAttesterSlashing(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return false
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func (s *Server) submitAttesterSlashing(ctx context.Context, w http.ResponseWriter, slashing eth.AttSlashing) {
 	headState, err := s.ChainInfoFetcher.HeadState(ctx)
 	if err != nil {
 		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, slashing.Attestation_1.Data.Slot)
+	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, slashing.FirstAttestation().GetData().Slot)
 	if err != nil {
 		httputil.HandleError(w, "Could not process slots: "+err.Error(), http.StatusInternalServerError)
 		return
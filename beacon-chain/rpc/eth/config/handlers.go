@@ -80,6 +80,22 @@ func GetSpec(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, &structs.GetSpecResponse{Data: data})
 }
 
+// GetLateBlockReorgConfig retrieves the policy used by this node to orphan late head blocks, in the
+// format of the specification configuration. The cutoffs are the ones in effect, in milliseconds.
+func GetLateBlockReorgConfig(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "config.GetLateBlockReorgConfig")
+	defer span.End()
+
+	cfg := params.BeaconConfig()
+	httputil.WriteJson(w, &structs.GetSpecResponse{Data: map[string]string{
+		"REORG_WEIGHT_THRESHOLD":              strconv.FormatUint(cfg.ReorgWeightThreshold, 10),
+		"REORG_PARENT_WEIGHT_THRESHOLD":       strconv.FormatUint(cfg.ReorgParentWeightThreshold, 10),
+		"REORG_MAX_EPOCHS_SINCE_FINALIZATION": strconv.FormatUint(uint64(cfg.ReorgMaxEpochsSinceFinalization), 10),
+		"REORG_LATE_BLOCK_CUTOFF_MILLIS":      strconv.FormatInt(cfg.ReorgLateBlockCutoff().Milliseconds(), 10),
+		"REORG_PROPOSER_CUTOFF_MILLIS":        strconv.FormatInt(cfg.ReorgProposerCutoff().Milliseconds(), 10),
+	}})
+}
+
 func prepareConfigSpec() (map[string]string, error) {
 	data := make(map[string]string)
 	config := *params.BeaconConfig()
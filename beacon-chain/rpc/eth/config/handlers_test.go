@@ -536,6 +536,36 @@ func TestGetSpec(t *testing.T) {
 	}
 }
 
+func TestGetLateBlockReorgConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig().Copy()
+	config.ReorgWeightThreshold = 30
+	config.ReorgParentWeightThreshold = 150
+	config.ReorgMaxEpochsSinceFinalization = 3
+	config.ReorgLateBlockCutoffMillis = 0
+	config.ReorgProposerCutoffMillis = 1500
+	params.OverrideBeaconConfig(config)
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/config/late_block_reorg", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	GetLateBlockReorgConfig(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := structs.GetSpecResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+	data, ok := resp.Data.(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, map[string]interface{}{
+		"REORG_WEIGHT_THRESHOLD":              "30",
+		"REORG_PARENT_WEIGHT_THRESHOLD":       "150",
+		"REORG_MAX_EPOCHS_SINCE_FINALIZATION": "3",
+		// The late block cutoff defaults to the attestation deadline.
+		"REORG_LATE_BLOCK_CUTOFF_MILLIS": "4000",
+		"REORG_PROPOSER_CUTOFF_MILLIS":   "1500",
+	}, data)
+}
+
 func TestForkSchedule_Ok(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		genesisForkVersion := []byte("Genesis")
@@ -18,6 +18,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
@@ -57,6 +60,15 @@ const (
 	LightClientFinalityUpdateTopic = "light_client_finality_update"
 	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
 	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
+	// IndexedAttestationTopic represents a new indexed attestation fed to the slasher event topic.
+	// This Prysm specific topic is used to run a slasher apart from the beacon node.
+	IndexedAttestationTopic = "indexed_attestation"
+	// BlockHeaderTopic represents a new signed block header received on gossip, fed to the slasher event topic.
+	// This Prysm specific topic is used to run a slasher apart from the beacon node.
+	BlockHeaderTopic = "block_header"
+	// LateBlockReorgTopic represents a new decision to orphan, or not, a late head block event topic.
+	// This Prysm specific topic reports the inputs of the decision.
+	LateBlockReorgTopic = "late_block_reorg"
 )
 
 const topicDataMismatch = "Event data type %T does not correspond to event topic %s"
@@ -78,6 +90,9 @@ var casesHandled = map[string]bool{
 	AttesterSlashingTopic:            true,
 	LightClientFinalityUpdateTopic:   true,
 	LightClientOptimisticUpdateTopic: true,
+	IndexedAttestationTopic:          true,
+	BlockHeaderTopic:                 true,
+	LateBlockReorgTopic:              true,
 }
 
 // StreamEvents provides an endpoint to subscribe to the beacon node Server-Sent-Events stream.
@@ -107,6 +122,14 @@ func (s *Server) StreamEvents(w http.ResponseWriter, r *http.Request) {
 		}
 		topicsMap[topic] = true
 	}
+	if topicsMap[IndexedAttestationTopic] && !features.Get().EnableSlasher && !features.Get().EnableSlasherEventStream {
+		httputil.HandleError(w, "Indexed attestations are only streamed with the --slasher-event-stream flag", http.StatusBadRequest)
+		return
+	}
+	if topicsMap[BlockHeaderTopic] && !features.Get().EnableSlasher && !features.Get().EnableSlasherEventStream {
+		httputil.HandleError(w, "Block headers are only streamed with the --slasher-event-stream flag", http.StatusBadRequest)
+		return
+	}
 
 	// Subscribe to event feeds from information received in the beacon node runtime.
 	opsChan := make(chan *feed.Event, chanBuffer)
@@ -115,6 +138,16 @@ func (s *Server) StreamEvents(w http.ResponseWriter, r *http.Request) {
 	stateSub := s.StateNotifier.StateFeed().Subscribe(stateChan)
 	defer opsSub.Unsubscribe()
 	defer stateSub.Unsubscribe()
+	indexedAttsChan := make(chan *slashertypes.WrappedIndexedAtt, chanBuffer)
+	if topicsMap[IndexedAttestationTopic] {
+		indexedAttsSub := s.SlasherAttestationsFeed.Subscribe(indexedAttsChan)
+		defer indexedAttsSub.Unsubscribe()
+	}
+	headersChan := make(chan *eth.SignedBeaconBlockHeader, chanBuffer)
+	if topicsMap[BlockHeaderTopic] {
+		headersSub := s.SlasherBlockHeadersFeed.Subscribe(headersChan)
+		defer headersSub.Unsubscribe()
+	}
 
 	// Set up SSE response headers
 	w.Header().Set("Content-Type", api.EventStreamMediaType)
@@ -143,6 +176,16 @@ func (s *Server) StreamEvents(w http.ResponseWriter, r *http.Request) {
 				httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
+		case att := <-indexedAttsChan:
+			if err := handleIndexedAttestationEvent(w, flusher, att); err != nil {
+				httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case header := <-headersChan:
+			if err := send(w, flusher, BlockHeaderTopic, structs.SignedBeaconBlockHeaderFromConsensus(header)); err != nil {
+				httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
 		case <-keepaliveTicker.C:
 			if err := sendKeepalive(w, flusher); err != nil {
 				httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
@@ -376,6 +419,30 @@ func (s *Server) handleStateEvents(ctx context.Context, w http.ResponseWriter, f
 			ExecutionOptimistic: reorgData.ExecutionOptimistic,
 		}
 		return send(w, flusher, ChainReorgTopic, reorg)
+	case statefeed.LateBlockReorgDecision:
+		if _, ok := requestedTopics[LateBlockReorgTopic]; !ok {
+			return nil
+		}
+		d, ok := event.Data.(*forkchoicetypes.LateBlockReorgDecision)
+		if !ok {
+			return write(w, flusher, topicDataMismatch, event.Data, LateBlockReorgTopic)
+		}
+		decision := &structs.LateBlockReorgEvent{
+			Proposing:          d.Proposing,
+			HeadRoot:           hexutil.Encode(d.HeadRoot[:]),
+			HeadSlot:           fmt.Sprintf("%d", d.HeadSlot),
+			HeadWeight:         fmt.Sprintf("%d", d.HeadWeight),
+			ParentRoot:         hexutil.Encode(d.ParentRoot[:]),
+			ParentSlot:         fmt.Sprintf("%d", d.ParentSlot),
+			ParentWeight:       fmt.Sprintf("%d", d.ParentWeight),
+			HeadArrivalMillis:  fmt.Sprintf("%d", d.HeadArrival.Milliseconds()),
+			TimeIntoSlotMillis: fmt.Sprintf("%d", d.TimeIntoSlot.Milliseconds()),
+			CommitteeWeight:    fmt.Sprintf("%d", d.CommitteeWeight),
+			FinalizedEpoch:     fmt.Sprintf("%d", d.FinalizedEpoch),
+			Reorg:              d.Reorg,
+			Reason:             d.Reason,
+		}
+		return send(w, flusher, LateBlockReorgTopic, decision)
 	case statefeed.BlockProcessed:
 		if _, ok := requestedTopics[BlockTopic]; !ok {
 			return nil
@@ -398,6 +465,17 @@ func (s *Server) handleStateEvents(ctx context.Context, w http.ResponseWriter, f
 	return nil
 }
 
+func handleIndexedAttestationEvent(w http.ResponseWriter, flusher http.Flusher, att *slashertypes.WrappedIndexedAtt) error {
+	switch a := att.IndexedAtt.(type) {
+	case *eth.IndexedAttestation:
+		return send(w, flusher, IndexedAttestationTopic, structs.IndexedAttestationFromConsensus(a))
+	case *eth.IndexedAttestationElectra:
+		return send(w, flusher, IndexedAttestationTopic, structs.IndexedAttestationElectraFromConsensus(a))
+	default:
+		return write(w, flusher, topicDataMismatch, att.IndexedAtt, IndexedAttestationTopic)
+	}
+}
+
 // This event stream is intended to be used by builders and relays.
 // Parent fields are based on state at N_{current_slot}, while the rest of fields are based on state of N_{current_slot + 1}
 func (s *Server) sendPayloadAttributes(ctx context.Context, w http.ResponseWriter, flusher http.Flusher) error {
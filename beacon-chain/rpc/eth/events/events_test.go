@@ -11,12 +11,16 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	asyncevent "github.com/prysmaticlabs/prysm/v5/async/event"
 	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -404,6 +408,153 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 	})
 }
 
+func TestStreamEvents_IndexedAttestation(t *testing.T) {
+	t.Run("slasher event stream", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableSlasherEventStream: true})
+		defer resetCfg()
+		s := &Server{
+			StateNotifier:           &mockChain.MockStateNotifier{},
+			OperationNotifier:       &mockChain.MockOperationNotifier{},
+			SlasherAttestationsFeed: new(asyncevent.Feed),
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/events?topics="+IndexedAttestationTopic, nil)
+		w := &flushableResponseRecorder{
+			ResponseRecorder: httptest.NewRecorder(),
+		}
+
+		go func() {
+			s.StreamEvents(w, request)
+		}()
+		// wait for initiation of StreamEvents
+		time.Sleep(100 * time.Millisecond)
+		s.SlasherAttestationsFeed.Send(&slashertypes.WrappedIndexedAtt{
+			IndexedAtt: util.HydrateIndexedAttestation(&eth.IndexedAttestation{AttestingIndices: []uint64{1, 2}}),
+		})
+
+		// wait for feed
+		time.Sleep(1 * time.Second)
+		request.Context().Done()
+
+		resp := w.Result()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NotNil(t, body)
+		assert.Equal(t, indexedAttestationResult, string(body))
+	})
+	t.Run("no slasher event stream", func(t *testing.T) {
+		s := &Server{
+			StateNotifier:           &mockChain.MockStateNotifier{},
+			OperationNotifier:       &mockChain.MockOperationNotifier{},
+			SlasherAttestationsFeed: new(asyncevent.Feed),
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/events?topics="+IndexedAttestationTopic, nil)
+		w := &flushableResponseRecorder{
+			ResponseRecorder: httptest.NewRecorder(),
+		}
+		s.StreamEvents(w, request)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.StringContains(t, "--slasher-event-stream", w.Body.String())
+	})
+}
+
+func TestStreamEvents_BlockHeader(t *testing.T) {
+	t.Run("slasher event stream", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableSlasherEventStream: true})
+		defer resetCfg()
+		s := &Server{
+			StateNotifier:           &mockChain.MockStateNotifier{},
+			OperationNotifier:       &mockChain.MockOperationNotifier{},
+			SlasherBlockHeadersFeed: new(asyncevent.Feed),
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/events?topics="+BlockHeaderTopic, nil)
+		w := &flushableResponseRecorder{
+			ResponseRecorder: httptest.NewRecorder(),
+		}
+
+		go func() {
+			s.StreamEvents(w, request)
+		}()
+		// wait for initiation of StreamEvents
+		time.Sleep(100 * time.Millisecond)
+		s.SlasherBlockHeadersFeed.Send(util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+			Header: &eth.BeaconBlockHeader{Slot: 5, ProposerIndex: 3},
+		}))
+
+		// wait for feed
+		time.Sleep(1 * time.Second)
+		request.Context().Done()
+
+		resp := w.Result()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NotNil(t, body)
+		assert.StringContains(t, "event: block_header\ndata: {\"message\":{\"slot\":\"5\",\"proposer_index\":\"3\"", string(body))
+	})
+	t.Run("no slasher event stream", func(t *testing.T) {
+		s := &Server{
+			StateNotifier:           &mockChain.MockStateNotifier{},
+			OperationNotifier:       &mockChain.MockOperationNotifier{},
+			SlasherBlockHeadersFeed: new(asyncevent.Feed),
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/events?topics="+BlockHeaderTopic, nil)
+		w := &flushableResponseRecorder{
+			ResponseRecorder: httptest.NewRecorder(),
+		}
+		s.StreamEvents(w, request)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.StringContains(t, "--slasher-event-stream", w.Body.String())
+	})
+}
+
+func TestStreamEvents_LateBlockReorg(t *testing.T) {
+	s := &Server{
+		StateNotifier:     &mockChain.MockStateNotifier{},
+		OperationNotifier: &mockChain.MockOperationNotifier{},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/events?topics="+LateBlockReorgTopic, nil)
+	w := &flushableResponseRecorder{
+		ResponseRecorder: httptest.NewRecorder(),
+	}
+
+	go func() {
+		s.StreamEvents(w, request)
+	}()
+	// wait for initiation of StreamEvents
+	time.Sleep(100 * time.Millisecond)
+	s.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.LateBlockReorgDecision,
+		Data: &forkchoicetypes.LateBlockReorgDecision{
+			Proposing:       true,
+			HeadRoot:        [32]byte{'a'},
+			HeadSlot:        2,
+			HeadWeight:      10,
+			ParentRoot:      [32]byte{'b'},
+			ParentSlot:      1,
+			ParentWeight:    100,
+			HeadArrival:     5 * time.Second,
+			TimeIntoSlot:    1500 * time.Millisecond,
+			CommitteeWeight: 500,
+			FinalizedEpoch:  3,
+			Reorg:           true,
+		},
+	})
+
+	// wait for feed
+	time.Sleep(1 * time.Second)
+	request.Context().Done()
+
+	resp := w.Result()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NotNil(t, body)
+	assert.Equal(t, lateBlockReorgResult, string(body))
+}
+
 const operationsResult = `:
 
 event: attestation
@@ -448,6 +599,20 @@ data: {"slot":"0","block":"0xeade62f0457b2fdf48e7d3fc4b60736688286be7c7a3ac4c9a1
 
 `
 
+const indexedAttestationResult = `:
+
+event: indexed_attestation
+data: {"attesting_indices":["1","2"],"data":{"slot":"0","index":"0","beacon_block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},"target":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"}},"signature":"0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}
+
+`
+
+const lateBlockReorgResult = `:
+
+event: late_block_reorg
+data: {"proposing":true,"head_root":"0x6100000000000000000000000000000000000000000000000000000000000000","head_slot":"2","head_weight":"10","parent_root":"0x6200000000000000000000000000000000000000000000000000000000000000","parent_slot":"1","parent_weight":"100","head_arrival_millis":"5000","time_into_slot_millis":"1500","committee_weight":"500","finalized_epoch":"3","reorg":true,"reason":""}
+
+`
+
 const payloadAttributesBellatrixResult = `:
 
 event: payload_attributes
@@ -4,6 +4,7 @@
 package events
 
 import (
+	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	opfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
@@ -13,9 +14,11 @@ import (
 // Server defines a server implementation of the gRPC events service,
 // providing RPC endpoints to subscribe to events from the beacon node.
 type Server struct {
-	StateNotifier          statefeed.Notifier
-	OperationNotifier      opfeed.Notifier
-	HeadFetcher            blockchain.HeadFetcher
-	ChainInfoFetcher       blockchain.ChainInfoFetcher
-	TrackedValidatorsCache *cache.TrackedValidatorsCache
+	StateNotifier           statefeed.Notifier
+	OperationNotifier       opfeed.Notifier
+	HeadFetcher             blockchain.HeadFetcher
+	ChainInfoFetcher        blockchain.ChainInfoFetcher
+	TrackedValidatorsCache  *cache.TrackedValidatorsCache
+	SlasherAttestationsFeed *event.Feed
+	SlasherBlockHeadersFeed *event.Feed
 }
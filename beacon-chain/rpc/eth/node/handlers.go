@@ -92,8 +92,9 @@ func (s *Server) GetIdentity(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetVersion requests that the beacon node identify information about its implementation in a
-// format similar to a HTTP User-Agent field.
-func (*Server) GetVersion(w http.ResponseWriter, r *http.Request) {
+// format similar to a HTTP User-Agent field. The response is extended with the versions of the
+// execution client, when known.
+func (s *Server) GetVersion(w http.ResponseWriter, r *http.Request) {
 	_, span := trace.StartSpan(r.Context(), "node.GetVersion")
 	defer span.End()
 
@@ -103,6 +104,11 @@ func (*Server) GetVersion(w http.ResponseWriter, r *http.Request) {
 			Version: v,
 		},
 	}
+	if s.ExecutionChainInfoFetcher != nil {
+		for _, cv := range s.ExecutionChainInfoFetcher.ExecutionClientVersion() {
+			resp.Data.ExecutionClient = append(resp.Data.ExecutionClient, structs.ClientVersionFromConsensus(cv))
+		}
+	}
 	httputil.WriteJson(w, resp)
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -87,6 +88,25 @@ func TestGetVersion(t *testing.T) {
 	assert.StringContains(t, semVer, resp.Data.Version)
 	assert.StringContains(t, os, resp.Data.Version)
 	assert.StringContains(t, arch, resp.Data.Version)
+	assert.Equal(t, 0, len(resp.Data.ExecutionClient))
+}
+
+func TestGetVersion_ExecutionClient(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/node/version", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s := &Server{
+		ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{
+			ClientVersion: []*enginev1.ClientVersionV1{{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}},
+		},
+	}
+	s.GetVersion(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetVersionResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data.ExecutionClient))
+	assert.DeepEqual(t, &structs.ClientVersionV1{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}, resp.Data.ExecutionClient[0])
 }
 
 func TestGetHealth(t *testing.T) {
@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	corenet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
@@ -13,6 +17,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"go.opencensus.io/trace"
@@ -129,6 +136,389 @@ func (s *Server) RemoveTrustedPeer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetExecutionClientVersion retrieves the name and version of the execution client connected to the node,
+// as last returned by engine_getClientVersionV1.
+func (s *Server) GetExecutionClientVersion(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetExecutionClientVersion")
+	defer span.End()
+
+	versions := s.ExecutionChainInfoFetcher.ExecutionClientVersion()
+	if len(versions) == 0 {
+		httputil.HandleError(w, "Execution client version is not known", http.StatusServiceUnavailable)
+		return
+	}
+	data := make([]*structs.ClientVersionV1, len(versions))
+	for i, v := range versions {
+		data[i] = structs.ClientVersionFromConsensus(v)
+	}
+	httputil.WriteJson(w, &structs.GetExecutionClientVersionResponse{Data: data})
+}
+
+// ListConnectionFilterRules retrieves the runtime allow and deny rules of the connection gater.
+func (s *Server) ListConnectionFilterRules(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.ListConnectionFilterRules")
+	defer span.End()
+
+	rules := s.ConnectionFilterManager.ConnectionFilterRules()
+	data := make([]*structs.ConnectionFilterRule, len(rules))
+	for i, rule := range rules {
+		data[i] = &structs.ConnectionFilterRule{Action: string(rule.Action), Target: rule.Target}
+	}
+	httputil.WriteJson(w, &structs.ConnectionFilterRulesResponse{Data: data})
+}
+
+// AddConnectionFilterRule allows or denies connections with a peer ID, an IP address or a CIDR subnet.
+// The rule is persisted and connected peers matching a deny rule are disconnected.
+func (s *Server) AddConnectionFilterRule(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.AddConnectionFilterRule")
+	defer span.End()
+
+	var req structs.ConnectionFilterRule
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	rule := &p2p.PeerFilterRule{Action: p2p.PeerFilterAction(req.Action), Target: req.Target}
+	if err := s.ConnectionFilterManager.AddConnectionFilterRule(rule); err != nil {
+		httputil.HandleError(w, "Could not add connection filter rule: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// RemoveConnectionFilterRule removes the rule for the peer ID, IP address or CIDR subnet given in the
+// target query parameter. Removing a rule which does not exist returns 404.
+func (s *Server) RemoveConnectionFilterRule(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.RemoveConnectionFilterRule")
+	defer span.End()
+
+	target := r.URL.Query().Get("target")
+	if target == "" {
+		httputil.HandleError(w, "target is required in URL params", http.StatusBadRequest)
+		return
+	}
+	removed, err := s.ConnectionFilterManager.RemoveConnectionFilterRule(target)
+	if err != nil {
+		httputil.HandleError(w, "Could not remove connection filter rule: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !removed {
+		httputil.HandleError(w, "No connection filter rule for target "+target, http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetPeerScores retrieves the gossipsub score components of peers alongside the scores of Prysm's own
+// peer scorers. Connected peers are returned, unless a single peer is requested with the peer_id parameter.
+// Time in mesh is expressed in milliseconds.
+func (s *Server) GetPeerScores(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetPeerScores")
+	defer span.End()
+
+	peerStatus := s.PeersFetcher.Peers()
+	ids := peerStatus.Connected()
+	if rawId := r.URL.Query().Get("peer_id"); rawId != "" {
+		pid, err := peer.Decode(rawId)
+		if err != nil {
+			httputil.HandleError(w, "Could not decode peer id: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		ids = []peer.ID{pid}
+	}
+	data := make([]*structs.PeerScore, 0, len(ids))
+	for _, id := range ids {
+		score, err := httpPeerScore(peerStatus, id)
+		if err != nil {
+			if errors.Is(err, peerdata.ErrPeerUnknown) {
+				httputil.HandleError(w, "Peer not found", http.StatusNotFound)
+				return
+			}
+			httputil.HandleError(w, "Could not get peer score: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data = append(data, score)
+	}
+	httputil.WriteJson(w, &structs.GetPeerScoresResponse{Data: data})
+}
+
+func httpPeerScore(peerStatus *peers.Status, id peer.ID) (*structs.PeerScore, error) {
+	connectionState, err := peerStatus.ConnectionState(id)
+	if err != nil {
+		return nil, err
+	}
+	direction, err := peerStatus.Direction(id)
+	if err != nil {
+		return nil, err
+	}
+	scorers := peerStatus.Scorers()
+	gScore, bPenalty, topicScores, err := scorers.GossipScorer().GossipData(id)
+	if err != nil {
+		return nil, err
+	}
+	appScore, ipColocation, err := scorers.GossipScorer().ScoreComponents(id)
+	if err != nil {
+		return nil, err
+	}
+	badResponses, err := scorers.BadResponsesScorer().Count(id)
+	if err != nil {
+		return nil, err
+	}
+	topics := make(map[string]*structs.GossipsubTopicScore, len(topicScores))
+	for topic, ts := range topicScores {
+		topics[topic] = &structs.GossipsubTopicScore{
+			TimeInMesh:               strconv.FormatUint(ts.TimeInMesh, 10),
+			FirstMessageDeliveries:   formatScore(float64(ts.FirstMessageDeliveries)),
+			MeshMessageDeliveries:    formatScore(float64(ts.MeshMessageDeliveries)),
+			InvalidMessageDeliveries: formatScore(float64(ts.InvalidMessageDeliveries)),
+		}
+	}
+	var validationError string
+	if err := scorers.ValidationError(id); err != nil {
+		validationError = err.Error()
+	}
+	return &structs.PeerScore{
+		PeerId:    id.String(),
+		State:     eth.ConnectionState(connectionState).String(),
+		Direction: eth.PeerDirection(direction).String(),
+		Gossipsub: &structs.GossipsubScore{
+			Score:              formatScore(gScore),
+			AppSpecificScore:   formatScore(appScore),
+			IPColocationFactor: formatScore(ipColocation),
+			BehaviourPenalty:   formatScore(bPenalty),
+			Topics:             topics,
+		},
+		Prysm: &structs.PrysmPeerScore{
+			Score:              formatScore(scorers.Score(id)),
+			BadResponsesScore:  formatScore(scorers.BadResponsesScorer().Score(id)),
+			BadResponses:       strconv.Itoa(badResponses),
+			BlockProviderScore: formatScore(scorers.BlockProviderScorer().Score(id)),
+			ProcessedBlocks:    strconv.FormatUint(scorers.BlockProviderScorer().ProcessedBlocks(id), 10),
+			PeerStatusScore:    formatScore(scorers.PeerStatusScorer().Score(id)),
+			GossipScore:        formatScore(scorers.GossipScorer().Score(id)),
+			ValidationError:    validationError,
+		},
+	}, nil
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
+// GetBandwidth retrieves the traffic of the node, in total, for each libp2p protocol and for each gossip topic.
+// Totals are expressed in bytes and rates in bytes per second. Protocols and topics are ordered from the
+// most to the least traffic.
+func (s *Server) GetBandwidth(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetBandwidth")
+	defer span.End()
+
+	stats := s.BandwidthProvider.BandwidthStats()
+	httputil.WriteJson(w, &structs.GetBandwidthResponse{
+		Data: &structs.Bandwidth{
+			Total:     httpBandwidthUsage("", stats.Total),
+			Protocols: httpBandwidthUsages(stats.Protocols),
+			Topics:    httpBandwidthUsages(stats.Topics),
+		},
+	})
+}
+
+func httpBandwidthUsages(usages map[string]p2p.BandwidthUsage) []*structs.BandwidthUsage {
+	names := make([]string, 0, len(usages))
+	for name := range usages {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		ti := usages[names[i]].TotalIn + usages[names[i]].TotalOut
+		tj := usages[names[j]].TotalIn + usages[names[j]].TotalOut
+		if ti != tj {
+			return ti > tj
+		}
+		return names[i] < names[j]
+	})
+	result := make([]*structs.BandwidthUsage, len(names))
+	for i, name := range names {
+		result[i] = httpBandwidthUsage(name, usages[name])
+	}
+	return result
+}
+
+func httpBandwidthUsage(name string, usage p2p.BandwidthUsage) *structs.BandwidthUsage {
+	return &structs.BandwidthUsage{
+		Name:     name,
+		TotalIn:  strconv.FormatInt(usage.TotalIn, 10),
+		TotalOut: strconv.FormatInt(usage.TotalOut, 10),
+		RateIn:   strconv.FormatFloat(usage.RateIn, 'f', -1, 64),
+		RateOut:  strconv.FormatFloat(usage.RateOut, 'f', -1, 64),
+	}
+}
+
+// GetRateLimits reports how peers use their req/resp rate limit quotas: the cost of their accepted requests,
+// how many of their requests were rate limited and their remaining quota, per topic.
+func (s *Server) GetRateLimits(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetRateLimits")
+	defer span.End()
+
+	var limits []*sync.PeerRateLimits
+	if rawId := r.URL.Query().Get("peer_id"); rawId != "" {
+		pid, err := peer.Decode(rawId)
+		if err != nil {
+			httputil.HandleError(w, "Could not decode peer id: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		peerLimits, ok := s.RateLimitReporter.PeerRateLimits(pid)
+		if !ok {
+			httputil.HandleError(w, "Peer made no request", http.StatusNotFound)
+			return
+		}
+		limits = []*sync.PeerRateLimits{peerLimits}
+	} else {
+		limits = s.RateLimitReporter.RateLimits()
+	}
+	data := make([]*structs.PeerRateLimits, 0, len(limits))
+	for _, l := range limits {
+		data = append(data, httpPeerRateLimits(l))
+	}
+	httputil.WriteJson(w, &structs.GetRateLimitsResponse{Data: data})
+}
+
+func httpPeerRateLimits(limits *sync.PeerRateLimits) *structs.PeerRateLimits {
+	usage := make([]*structs.RateLimitUsage, 0, len(limits.Usage))
+	for _, u := range limits.Usage {
+		var lastRateLimited string
+		if !u.LastRateLimited.IsZero() {
+			lastRateLimited = u.LastRateLimited.UTC().Format(time.RFC3339)
+		}
+		usage = append(usage, &structs.RateLimitUsage{
+			Topic:           u.Topic,
+			Requests:        strconv.FormatUint(u.Requests, 10),
+			RateLimited:     strconv.FormatUint(u.RateLimited, 10),
+			LastRateLimited: lastRateLimited,
+			Remaining:       strconv.FormatInt(u.Remaining, 10),
+			Capacity:        strconv.FormatInt(u.Capacity, 10),
+		})
+	}
+	return &structs.PeerRateLimits{
+		PeerId:  limits.PeerID.String(),
+		Trusted: limits.Trusted,
+		Usage:   usage,
+	}
+}
+
+// GetCrawledNodes returns the beacon nodes found by the discovery crawler, optionally filtered by attestation
+// subnet, sync committee subnet, fork digest or client.
+func (s *Server) GetCrawledNodes(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetCrawledNodes")
+	defer span.End()
+
+	var filter p2p.CrawledNodeFilter
+	rawAttnet, attnet, ok := shared.UintFromQuery(w, r, "attnet", false)
+	if !ok {
+		return
+	}
+	if rawAttnet != "" {
+		filter.Attnet = &attnet
+	}
+	rawSyncnet, syncnet, ok := shared.UintFromQuery(w, r, "syncnet", false)
+	if !ok {
+		return
+	}
+	if rawSyncnet != "" {
+		filter.Syncnet = &syncnet
+	}
+	_, digest, ok := shared.HexFromQuery(w, r, "fork_digest", 4, false)
+	if !ok {
+		return
+	}
+	if digest != nil {
+		forkDigest := bytesutil.ToBytes4(digest)
+		filter.ForkDigest = &forkDigest
+	}
+	filter.Client = strings.ToLower(r.URL.Query().Get("client"))
+
+	nodes := s.CrawledNodesProvider.CrawledNodes(filter)
+	data := make([]*structs.CrawledNode, 0, len(nodes))
+	for _, n := range nodes {
+		data = append(data, httpCrawledNode(n))
+	}
+	httputil.WriteJson(w, &structs.GetCrawledNodesResponse{Data: data})
+}
+
+// GetBuilderRelays returns the health of the builder relays, reporting those blacklisted by the relay
+// circuit breaker after failing to reveal a payload or offering an invalid bid.
+func (s *Server) GetBuilderRelays(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetBuilderRelays")
+	defer span.End()
+
+	statuses := s.RelayHealthManager.RelayStatuses()
+	data := make([]*structs.BuilderRelay, len(statuses))
+	for i, st := range statuses {
+		data[i] = &structs.BuilderRelay{
+			Name:          st.Name,
+			Blacklisted:   st.Blacklisted,
+			Blacklistings: strconv.FormatUint(st.Blacklistings, 10),
+		}
+		if st.Blacklisted {
+			data[i].BlacklistedUntil = st.BlacklistedUntil.UTC().Format(time.RFC3339)
+			data[i].BlacklistReason = st.BlacklistReason
+		}
+	}
+	httputil.WriteJson(w, &structs.GetBuilderRelaysResponse{Data: data})
+}
+
+// ResetBuilderRelayBlacklist lifts the blacklisting of the relay given by the relay parameter, or of all
+// the relays when it is omitted.
+func (s *Server) ResetBuilderRelayBlacklist(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.ResetBuilderRelayBlacklist")
+	defer span.End()
+
+	name := r.URL.Query().Get("relay")
+	if !s.RelayHealthManager.ResetRelayBlacklist(name) {
+		if name == "" {
+			httputil.HandleError(w, "No builder relay is configured", http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "No builder relay named "+name, http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func httpCrawledNode(n *p2p.CrawledNode) *structs.CrawledNode {
+	formatPort := func(port uint) string {
+		if port == 0 {
+			return ""
+		}
+		return strconv.FormatUint(uint64(port), 10)
+	}
+	var ip string
+	if n.IP != nil {
+		ip = n.IP.String()
+	}
+	return &structs.CrawledNode{
+		NodeId:          n.ID.String(),
+		PeerId:          n.PeerID.String(),
+		Enr:             n.ENR,
+		Seq:             strconv.FormatUint(n.Seq, 10),
+		Ip:              ip,
+		TcpPort:         formatPort(n.TCP),
+		UdpPort:         formatPort(n.UDP),
+		QuicPort:        formatPort(n.QUIC),
+		ForkDigest:      hexutil.Encode(n.ForkDigest[:]),
+		NextForkVersion: hexutil.Encode(n.NextForkVersion[:]),
+		NextForkEpoch:   strconv.FormatUint(uint64(n.NextForkEpoch), 10),
+		Attnets:         hexutil.Encode(n.Attnets),
+		Syncnets:        hexutil.Encode(n.Syncnets),
+		Client:          n.Client,
+		FirstSeen:       n.FirstSeen.UTC().Format(time.RFC3339),
+		LastSeen:        n.LastSeen.UTC().Format(time.RFC3339),
+	}
+}
+
 // httpPeerInfo does the same thing as peerInfo function in node.go but returns the
 // http peer response.
 func httpPeerInfo(peerStatus *peers.Status, id peer.ID) (*structs.Peer, error) {
@@ -3,10 +3,13 @@ package node
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
@@ -14,11 +17,17 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
 	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -249,3 +258,459 @@ func TestRemoveTrustedPeer_EmptyParameter(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
 	assert.Equal(t, "Could not decode peer id: failed to parse peer ID: invalid cid: cid too short", e.Message)
 }
+
+func TestGetExecutionClientVersion(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		s := Server{ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{
+			ClientVersion: []*enginev1.ClientVersionV1{{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}},
+		}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/execution_client_version", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetExecutionClientVersion(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetExecutionClientVersionResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, &structs.ClientVersionV1{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}, resp.Data[0])
+	})
+	t.Run("unknown version", func(t *testing.T) {
+		s := Server{ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/execution_client_version", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetExecutionClientVersion(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Execution client version is not known", e.Message)
+	})
+}
+
+type mockConnectionFilterManager struct {
+	rules []*p2p.PeerFilterRule
+}
+
+func (m *mockConnectionFilterManager) ConnectionFilterRules() []*p2p.PeerFilterRule {
+	return m.rules
+}
+
+func (m *mockConnectionFilterManager) AddConnectionFilterRule(rule *p2p.PeerFilterRule) error {
+	if rule.Action != p2p.PeerFilterAllow && rule.Action != p2p.PeerFilterDeny {
+		return errors.New("invalid peer filter action")
+	}
+	m.rules = append(m.rules, rule)
+	return nil
+}
+
+func (m *mockConnectionFilterManager) RemoveConnectionFilterRule(target string) (bool, error) {
+	for i, r := range m.rules {
+		if r.Target == target {
+			m.rules = append(m.rules[:i], m.rules[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestConnectionFilterRules(t *testing.T) {
+	manager := &mockConnectionFilterManager{}
+	s := Server{ConnectionFilterManager: manager}
+
+	t.Run("add", func(t *testing.T) {
+		body := bytes.NewBufferString(`{"action":"deny","target":"212.67.10.0/24"}`)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/connection_filter", body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.AddConnectionFilterRule(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, 1, len(manager.rules))
+	})
+	t.Run("add invalid", func(t *testing.T) {
+		body := bytes.NewBufferString(`{"action":"block","target":"212.67.10.0/24"}`)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/connection_filter", body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.AddConnectionFilterRule(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "invalid peer filter action", writer.Body.String())
+	})
+	t.Run("add no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/connection_filter", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.AddConnectionFilterRule(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("list", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/connection_filter", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListConnectionFilterRules(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ConnectionFilterRulesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "deny", resp.Data[0].Action)
+		assert.Equal(t, "212.67.10.0/24", resp.Data[0].Target)
+	})
+	t.Run("remove", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/node/connection_filter?target=212.67.10.0/24", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.RemoveConnectionFilterRule(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, 0, len(manager.rules))
+
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.RemoveConnectionFilterRule(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("remove no target", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/node/connection_filter", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.RemoveConnectionFilterRule(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetPeerScores(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerStatus := peerFetcher.Peers()
+	id0, err := peer.Decode(mockp2p.MockRawPeerId0)
+	require.NoError(t, err)
+	peerStatus.Scorers().GossipScorer().SetGossipData(id0, -12.5, 3, map[string]*ethpb.TopicScoreSnapshot{
+		"/eth2/beacon_block": {TimeInMesh: 120000, FirstMessageDeliveries: 4, InvalidMessageDeliveries: 1},
+	})
+	peerStatus.Scorers().GossipScorer().SetScoreComponents(id0, 0.5, 2)
+	peerStatus.Scorers().BadResponsesScorer().Increment(id0)
+	s := Server{PeersFetcher: peerFetcher}
+
+	t.Run("all connected peers", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peer_scores", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPeerScores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPeerScoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 2, len(resp.Data))
+	})
+	t.Run("single peer", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peer_scores?peer_id="+mockp2p.MockRawPeerId0, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPeerScores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPeerScoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		score := resp.Data[0]
+		assert.Equal(t, mockp2p.MockRawPeerId0, score.PeerId)
+		assert.Equal(t, "CONNECTED", score.State)
+		assert.Equal(t, "INBOUND", score.Direction)
+		assert.Equal(t, "-12.5", score.Gossipsub.Score)
+		assert.Equal(t, "0.5", score.Gossipsub.AppSpecificScore)
+		assert.Equal(t, "2", score.Gossipsub.IPColocationFactor)
+		assert.Equal(t, "3", score.Gossipsub.BehaviourPenalty)
+		topic, ok := score.Gossipsub.Topics["/eth2/beacon_block"]
+		require.Equal(t, true, ok)
+		assert.Equal(t, "120000", topic.TimeInMesh)
+		assert.Equal(t, "4", topic.FirstMessageDeliveries)
+		assert.Equal(t, "1", topic.InvalidMessageDeliveries)
+		assert.Equal(t, "1", score.Prysm.BadResponses)
+	})
+	t.Run("unknown peer", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peer_scores?peer_id=16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPeerScores(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid peer id", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peer_scores?peer_id=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPeerScores(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+type mockBandwidthProvider struct {
+	stats *p2p.BandwidthStats
+}
+
+func (m *mockBandwidthProvider) BandwidthStats() *p2p.BandwidthStats {
+	return m.stats
+}
+
+func TestGetBandwidth(t *testing.T) {
+	s := Server{BandwidthProvider: &mockBandwidthProvider{stats: &p2p.BandwidthStats{
+		Total: p2p.BandwidthUsage{TotalIn: 3000, TotalOut: 1500, RateIn: 12.5, RateOut: 4},
+		Protocols: map[string]p2p.BandwidthUsage{
+			"/meshsub/1.1.0": {TotalIn: 1000, TotalOut: 500},
+			"/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy": {TotalIn: 2000, TotalOut: 1000},
+		},
+		Topics: map[string]p2p.BandwidthUsage{
+			"/eth2/6a95a1a9/beacon_block/ssz_snappy": {TotalIn: 800, TotalOut: 400, RateIn: 1.5},
+		},
+	}}}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/bandwidth", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetBandwidth(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetBandwidthResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "3000", resp.Data.Total.TotalIn)
+	assert.Equal(t, "1500", resp.Data.Total.TotalOut)
+	assert.Equal(t, "12.5", resp.Data.Total.RateIn)
+	require.Equal(t, 2, len(resp.Data.Protocols))
+	assert.Equal(t, "/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy", resp.Data.Protocols[0].Name, "busiest protocol should be first")
+	assert.Equal(t, "/meshsub/1.1.0", resp.Data.Protocols[1].Name)
+	require.Equal(t, 1, len(resp.Data.Topics))
+	assert.Equal(t, "800", resp.Data.Topics[0].TotalIn)
+	assert.Equal(t, "1.5", resp.Data.Topics[0].RateIn)
+}
+
+type mockRateLimitReporter struct {
+	limits []*sync.PeerRateLimits
+}
+
+func (m *mockRateLimitReporter) RateLimits() []*sync.PeerRateLimits {
+	return m.limits
+}
+
+func (m *mockRateLimitReporter) PeerRateLimits(pid peer.ID) (*sync.PeerRateLimits, bool) {
+	for _, l := range m.limits {
+		if l.PeerID == pid {
+			return l, true
+		}
+	}
+	return nil, false
+}
+
+func TestGetRateLimits(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(3)
+	limitedAt := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+	s := Server{RateLimitReporter: &mockRateLimitReporter{limits: []*sync.PeerRateLimits{
+		{
+			PeerID: ids[0],
+			Usage: []*sync.RateLimitUsage{
+				{Topic: "/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy", Requests: 640, RateLimited: 2, LastRateLimited: limitedAt, Remaining: 0, Capacity: 640},
+			},
+		},
+		{
+			PeerID:  ids[1],
+			Trusted: true,
+			Usage: []*sync.RateLimitUsage{
+				{Topic: "rpc-limiter-topic", Requests: 3, Remaining: 7, Capacity: 10},
+			},
+		},
+	}}}
+
+	t.Run("all peers", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/rate_limits", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRateLimits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetRateLimitsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, ids[0].String(), resp.Data[0].PeerId)
+		assert.Equal(t, false, resp.Data[0].Trusted)
+		require.Equal(t, 1, len(resp.Data[0].Usage))
+		assert.Equal(t, "640", resp.Data[0].Usage[0].Requests)
+		assert.Equal(t, "2", resp.Data[0].Usage[0].RateLimited)
+		assert.Equal(t, "2024-08-01T12:00:00Z", resp.Data[0].Usage[0].LastRateLimited)
+		assert.Equal(t, "0", resp.Data[0].Usage[0].Remaining)
+		assert.Equal(t, "640", resp.Data[0].Usage[0].Capacity)
+		assert.Equal(t, true, resp.Data[1].Trusted)
+		assert.Equal(t, "", resp.Data[1].Usage[0].LastRateLimited)
+	})
+	t.Run("single peer", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/rate_limits?peer_id="+ids[1].String(), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRateLimits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetRateLimitsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, ids[1].String(), resp.Data[0].PeerId)
+		assert.Equal(t, "7", resp.Data[0].Usage[0].Remaining)
+	})
+	t.Run("peer without requests", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/rate_limits?peer_id="+ids[2].String(), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRateLimits(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid peer ID", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/rate_limits?peer_id=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRateLimits(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+type mockCrawledNodesProvider struct {
+	nodes  []*p2p.CrawledNode
+	filter p2p.CrawledNodeFilter
+}
+
+func (m *mockCrawledNodesProvider) CrawledNodes(filter p2p.CrawledNodeFilter) []*p2p.CrawledNode {
+	m.filter = filter
+	return m.nodes
+}
+
+func TestGetCrawledNodes(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(1)
+	seen := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+	provider := &mockCrawledNodesProvider{nodes: []*p2p.CrawledNode{
+		{
+			ID:              enode.ID{0x01},
+			PeerID:          ids[0],
+			ENR:             "enr:-test",
+			Seq:             3,
+			IP:              net.ParseIP("192.0.2.1"),
+			TCP:             13000,
+			UDP:             12000,
+			ForkDigest:      [4]byte{0xaa, 0xbb, 0xcc, 0xdd},
+			NextForkVersion: [4]byte{0x01, 0x00, 0x00, 0x00},
+			NextForkEpoch:   100,
+			Attnets:         bitfield.Bitvector64{0x20, 0, 0, 0, 0, 0, 0, 0},
+			Syncnets:        bitfield.Bitvector4{0x04},
+			Client:          "prysm",
+			FirstSeen:       seen.Add(-time.Hour),
+			LastSeen:        seen,
+		},
+	}}
+	s := Server{CrawledNodesProvider: provider}
+
+	t.Run("OK", func(t *testing.T) {
+		url := "http://example.com/prysm/v1/node/crawled_nodes?attnet=5&syncnet=2&fork_digest=0xaabbccdd&client=Prysm"
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetCrawledNodes(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		require.NotNil(t, provider.filter.Attnet)
+		assert.Equal(t, uint64(5), *provider.filter.Attnet)
+		require.NotNil(t, provider.filter.Syncnet)
+		assert.Equal(t, uint64(2), *provider.filter.Syncnet)
+		require.NotNil(t, provider.filter.ForkDigest)
+		assert.Equal(t, [4]byte{0xaa, 0xbb, 0xcc, 0xdd}, *provider.filter.ForkDigest)
+		assert.Equal(t, "prysm", provider.filter.Client)
+
+		resp := &structs.GetCrawledNodesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		n := resp.Data[0]
+		assert.Equal(t, ids[0].String(), n.PeerId)
+		assert.Equal(t, "enr:-test", n.Enr)
+		assert.Equal(t, "3", n.Seq)
+		assert.Equal(t, "192.0.2.1", n.Ip)
+		assert.Equal(t, "13000", n.TcpPort)
+		assert.Equal(t, "12000", n.UdpPort)
+		assert.Equal(t, "", n.QuicPort)
+		assert.Equal(t, "0xaabbccdd", n.ForkDigest)
+		assert.Equal(t, "0x01000000", n.NextForkVersion)
+		assert.Equal(t, "100", n.NextForkEpoch)
+		assert.Equal(t, "0x2000000000000000", n.Attnets)
+		assert.Equal(t, "0x04", n.Syncnets)
+		assert.Equal(t, "prysm", n.Client)
+		assert.Equal(t, "2024-08-01T11:00:00Z", n.FirstSeen)
+		assert.Equal(t, "2024-08-01T12:00:00Z", n.LastSeen)
+	})
+	t.Run("no filter", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/crawled_nodes", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetCrawledNodes(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, true, provider.filter.Attnet == nil)
+		assert.Equal(t, true, provider.filter.Syncnet == nil)
+		assert.Equal(t, true, provider.filter.ForkDigest == nil)
+		assert.Equal(t, "", provider.filter.Client)
+	})
+	t.Run("invalid fork digest", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/crawled_nodes?fork_digest=0xaabb", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetCrawledNodes(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid attnet", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/crawled_nodes?attnet=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetCrawledNodes(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+type mockRelayHealthManager struct {
+	statuses []*builder.RelayStatus
+	reset    []string
+}
+
+func (m *mockRelayHealthManager) RelayStatuses() []*builder.RelayStatus {
+	return m.statuses
+}
+
+func (m *mockRelayHealthManager) ResetRelayBlacklist(name string) bool {
+	found := false
+	for _, st := range m.statuses {
+		if name == "" || st.Name == name {
+			found = true
+			m.reset = append(m.reset, st.Name)
+			st.Blacklisted = false
+		}
+	}
+	return found
+}
+
+func TestBuilderRelays(t *testing.T) {
+	until := time.Date(2024, 8, 1, 13, 0, 0, 0, time.UTC)
+	manager := &mockRelayHealthManager{statuses: []*builder.RelayStatus{
+		{Name: "good.relay"},
+		{Name: "bad.relay", Blacklisted: true, BlacklistedUntil: until, BlacklistReason: "payload not revealed: timeout", Blacklistings: 2},
+	}}
+	s := Server{RelayHealthManager: manager}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/builder_relays", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetBuilderRelays(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetBuilderRelaysResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.DeepEqual(t, []*structs.BuilderRelay{
+		{Name: "good.relay", Blacklistings: "0"},
+		{Name: "bad.relay", Blacklisted: true, BlacklistedUntil: "2024-08-01T13:00:00Z", BlacklistReason: "payload not revealed: timeout", Blacklistings: "2"},
+	}, resp.Data)
+
+	t.Run("reset unknown relay", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/node/builder_relays/blacklist?relay=unknown.relay", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ResetBuilderRelayBlacklist(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+		require.StringContains(t, "No builder relay named unknown.relay", writer.Body.String())
+	})
+	t.Run("reset relay", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/node/builder_relays/blacklist?relay=bad.relay", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ResetBuilderRelayBlacklist(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.DeepEqual(t, []string{"bad.relay"}, manager.reset)
+	})
+}
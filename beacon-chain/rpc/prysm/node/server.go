@@ -2,6 +2,7 @@ package node
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
@@ -14,6 +15,11 @@ type Server struct {
 	BeaconDB                  db.ReadOnlyDatabase
 	PeersFetcher              p2p.PeersProvider
 	PeerManager               p2p.PeerManager
+	ConnectionFilterManager   p2p.ConnectionFilterManager
+	BandwidthProvider         p2p.BandwidthProvider
+	CrawledNodesProvider      p2p.CrawledNodesProvider
+	RelayHealthManager        builder.RelayHealthManager
+	RateLimitReporter         sync.RateLimitReporter
 	MetadataProvider          p2p.MetadataProvider
 	GenesisTimeFetcher        blockchain.TimeFetcher
 	HeadFetcher               blockchain.HeadFetcher
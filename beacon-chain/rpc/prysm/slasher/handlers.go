@@ -0,0 +1,345 @@
+package slasher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/slice"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+)
+
+const (
+	defaultDetectedSlashingsPageSize = 100
+	maxDetectedSlashingsPageSize     = 1000
+)
+
+// GetDetectedSlashings retrieves a page of the slashings detected by the slasher of this node, sorted
+// by the epoch of the offense, optionally only those of the validator given by the validator_index
+// parameter. The page_token parameter is the next_page_token of the previous page.
+func (s *Server) GetDetectedSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetDetectedSlashings")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	rawIndex, index, ok := shared.UintFromQuery(w, r, "validator_index", false)
+	if !ok {
+		return
+	}
+	rawPageSize, pageSize, ok := shared.UintFromQuery(w, r, "page_size", false)
+	if !ok {
+		return
+	}
+	if rawPageSize == "" {
+		pageSize = defaultDetectedSlashingsPageSize
+	}
+	if pageSize == 0 || pageSize > maxDetectedSlashingsPageSize {
+		httputil.HandleError(w, fmt.Sprintf("page_size must be between 1 and %d", maxDetectedSlashingsPageSize), http.StatusBadRequest)
+		return
+	}
+	var pageToken []byte
+	if rawPageToken := r.URL.Query().Get("page_token"); rawPageToken != "" {
+		var err error
+		pageToken, err = hexutil.Decode(rawPageToken)
+		if err != nil {
+			httputil.HandleError(w, "Invalid page_token: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// The slashings of other validators are skipped, so more slashings are retrieved until the page is
+	// full or there are none left.
+	data := make([]*structs.DetectedSlashing, 0)
+	for {
+		slashings, nextPageToken, err := s.SlasherQuerier.DetectedSlashings(ctx, pageToken, int(pageSize)-len(data))
+		if err != nil {
+			httputil.HandleError(w, "Could not get detected slashings: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, slashing := range slashings {
+			indices := slashedIndices(slashing)
+			if rawIndex != "" && !slices.Contains(indices, index) {
+				continue
+			}
+			detected, err := detectedSlashingFromConsensus(slashing, indices)
+			if err != nil {
+				httputil.HandleError(w, "Could not convert detected slashing: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			data = append(data, detected)
+		}
+		pageToken = nextPageToken
+		if len(pageToken) == 0 || len(data) == int(pageSize) {
+			break
+		}
+	}
+	resp := &structs.GetDetectedSlashingsResponse{Data: data}
+	if len(pageToken) > 0 {
+		resp.NextPageToken = hexutil.Encode(pageToken)
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// IsSlashableAttestation checks whether the indexed attestation in the request body would be slashable
+// with respect to the attestations in the slasher database, without recording it.
+func (s *Server) IsSlashableAttestation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	var req structs.IndexedAttestation
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	att, err := indexedAttestationToConsensus(r, &req)
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request attestation to consensus attestation: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	slashings, err := s.SlasherQuerier.IsSlashableAttestation(ctx, att)
+	if err != nil {
+		if errors.Is(err, slasher.ErrInvalidAttestation) {
+			httputil.HandleError(w, "Invalid attestation: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		httputil.HandleError(w, "Could not check if attestation is slashable: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]json.RawMessage, len(slashings))
+	for i, slashing := range slashings {
+		data[i], err = attesterSlashingToJSON(slashing)
+		if err != nil {
+			httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.Header().Set(api.VersionHeader, version.String(att.Version()))
+	httputil.WriteJson(w, &structs.IsSlashableAttestationResponse{
+		Version: version.String(att.Version()),
+		Data: &structs.SlashableAttestation{
+			Slashable:         len(data) > 0,
+			AttesterSlashings: data,
+		},
+	})
+}
+
+// IsSlashableBlock checks whether the signed block header in the request body would be slashable
+// with respect to the block proposals in the slasher database, without recording it.
+func (s *Server) IsSlashableBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	var req structs.SignedBeaconBlockHeader
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	header, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request header to consensus header: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	slashings, err := s.SlasherQuerier.IsSlashableBlock(ctx, header)
+	if err != nil {
+		if errors.Is(err, slasher.ErrInvalidBlockHeader) {
+			httputil.HandleError(w, "Invalid block header: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		httputil.HandleError(w, "Could not check if block is slashable: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*structs.ProposerSlashing, len(slashings))
+	for i, slashing := range slashings {
+		data[i] = structs.ProposerSlashingFromConsensus(slashing)
+	}
+	httputil.WriteJson(w, &structs.IsSlashableBlockResponse{
+		Data: &structs.SlashableBlock{
+			Slashable:         len(data) > 0,
+			ProposerSlashings: data,
+		},
+	})
+}
+
+// GetValidatorSpans retrieves the min or max spans, given by the kind parameter, of a validator for
+// the epochs of the chunk containing the epoch parameter. These are the spans displayed offline by
+// `prysmctl db slasher-span-display`.
+func (s *Server) GetValidatorSpans(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetValidatorSpans")
+	defer span.End()
+
+	if !s.slasherEnabled(w) {
+		return
+	}
+	_, index, ok := shared.UintFromRoute(w, r, "validator_index")
+	if !ok {
+		return
+	}
+	_, epoch, ok := shared.UintFromQuery(w, r, "epoch", true)
+	if !ok {
+		return
+	}
+	var kind slashertypes.ChunkKind
+	switch rawKind := r.URL.Query().Get("kind"); rawKind {
+	case "", slashertypes.MinSpan.String():
+		kind = slashertypes.MinSpan
+	case slashertypes.MaxSpan.String():
+		kind = slashertypes.MaxSpan
+	default:
+		httputil.HandleError(w, fmt.Sprintf("Invalid kind %s, must be %s or %s", rawKind, slashertypes.MinSpan, slashertypes.MaxSpan), http.StatusBadRequest)
+		return
+	}
+
+	spans, err := s.SlasherQuerier.ValidatorSpans(ctx, kind, primitives.ValidatorIndex(index), primitives.Epoch(epoch))
+	if err != nil {
+		if errors.Is(err, slasher.ErrSpansNotFound) {
+			httputil.HandleError(w, "No spans found: "+err.Error(), http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not get validator spans: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*structs.EpochSpan, len(spans.Spans))
+	for i, sp := range spans.Spans {
+		data[i] = &structs.EpochSpan{
+			Epoch: fmt.Sprintf("%d", sp.Epoch),
+			Span:  fmt.Sprintf("%d", sp.Span),
+		}
+	}
+	httputil.WriteJson(w, &structs.GetValidatorSpansResponse{
+		Data: &structs.ValidatorSpans{
+			ValidatorIndex:   fmt.Sprintf("%d", spans.ValidatorIndex),
+			Kind:             spans.Kind.String(),
+			LastEpochWritten: fmt.Sprintf("%d", spans.LastEpochWritten),
+			Spans:            data,
+		},
+	})
+}
+
+func (s *Server) slasherEnabled(w http.ResponseWriter) bool {
+	if s.SlasherQuerier == nil {
+		httputil.HandleError(w, "Slasher is not enabled on this node", http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+// indexedAttestationToConsensus converts an indexed attestation of the fork given by the
+// Eth-Consensus-Version header, or else of the fork at the slot of the attestation.
+func indexedAttestationToConsensus(r *http.Request, req *structs.IndexedAttestation) (ethpb.IndexedAtt, error) {
+	var v int
+	if versionHeader := r.Header.Get(api.VersionHeader); versionHeader != "" {
+		var err error
+		v, err = version.FromString(versionHeader)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if req.Data == nil {
+			return nil, errors.New("missing attestation data")
+		}
+		slot, err := strconv.ParseUint(req.Data.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse attestation slot")
+		}
+		v = version.Phase0
+		if slots.ToEpoch(primitives.Slot(slot)) >= params.BeaconConfig().ElectraForkEpoch {
+			v = version.Electra
+		}
+	}
+	if v >= version.Electra {
+		electraReq := &structs.IndexedAttestationElectra{
+			AttestingIndices: req.AttestingIndices,
+			Data:             req.Data,
+			Signature:        req.Signature,
+		}
+		return electraReq.ToConsensus()
+	}
+	return req.ToConsensus()
+}
+
+// attesterSlashingToJSON marshals an attester slashing with the JSON structure of its fork.
+func attesterSlashingToJSON(slashing ethpb.AttSlashing) (json.RawMessage, error) {
+	var attSlashing interface{}
+	switch s := slashing.(type) {
+	case *ethpb.AttesterSlashing:
+		attSlashing = structs.AttesterSlashingFromConsensus(s)
+	case *ethpb.AttesterSlashingElectra:
+		attSlashing = structs.AttesterSlashingElectraFromConsensus(s)
+	default:
+		return nil, fmt.Errorf("unexpected attester slashing type %T", s)
+	}
+	enc, err := json.Marshal(attSlashing)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attester slashing")
+	}
+	return enc, nil
+}
+
+// slashedIndices returns the indices of the validators slashed by a detected slashing.
+func slashedIndices(slashing *slashertypes.DetectedSlashing) []uint64 {
+	if slashing.ProposerSlashing != nil {
+		return []uint64{uint64(slashing.ProposerSlashing.Header_1.Header.ProposerIndex)}
+	}
+	return slice.IntersectionUint64(
+		slashing.AttesterSlashing.FirstAttestation().GetAttestingIndices(),
+		slashing.AttesterSlashing.SecondAttestation().GetAttestingIndices(),
+	)
+}
+
+func detectedSlashingFromConsensus(slashing *slashertypes.DetectedSlashing, indices []uint64) (*structs.DetectedSlashing, error) {
+	detected := &structs.DetectedSlashing{
+		DetectedAt:       slashing.DetectedAt.UTC().Format(time.RFC3339),
+		ValidatorIndices: make([]string, len(indices)),
+	}
+	for i, index := range indices {
+		detected.ValidatorIndices[i] = strconv.FormatUint(index, 10)
+	}
+
+	if slashing.AttesterSlashing == nil {
+		detected.ProposerSlashing = structs.ProposerSlashingFromConsensus(slashing.ProposerSlashing)
+		return detected, nil
+	}
+	var err error
+	detected.AttesterSlashing, err = attesterSlashingToJSON(slashing.AttesterSlashing)
+	if err != nil {
+		return nil, err
+	}
+	return detected, nil
+}
@@ -0,0 +1,352 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockSlasherQuerier struct {
+	detected          []*slashertypes.DetectedSlashing
+	attesterSlashings []ethpb.AttSlashing
+	proposerSlashings []*ethpb.ProposerSlashing
+	spans             *slasher.ValidatorSpans
+	err               error
+	kind              slashertypes.ChunkKind
+	attestation       ethpb.IndexedAtt
+}
+
+// DetectedSlashings pages through the detected slashings, the page token being the position of the
+// first slashing of the page.
+func (m *mockSlasherQuerier) DetectedSlashings(
+	_ context.Context, pageToken []byte, pageSize int,
+) ([]*slashertypes.DetectedSlashing, []byte, error) {
+	start := 0
+	if len(pageToken) > 0 {
+		start = int(pageToken[0])
+	}
+	end := min(start+pageSize, len(m.detected))
+	var next []byte
+	if end < len(m.detected) {
+		next = []byte{byte(end)}
+	}
+	return m.detected[start:end], next, m.err
+}
+
+func (m *mockSlasherQuerier) IsSlashableAttestation(_ context.Context, att ethpb.IndexedAtt) ([]ethpb.AttSlashing, error) {
+	m.attestation = att
+	return m.attesterSlashings, m.err
+}
+
+func (m *mockSlasherQuerier) IsSlashableBlock(_ context.Context, _ *ethpb.SignedBeaconBlockHeader) ([]*ethpb.ProposerSlashing, error) {
+	return m.proposerSlashings, m.err
+}
+
+func (m *mockSlasherQuerier) ValidatorSpans(
+	_ context.Context, kind slashertypes.ChunkKind, _ primitives.ValidatorIndex, _ primitives.Epoch,
+) (*slasher.ValidatorSpans, error) {
+	m.kind = kind
+	return m.spans, m.err
+}
+
+func testAttesterSlashing() *ethpb.AttesterSlashing {
+	return &ethpb.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{2, 3}}),
+	}
+}
+
+func testProposerSlashing() *ethpb.ProposerSlashing {
+	return &ethpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 5}}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 5}}),
+	}
+}
+
+func TestGetDetectedSlashings(t *testing.T) {
+	detectedAt := time.Date(2024, 8, 1, 13, 0, 0, 0, time.UTC)
+	s := &Server{SlasherQuerier: &mockSlasherQuerier{detected: []*slashertypes.DetectedSlashing{
+		{DetectedAt: detectedAt, AttesterSlashing: testAttesterSlashing()},
+		{DetectedAt: detectedAt.Add(time.Minute), ProposerSlashing: testProposerSlashing()},
+	}}}
+
+	t.Run("all", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetDetectedSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetDetectedSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+
+		assert.Equal(t, "2024-08-01T13:00:00Z", resp.Data[0].DetectedAt)
+		assert.DeepEqual(t, []string{"2"}, resp.Data[0].ValidatorIndices)
+		attSlashing := &structs.AttesterSlashing{}
+		require.NoError(t, json.Unmarshal(resp.Data[0].AttesterSlashing, attSlashing))
+		assert.DeepEqual(t, structs.AttesterSlashingFromConsensus(testAttesterSlashing()), attSlashing)
+		require.IsNil(t, resp.Data[0].ProposerSlashing)
+
+		assert.Equal(t, "2024-08-01T13:01:00Z", resp.Data[1].DetectedAt)
+		assert.DeepEqual(t, []string{"5"}, resp.Data[1].ValidatorIndices)
+		assert.DeepEqual(t, structs.ProposerSlashingFromConsensus(testProposerSlashing()), resp.Data[1].ProposerSlashing)
+		assert.Equal(t, 0, len(resp.Data[1].AttesterSlashing))
+	})
+	t.Run("validator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings?validator_index=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetDetectedSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetDetectedSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, []string{"5"}, resp.Data[0].ValidatorIndices)
+	})
+	t.Run("pages", func(t *testing.T) {
+		s := &Server{SlasherQuerier: &mockSlasherQuerier{detected: []*slashertypes.DetectedSlashing{
+			{DetectedAt: detectedAt, ProposerSlashing: testProposerSlashing()},
+			{DetectedAt: detectedAt, AttesterSlashing: testAttesterSlashing()},
+			{DetectedAt: detectedAt, AttesterSlashing: testAttesterSlashing()},
+			{DetectedAt: detectedAt, ProposerSlashing: testProposerSlashing()},
+			{DetectedAt: detectedAt, ProposerSlashing: testProposerSlashing()},
+		}}}
+		get := func(query string) *structs.GetDetectedSlashingsResponse {
+			request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings?"+query, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.GetDetectedSlashings(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.GetDetectedSlashingsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			return resp
+		}
+
+		resp := get("page_size=2")
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "0x02", resp.NextPageToken)
+		resp = get("page_size=2&page_token=0x02")
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "0x04", resp.NextPageToken)
+		resp = get("page_size=2&page_token=0x04")
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "", resp.NextPageToken)
+
+		// The slashings of other validators are skipped while filling the page.
+		resp = get("page_size=2&validator_index=5")
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, []string{"5"}, resp.Data[1].ValidatorIndices)
+		assert.Equal(t, "0x04", resp.NextPageToken)
+		resp = get("page_size=2&validator_index=5&page_token=0x04")
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "", resp.NextPageToken)
+	})
+	t.Run("invalid page", func(t *testing.T) {
+		for _, query := range []string{"page_size=0", "page_size=1001", "page_token=zz"} {
+			request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings?"+query, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.GetDetectedSlashings(writer, request)
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+		}
+	})
+	t.Run("slasher disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		(&Server{}).GetDetectedSlashings(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		require.StringContains(t, "Slasher is not enabled", writer.Body.String())
+	})
+}
+
+func TestIsSlashableAttestation(t *testing.T) {
+	att := structs.AttesterSlashingFromConsensus(testAttesterSlashing()).Attestation2
+	body, err := json.Marshal(att)
+	require.NoError(t, err)
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{SlasherQuerier: &mockSlasherQuerier{attesterSlashings: []ethpb.AttSlashing{testAttesterSlashing()}}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Data.Slashable)
+		assert.Equal(t, "phase0", resp.Version)
+		require.Equal(t, 1, len(resp.Data.AttesterSlashings))
+		attSlashing := &structs.AttesterSlashing{}
+		require.NoError(t, json.Unmarshal(resp.Data.AttesterSlashings[0], attSlashing))
+		assert.DeepEqual(t, structs.AttesterSlashingFromConsensus(testAttesterSlashing()), attSlashing)
+	})
+	t.Run("electra", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ElectraForkEpoch = 1
+		params.OverrideBeaconConfig(cfg)
+
+		electraSlashing := &ethpb.AttesterSlashingElectra{
+			Attestation_1: &ethpb.IndexedAttestationElectra{
+				AttestingIndices: []uint64{1},
+				Data:             util.HydrateAttestationData(&ethpb.AttestationData{Slot: params.BeaconConfig().SlotsPerEpoch}),
+				Signature:        make([]byte, 96),
+			},
+			Attestation_2: &ethpb.IndexedAttestationElectra{
+				AttestingIndices: []uint64{1},
+				Data:             util.HydrateAttestationData(&ethpb.AttestationData{Slot: params.BeaconConfig().SlotsPerEpoch + 1}),
+				Signature:        make([]byte, 96),
+			},
+		}
+		electraBody, err := json.Marshal(structs.AttesterSlashingElectraFromConsensus(electraSlashing).Attestation2)
+		require.NoError(t, err)
+
+		for _, header := range []string{"", "electra"} {
+			querier := &mockSlasherQuerier{attesterSlashings: []ethpb.AttSlashing{electraSlashing}}
+			s := &Server{SlasherQuerier: querier}
+			request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(electraBody))
+			if header != "" {
+				request.Header.Set(api.VersionHeader, header)
+			}
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.IsSlashableAttestation(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			_, ok := querier.attestation.(*ethpb.IndexedAttestationElectra)
+			require.Equal(t, true, ok)
+			assert.Equal(t, "electra", writer.Header().Get(api.VersionHeader))
+			resp := &structs.IsSlashableAttestationResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			assert.Equal(t, "electra", resp.Version)
+			require.Equal(t, 1, len(resp.Data.AttesterSlashings))
+			attSlashing := &structs.AttesterSlashingElectra{}
+			require.NoError(t, json.Unmarshal(resp.Data.AttesterSlashings[0], attSlashing))
+			assert.DeepEqual(t, structs.AttesterSlashingElectraFromConsensus(electraSlashing), attSlashing)
+		}
+	})
+	t.Run("invalid version", func(t *testing.T) {
+		s := &Server{SlasherQuerier: &mockSlasherQuerier{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		request.Header.Set(api.VersionHeader, "unknown")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{SlasherQuerier: &mockSlasherQuerier{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, false, resp.Data.Slashable)
+		assert.Equal(t, 0, len(resp.Data.AttesterSlashings))
+	})
+	t.Run("invalid attestation", func(t *testing.T) {
+		s := &Server{SlasherQuerier: &mockSlasherQuerier{err: slasher.ErrInvalidAttestation}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("no body", func(t *testing.T) {
+		s := &Server{SlasherQuerier: &mockSlasherQuerier{}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/attestations/slashable", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.StringContains(t, "No data submitted", writer.Body.String())
+	})
+}
+
+func TestIsSlashableBlock(t *testing.T) {
+	body, err := json.Marshal(structs.ProposerSlashingFromConsensus(testProposerSlashing()).SignedHeader2)
+	require.NoError(t, err)
+	s := &Server{SlasherQuerier: &mockSlasherQuerier{proposerSlashings: []*ethpb.ProposerSlashing{testProposerSlashing()}}}
+
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/slasher/blocks/slashable", bytes.NewReader(body))
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.IsSlashableBlock(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.IsSlashableBlockResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, true, resp.Data.Slashable)
+	assert.DeepEqual(t, []*structs.ProposerSlashing{structs.ProposerSlashingFromConsensus(testProposerSlashing())}, resp.Data.ProposerSlashings)
+}
+
+func TestGetValidatorSpans(t *testing.T) {
+	querier := &mockSlasherQuerier{spans: &slasher.ValidatorSpans{
+		Kind:             slashertypes.MaxSpan,
+		ValidatorIndex:   3,
+		LastEpochWritten: 20,
+		Spans:            []*slasher.EpochSpan{{Epoch: 16, Span: 2}, {Epoch: 17, Span: 0}},
+	}}
+	s := &Server{SlasherQuerier: querier}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/3/spans?epoch=17&kind=maxspan", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "3"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorSpans(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, slashertypes.MaxSpan, querier.kind)
+		resp := &structs.GetValidatorSpansResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, &structs.ValidatorSpans{
+			ValidatorIndex:   "3",
+			Kind:             "maxspan",
+			LastEpochWritten: "20",
+			Spans:            []*structs.EpochSpan{{Epoch: "16", Span: "2"}, {Epoch: "17", Span: "0"}},
+		}, resp.Data)
+	})
+	t.Run("invalid kind", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/3/spans?epoch=17&kind=foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "3"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorSpans(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.StringContains(t, "Invalid kind foo", writer.Body.String())
+	})
+	t.Run("no epoch", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/3/spans", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "3"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorSpans(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("not found", func(t *testing.T) {
+		s := &Server{SlasherQuerier: &mockSlasherQuerier{err: slasher.ErrSpansNotFound}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/slasher/validators/3/spans?epoch=17", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "3"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetValidatorSpans(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
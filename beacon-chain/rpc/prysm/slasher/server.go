@@ -0,0 +1,7 @@
+package slasher
+
+import "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+
+type Server struct {
+	SlasherQuerier slasher.Querier
+}
@@ -5,6 +5,7 @@ package node
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"sort"
@@ -15,6 +16,7 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
@@ -110,10 +112,28 @@ func (ns *Server) GetGenesis(ctx context.Context, _ *empty.Empty) (*ethpb.Genesi
 }
 
 // GetVersion checks the version information of the beacon node.
-func (_ *Server) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.Version, error) {
-	return &ethpb.Version{
+// The metadata contains the versions of the execution client as JSON, when known.
+func (ns *Server) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+	v := &ethpb.Version{
 		Version: version.Version(),
-	}, nil
+	}
+	if ns.POWChainInfoFetcher == nil {
+		return v, nil
+	}
+	clientVersion := ns.POWChainInfoFetcher.ExecutionClientVersion()
+	if len(clientVersion) == 0 {
+		return v, nil
+	}
+	metadata := &structs.VersionMetadata{ExecutionClient: make([]*structs.ClientVersionV1, len(clientVersion))}
+	for i, cv := range clientVersion {
+		metadata.ExecutionClient[i] = structs.ClientVersionFromConsensus(cv)
+	}
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal version metadata: %v", err)
+	}
+	v.Metadata = string(b)
+	return v, nil
 }
 
 // ListImplementedServices lists the services implemented and enabled by this node.
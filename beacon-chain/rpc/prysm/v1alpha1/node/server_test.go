@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -80,6 +81,19 @@ func TestNodeServer_GetVersion(t *testing.T) {
 	res, err := ns.GetVersion(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "", res.Metadata)
+}
+
+func TestNodeServer_GetVersion_ExecutionClient(t *testing.T) {
+	ns := &Server{
+		POWChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{
+			ClientVersion: []*enginev1.ClientVersionV1{{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}},
+		},
+	}
+	res, err := ns.GetVersion(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, version.Version(), res.Version)
+	assert.Equal(t, `{"execution_client":[{"code":"GE","name":"Geth","version":"1.14.8","commit":"0xa9523b64"}]}`, res.Metadata)
 }
 
 func TestNodeServer_GetImplementedServices(t *testing.T) {
@@ -233,20 +233,29 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 			return nil, status.Errorf(codes.Internal, "Could not get local payload: %v", err)
 		}
 
+		decision := vs.newPayloadDecision(sBlk.Block())
+
 		// There's no reason to try to get a builder bid if local override is true.
 		var builderBid builderapi.Bid
-		if !(local.OverrideBuilder || skipMevBoost) {
+		switch {
+		case local.OverrideBuilder:
+			decision.UseLocal("execution client overrode the builder")
+		case skipMevBoost:
+			decision.UseLocal("builder skipped by the proposer")
+		default:
 			builderBid, err = vs.getBuilderPayloadAndBlobs(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
 			if err != nil {
 				builderGetPayloadMissCount.Inc()
 				log.WithError(err).Error("Could not get builder payload")
+				decision.UseLocal(fmt.Sprintf("could not get builder bid: %v", err))
 			}
 		}
 
-		winningBid, bundle, err = setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor)
+		winningBid, bundle, err = setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor, decision)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
 		}
+		vs.savePayloadDecisionAsync(ctx, decision)
 	}
 
 	wg.Wait()
@@ -329,6 +338,7 @@ func (vs *Server) handleBlindedBlock(ctx context.Context, block interfaces.Signe
 	}
 
 	payload, bundle, err := vs.BlockBuilder.SubmitBlindedBlock(ctx, block)
+	vs.savePayloadDelivery(ctx, block.Block().Slot(), err)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "submit blinded block failed")
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -53,7 +54,8 @@ var emptyTransactionsRoot = [32]byte{127, 254, 36, 30, 166, 1, 135, 253, 176, 24
 const blockBuilderTimeout = 1 * time.Second
 
 // Sets the execution data for the block. Execution data can come from local EL client or remote builder depends on validator registration and circuit breaker conditions.
-func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, local *blocks.GetPayloadResponse, bid builder.Bid, builderBoostFactor primitives.Gwei) (primitives.Wei, *enginev1.BlobsBundle, error) {
+// The source of the payload and the reason it was chosen are recorded in the decision, which may be nil.
+func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, local *blocks.GetPayloadResponse, bid builder.Bid, builderBoostFactor primitives.Gwei, decision *buildertypes.PayloadDecision) (primitives.Wei, *enginev1.BlobsBundle, error) {
 	_, span := trace.StartSpan(ctx, "ProposerServer.setExecutionData")
 	defer span.End()
 
@@ -66,8 +68,17 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		return primitives.ZeroWei(), nil, errors.New("local payload is nil")
 	}
 
+	if decision != nil {
+		decision.LocalValueGwei = primitives.WeiToGwei(local.Bid)
+		decision.BuilderBoostFactor = builderBoostFactor
+		decision.LocalBoostPercentage = params.BeaconConfig().LocalBlockValueBoost
+	}
+
 	// Use local payload if builder payload is nil.
 	if bid == nil {
+		if decision != nil && decision.Reason == "" {
+			decision.UseLocal("no builder bid")
+		}
 		return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 	}
 
@@ -75,6 +86,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 	builderPayload, err := bid.Header()
 	if err != nil {
 		log.WithError(err).Warn("Proposer: failed to retrieve header from BuilderBid")
+		decision.UseLocal(fmt.Sprintf("invalid builder bid header: %v", err))
 		return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 	}
 	if bid.Version() >= version.Deneb {
@@ -83,6 +95,10 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 			log.WithError(err).Warn("Proposer: failed to retrieve kzg commitments from BuilderBid")
 		}
 	}
+	if decision != nil {
+		decision.BuilderBlockHash = builderPayload.BlockHash()
+		decision.BuilderValueGwei = primitives.WeiToGwei(bid.Value())
+	}
 
 	switch {
 	case blk.Version() >= version.Capella:
@@ -90,6 +106,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		if err != nil {
 			tracing.AnnotateError(span, err)
 			log.WithError(err).Warn("Proposer: failed to match withdrawals root")
+			decision.UseLocal(fmt.Sprintf("could not match withdrawals root: %v", err))
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		}
 
@@ -103,6 +120,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 				"minBuilderBid":    minBid,
 				"builderGweiValue": builderValueGwei,
 			}).Warn("Proposer: using local execution payload because min bid not attained")
+			decision.UseLocal(fmt.Sprintf("builder value below the minimum bid of %d gwei", minBid))
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		}
 
@@ -114,6 +132,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 				"minBidDiff":       minDiff,
 				"builderGweiValue": builderValueGwei,
 			}).Warn("Proposer: using local execution payload because min difference with local value was not attained")
+			decision.UseLocal(fmt.Sprintf("builder value below the minimum difference of %d gwei with the local value", params.BeaconConfig().MinBuilderDiff))
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		}
 
@@ -136,8 +155,10 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		if higherValueBuilder && withdrawalsMatched { // Builder value is higher and withdrawals match.
 			if err := setBuilderExecution(blk, builderPayload, builderKzgCommitments); err != nil {
 				log.WithError(err).Warn("Proposer: failed to set builder payload")
+				decision.UseLocal(fmt.Sprintf("could not set builder payload: %v", err))
 				return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 			} else {
+				decision.UseBuilder("builder value is higher")
 				return bid.Value(), nil, nil
 			}
 		}
@@ -148,6 +169,9 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 				"builderGweiValue":     builderValueGwei,
 				"builderBoostFactor":   builderBoostFactor,
 			}).Warn("Proposer: using local execution payload because higher value")
+			decision.UseLocal("local value is higher")
+		} else {
+			decision.UseLocal("builder withdrawals do not match the local withdrawals")
 		}
 		span.AddAttributes(
 			trace.BoolAttribute("higherValueBuilder", higherValueBuilder),
@@ -160,8 +184,10 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 	default: // Bellatrix case.
 		if err := setBuilderExecution(blk, builderPayload, builderKzgCommitments); err != nil {
 			log.WithError(err).Warn("Proposer: failed to set builder payload")
+			decision.UseLocal(fmt.Sprintf("could not set builder payload: %v", err))
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		} else {
+			decision.UseBuilder("builder payload is always used before capella")
 			return bid.Value(), nil, nil
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	blockchainTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	builderTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -29,6 +30,7 @@ import (
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -104,8 +106,11 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
 		require.NoError(t, err)
 		require.IsNil(t, builderBid)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		decision := &buildertypes.PayloadDecision{}
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, decision)
 		require.NoError(t, err)
+		assert.Equal(t, buildertypes.LocalSource, decision.Source)
+		assert.Equal(t, "no builder bid", decision.Reason)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
@@ -173,8 +178,10 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		decision := &buildertypes.PayloadDecision{}
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, decision)
 		require.NoError(t, err)
+		assert.Equal(t, buildertypes.LocalSource, decision.Source)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
@@ -245,8 +252,11 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		decision := &buildertypes.PayloadDecision{}
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, decision)
 		require.NoError(t, err)
+		assert.Equal(t, buildertypes.BuilderSource, decision.Source)
+		assert.Equal(t, "builder value is higher", decision.Reason)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
@@ -316,7 +326,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, math.MaxUint64)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, math.MaxUint64, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -387,7 +397,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, 0)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, 0, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -413,8 +423,10 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		decision := &buildertypes.PayloadDecision{}
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, decision)
 		require.NoError(t, err)
+		assert.Equal(t, buildertypes.LocalSource, decision.Source)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
@@ -445,7 +457,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -480,7 +492,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		_, err = builderBid.Header()
 		require.NoError(t, err)
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -506,7 +518,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
 		require.ErrorIs(t, consensus_types.ErrNilObjectWrapped, err) // Builder returns fault. Use local block
 		require.IsNil(t, builderBid)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, nil, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, nil, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -630,7 +642,7 @@ func TestServer_setExecutionData(t *testing.T) {
 
 		res, err := vs.getLocalPayload(ctx, blk.Block(), denebTransitionState)
 		require.NoError(t, err)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, nil)
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 
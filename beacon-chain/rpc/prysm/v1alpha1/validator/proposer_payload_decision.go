@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// newPayloadDecision returns the decision recording the payload source of the block, or nil if no
+// builder is configured as there is no choice to audit.
+func (vs *Server) newPayloadDecision(blk interfaces.ReadOnlyBeaconBlock) *buildertypes.PayloadDecision {
+	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() || vs.BeaconDB == nil {
+		return nil
+	}
+	return &buildertypes.PayloadDecision{
+		Slot:          blk.Slot(),
+		ProposerIndex: blk.ProposerIndex(),
+	}
+}
+
+// savePayloadDecisionAsync saves the payload decision of a proposal in the background, so that the
+// database write does not delay the proposal.
+func (vs *Server) savePayloadDecisionAsync(ctx context.Context, decision *buildertypes.PayloadDecision) {
+	if decision == nil {
+		return
+	}
+	vs.payloadDecisionWrites.Add(1)
+	go func() {
+		defer vs.payloadDecisionWrites.Done()
+		vs.savePayloadDecision(context.WithoutCancel(ctx), decision)
+	}()
+}
+
+// savePayloadDecision persists the payload decision of a proposal, along with the relays which
+// offered the winning bid.
+func (vs *Server) savePayloadDecision(ctx context.Context, decision *buildertypes.PayloadDecision) {
+	if decision == nil {
+		return
+	}
+	if decision.Source == buildertypes.BuilderSource {
+		decision.Relays = vs.BlockBuilder.BidRelays(decision.Slot, bytesutil.ToBytes32(decision.BuilderBlockHash))
+	}
+	log.WithFields(logrus.Fields{
+		"slot":             decision.Slot,
+		"source":           decision.Source,
+		"reason":           decision.Reason,
+		"relays":           decision.Relays,
+		"builderGweiValue": decision.BuilderValueGwei,
+		"localGweiValue":   decision.LocalValueGwei,
+	}).Debug("Chose execution payload source")
+	if err := vs.BeaconDB.SavePayloadDecision(ctx, decision); err != nil {
+		log.WithError(err).WithField("slot", decision.Slot).Error("Could not save payload decision")
+	}
+	vs.prunePayloadDecisions(ctx)
+}
+
+// prunePayloadDecisions deletes the payload decisions older than PayloadDecisionHistoryEpochs before
+// the finalized epoch.
+func (vs *Server) prunePayloadDecisions(ctx context.Context) {
+	finalized := vs.FinalizationFetcher.FinalizedCheckpt().Epoch
+	if finalized <= vs.PayloadDecisionHistoryEpochs {
+		return
+	}
+	before, err := slots.EpochStart(finalized - vs.PayloadDecisionHistoryEpochs)
+	if err != nil {
+		log.WithError(err).Error("Could not compute payload decisions retention slot")
+		return
+	}
+	if err := vs.BeaconDB.DeletePayloadDecisionsBefore(ctx, before); err != nil {
+		log.WithError(err).WithField("slot", before).Error("Could not prune payload decisions")
+	}
+}
+
+// savePayloadDelivery records whether the relays revealed the payload of the blinded block proposed
+// at the slot.
+func (vs *Server) savePayloadDelivery(ctx context.Context, slot primitives.Slot, submitErr error) {
+	if vs.BeaconDB == nil {
+		return
+	}
+	// The decision of the proposal may still be being saved.
+	vs.payloadDecisionWrites.Wait()
+	decision, err := vs.BeaconDB.PayloadDecision(ctx, slot)
+	if err != nil {
+		if !errors.Is(err, db.ErrNotFound) {
+			log.WithError(err).WithField("slot", slot).Error("Could not get payload decision")
+		}
+		return
+	}
+	if decision.Source != buildertypes.BuilderSource {
+		return
+	}
+	decision.Delivery = deliveryStatus(submitErr)
+	decision.DeliveryError = ""
+	if submitErr != nil {
+		decision.DeliveryError = submitErr.Error()
+	}
+	if err := vs.BeaconDB.SavePayloadDecision(ctx, decision); err != nil {
+		log.WithError(err).WithField("slot", slot).Error("Could not save payload delivery")
+	}
+}
+
+func deliveryStatus(err error) buildertypes.DeliveryStatus {
+	if err == nil {
+		return buildertypes.DeliveryDelivered
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return buildertypes.DeliveryTimeout
+	}
+	return buildertypes.DeliveryFailed
+}
@@ -0,0 +1,96 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestServer_PayloadDecision(t *testing.T) {
+	ctx := context.Background()
+	vs := &Server{
+		BeaconDB:            dbutil.SetupDB(t),
+		BlockBuilder:        &builderTest.MockBuilderService{HasConfigured: true, Relays: []string{"relay.example"}},
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{}},
+	}
+	b := util.NewBeaconBlockCapella()
+	b.Block.Slot = 10
+	b.Block.ProposerIndex = 3
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	decision := vs.newPayloadDecision(blk.Block())
+	require.NotNil(t, decision)
+	decision.BuilderBlockHash = []byte{'a'}
+	decision.UseBuilder("builder value is higher")
+	// The delivery is recorded once the decision saved in the background is written.
+	vs.savePayloadDecisionAsync(ctx, decision)
+	vs.savePayloadDelivery(ctx, 10, nil)
+	saved, err := vs.BeaconDB.PayloadDecision(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, buildertypes.BuilderSource, saved.Source)
+	assert.Equal(t, buildertypes.DeliveryDelivered, saved.Delivery)
+	assert.DeepEqual(t, []string{"relay.example"}, saved.Relays)
+
+	vs.savePayloadDelivery(ctx, 10, errors.Wrap(context.DeadlineExceeded, "submit blinded block"))
+	saved, err = vs.BeaconDB.PayloadDecision(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, buildertypes.DeliveryTimeout, saved.Delivery)
+	assert.Equal(t, "submit blinded block: context deadline exceeded", saved.DeliveryError)
+
+	vs.savePayloadDelivery(ctx, 10, nil)
+	saved, err = vs.BeaconDB.PayloadDecision(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, buildertypes.DeliveryDelivered, saved.Delivery)
+	assert.Equal(t, "", saved.DeliveryError)
+
+	// The delivery of a local payload is not tracked.
+	decision = vs.newPayloadDecision(blk.Block())
+	decision.Slot = 11
+	decision.UseLocal("local value is higher")
+	vs.savePayloadDecision(ctx, decision)
+	vs.savePayloadDelivery(ctx, 11, errors.New("unknown payload"))
+	saved, err = vs.BeaconDB.PayloadDecision(ctx, 11)
+	require.NoError(t, err)
+	assert.Equal(t, buildertypes.LocalSource, saved.Source)
+	assert.Equal(t, buildertypes.DeliveryStatus(""), saved.Delivery)
+	assert.Equal(t, 0, len(saved.Relays))
+
+	// The decisions older than the history before the finalized epoch are pruned.
+	finalized := slots.ToEpoch(11) + 2
+	vs.PayloadDecisionHistoryEpochs = 1
+	vs.FinalizationFetcher = &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: finalized}}
+	decision = vs.newPayloadDecision(blk.Block())
+	decision.Slot, err = slots.EpochStart(finalized)
+	require.NoError(t, err)
+	decision.UseLocal("local value is higher")
+	vs.savePayloadDecision(ctx, decision)
+	_, err = vs.BeaconDB.PayloadDecision(ctx, 10)
+	require.ErrorIs(t, err, db.ErrNotFound)
+	_, err = vs.BeaconDB.PayloadDecision(ctx, 11)
+	require.ErrorIs(t, err, db.ErrNotFound)
+	_, err = vs.BeaconDB.PayloadDecision(ctx, decision.Slot)
+	require.NoError(t, err)
+
+	// No decision is recorded without a builder.
+	vs.BlockBuilder = &builderTest.MockBuilderService{}
+	require.IsNil(t, vs.newPayloadDecision(blk.Block()))
+}
+
+func TestDeliveryStatus(t *testing.T) {
+	assert.Equal(t, buildertypes.DeliveryDelivered, deliveryStatus(nil))
+	assert.Equal(t, buildertypes.DeliveryTimeout, deliveryStatus(errors.Wrap(context.DeadlineExceeded, "timeout")))
+	assert.Equal(t, buildertypes.DeliveryFailed, deliveryStatus(errors.New("no payload")))
+}
@@ -5,6 +5,7 @@ package validator
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
@@ -26,8 +27,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	beaconsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -57,7 +59,7 @@ type Server struct {
 	ChainStartFetcher      execution.ChainStartFetcher
 	Eth1InfoFetcher        execution.ChainInfoFetcher
 	OptimisticModeFetcher  blockchain.OptimisticModeFetcher
-	SyncChecker            sync.Checker
+	SyncChecker            beaconsync.Checker
 	StateNotifier          statefeed.Notifier
 	BlockNotifier          blockfeed.Notifier
 	P2P                    p2p.Broadcaster
@@ -76,9 +78,14 @@ type Server struct {
 	BeaconDB               db.HeadAccessDatabase
 	ExecutionEngineCaller  execution.EngineCaller
 	BlockBuilder           builder.BlockBuilder
-	BLSChangesPool         blstoexec.PoolManager
-	ClockWaiter            startup.ClockWaiter
-	CoreService            *core.Service
+	// PayloadDecisionHistoryEpochs is the number of epochs before the finalized epoch for which the
+	// payload decisions of the proposals are kept.
+	PayloadDecisionHistoryEpochs primitives.Epoch
+	BLSChangesPool               blstoexec.PoolManager
+	ClockWaiter                  startup.ClockWaiter
+	CoreService                  *core.Service
+	// payloadDecisionWrites tracks the payload decisions being saved in the background.
+	payloadDecisionWrites sync.WaitGroup
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -6,9 +6,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -100,6 +103,108 @@ func (s *Server) GetActiveSetChanges(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, response)
 }
 
+// GetPayloadDecision retrieves the record of why the local payload or the builder bid was chosen
+// for the proposal at the given slot, and whether the relays delivered the builder payload.
+func (s *Server) GetPayloadDecision(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetPayloadDecision")
+	defer span.End()
+
+	_, slot, ok := shared.UintFromRoute(w, r, "slot")
+	if !ok {
+		return
+	}
+	decision, err := s.BeaconDB.PayloadDecision(ctx, primitives.Slot(slot))
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			httputil.HandleError(w, fmt.Sprintf("No payload decision found for slot %d", slot), http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not get payload decision: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	relays := decision.Relays
+	if relays == nil {
+		relays = []string{}
+	}
+	blockHash := ""
+	if len(decision.BuilderBlockHash) > 0 {
+		blockHash = hexutil.Encode(decision.BuilderBlockHash)
+	}
+	httputil.WriteJson(w, &structs.GetPayloadDecisionResponse{
+		Data: &structs.PayloadDecision{
+			Slot:                 fmt.Sprintf("%d", decision.Slot),
+			ProposerIndex:        fmt.Sprintf("%d", decision.ProposerIndex),
+			Relays:               relays,
+			BuilderBlockHash:     blockHash,
+			BuilderValueGwei:     fmt.Sprintf("%d", decision.BuilderValueGwei),
+			LocalValueGwei:       fmt.Sprintf("%d", decision.LocalValueGwei),
+			BuilderBoostFactor:   fmt.Sprintf("%d", decision.BuilderBoostFactor),
+			LocalBoostPercentage: fmt.Sprintf("%d", decision.LocalBoostPercentage),
+			Source:               string(decision.Source),
+			Reason:               decision.Reason,
+			Delivery:             string(decision.Delivery),
+			DeliveryError:        decision.DeliveryError,
+		},
+	})
+}
+
+// GetValidatorMonitorHistory retrieves the performances of a validator tracked by the validator
+// monitor in each epoch from from_epoch to to_epoch, which default to the whole history.
+func (s *Server) GetValidatorMonitorHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetValidatorMonitorHistory")
+	defer span.End()
+
+	_, index, ok := shared.UintFromRoute(w, r, "index")
+	if !ok {
+		return
+	}
+	_, fromEpoch, ok := shared.UintFromQuery(w, r, "from_epoch", false)
+	if !ok {
+		return
+	}
+	rawToEpoch, toEpoch, ok := shared.UintFromQuery(w, r, "to_epoch", false)
+	if !ok {
+		return
+	}
+	if rawToEpoch == "" {
+		toEpoch = uint64(params.BeaconConfig().FarFutureEpoch)
+	}
+	if fromEpoch > toEpoch {
+		httputil.HandleError(w, fmt.Sprintf("from_epoch %d is after to_epoch %d", fromEpoch, toEpoch), http.StatusBadRequest)
+		return
+	}
+
+	performances, err := s.BeaconDB.ValidatorPerformances(ctx, primitives.ValidatorIndex(index), primitives.Epoch(fromEpoch), primitives.Epoch(toEpoch))
+	if err != nil {
+		httputil.HandleError(w, "Could not get validator performances: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*structs.ValidatorEpochPerformance, len(performances))
+	for i, p := range performances {
+		proposedSlots := make([]string, len(p.ProposedSlots))
+		for j, slot := range p.ProposedSlots {
+			proposedSlots[j] = fmt.Sprintf("%d", slot)
+		}
+		data[i] = &structs.ValidatorEpochPerformance{
+			Epoch:                 fmt.Sprintf("%d", p.Epoch),
+			Attested:              p.Attested,
+			AttestedSlot:          fmt.Sprintf("%d", p.AttestedSlot),
+			InclusionSlot:         fmt.Sprintf("%d", p.InclusionSlot),
+			InclusionDistance:     fmt.Sprintf("%d", p.InclusionDistance()),
+			CorrectSource:         p.CorrectSource,
+			CorrectTarget:         p.CorrectTarget,
+			CorrectHead:           p.CorrectHead,
+			ProposedSlots:         proposedSlots,
+			SyncCommitteeExpected: fmt.Sprintf("%d", p.SyncCommitteeExpected),
+			SyncCommitteeIncluded: fmt.Sprintf("%d", p.SyncCommitteeIncluded),
+			Balance:               fmt.Sprintf("%d", p.Balance),
+			BalanceChange:         fmt.Sprintf("%d", p.BalanceChange),
+		}
+	}
+	httputil.WriteJson(w, &structs.GetValidatorMonitorHistoryResponse{Data: data})
+}
+
 func byteSlice2dToStringSlice(byteArrays [][]byte) []string {
 	s := make([]string, len(byteArrays))
 	for i, b := range byteArrays {
@@ -17,11 +17,13 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	buildertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	monitortypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -556,3 +558,164 @@ func pubKey(i uint64) []byte {
 	binary.LittleEndian.PutUint64(pubKey, i)
 	return pubKey
 }
+
+func TestServer_GetPayloadDecision(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	decision := &buildertypes.PayloadDecision{
+		Slot:               10,
+		ProposerIndex:      3,
+		Relays:             []string{"relay.example"},
+		BuilderBlockHash:   []byte{0xaa},
+		BuilderValueGwei:   200,
+		LocalValueGwei:     100,
+		BuilderBoostFactor: 100,
+	}
+	decision.UseBuilder("builder value is higher")
+	decision.Delivery = buildertypes.DeliveryTimeout
+	decision.DeliveryError = "context deadline exceeded"
+	require.NoError(t, beaconDB.SavePayloadDecision(ctx, decision))
+	s := &Server{BeaconDB: beaconDB}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validator/proposals/10/payload_decision", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "10"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPayloadDecision(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPayloadDecisionResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, &structs.PayloadDecision{
+			Slot:                 "10",
+			ProposerIndex:        "3",
+			Relays:               []string{"relay.example"},
+			BuilderBlockHash:     "0xaa",
+			BuilderValueGwei:     "200",
+			LocalValueGwei:       "100",
+			BuilderBoostFactor:   "100",
+			LocalBoostPercentage: "0",
+			Source:               "builder",
+			Reason:               "builder value is higher",
+			Delivery:             "timeout",
+			DeliveryError:        "context deadline exceeded",
+		}, resp.Data)
+	})
+	t.Run("not found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validator/proposals/11/payload_decision", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "11"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPayloadDecision(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+		require.StringContains(t, "No payload decision found for slot 11", writer.Body.String())
+	})
+	t.Run("invalid slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validator/proposals/foo/payload_decision", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPayloadDecision(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestServer_GetValidatorMonitorHistory(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	performances := make([]*monitortypes.EpochPerformance, 0)
+	for epoch := primitives.Epoch(1); epoch <= 3; epoch++ {
+		performances = append(performances, &monitortypes.EpochPerformance{
+			ValidatorIndex:        5,
+			Epoch:                 epoch,
+			Attested:              true,
+			AttestedSlot:          primitives.Slot(epoch * 32),
+			InclusionSlot:         primitives.Slot(epoch*32 + 2),
+			CorrectSource:         true,
+			CorrectTarget:         true,
+			SyncCommitteeExpected: 32,
+			SyncCommitteeIncluded: 30,
+			Balance:               32000000000,
+			BalanceChange:         -5,
+		})
+	}
+	performances[1].ProposedSlots = []primitives.Slot{70}
+	require.NoError(t, beaconDB.SaveValidatorPerformances(ctx, performances))
+	s := &Server{BeaconDB: beaconDB}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/5?from_epoch=2", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "5"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorMonitorHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, &structs.ValidatorEpochPerformance{
+			Epoch:                 "2",
+			Attested:              true,
+			AttestedSlot:          "64",
+			InclusionSlot:         "66",
+			InclusionDistance:     "2",
+			CorrectSource:         true,
+			CorrectTarget:         true,
+			CorrectHead:           false,
+			ProposedSlots:         []string{"70"},
+			SyncCommitteeExpected: "32",
+			SyncCommitteeIncluded: "30",
+			Balance:               "32000000000",
+			BalanceChange:         "-5",
+		}, resp.Data[0])
+		assert.Equal(t, "3", resp.Data[1].Epoch)
+	})
+	t.Run("epoch range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/5?from_epoch=1&to_epoch=1", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "5"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorMonitorHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].Epoch)
+	})
+	t.Run("untracked validator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/6", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "6"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorMonitorHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("from epoch after to epoch", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/5?from_epoch=3&to_epoch=2", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "5"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.StringContains(t, "from_epoch 3 is after to_epoch 2", writer.Body.String())
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/monitor/foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorMonitorHistory(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
@@ -38,11 +39,13 @@ import (
 	debugv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/node"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	ethpbv1alpha1 "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -123,6 +126,10 @@ type Config struct {
 	Broadcaster                   p2p.Broadcaster
 	PeersFetcher                  p2p.PeersProvider
 	PeerManager                   p2p.PeerManager
+	ConnectionFilterManager       p2p.ConnectionFilterManager
+	BandwidthProvider             p2p.BandwidthProvider
+	CrawledNodesProvider          p2p.CrawledNodesProvider
+	RateLimitReporter             chainSync.RateLimitReporter
 	MetadataProvider              p2p.MetadataProvider
 	DepositFetcher                cache.DepositFetcher
 	PendingDepositFetcher         depositsnapshot.PendingDepositsFetcher
@@ -134,11 +141,16 @@ type Config struct {
 	ExecutionEngineCaller         execution.EngineCaller
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
 	BlockBuilder                  builder.BlockBuilder
+	RelayHealthManager            builder.RelayHealthManager
+	SlasherQuerier                slasher.Querier
+	SlasherAttestationsFeed       *event.Feed
+	SlasherBlockHeadersFeed       *event.Feed
 	Router                        *mux.Router
 	ClockWaiter                   startup.ClockWaiter
 	BlobStorage                   *filesystem.BlobStorage
 	TrackedValidatorsCache        *cache.TrackedValidatorsCache
 	PayloadIDCache                *cache.PayloadIDCache
+	PayloadDecisionHistoryEpochs  primitives.Epoch
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -266,6 +278,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		CoreService:            coreService,
 		TrackedValidatorsCache: s.cfg.TrackedValidatorsCache,
 		PayloadIDCache:         s.cfg.PayloadIDCache,
+
+		PayloadDecisionHistoryEpochs: s.cfg.PayloadDecisionHistoryEpochs,
 	}
 	s.validatorServer = validatorServer
 	nodeServer := &nodev1alpha1.Server{
@@ -2,12 +2,15 @@ package testutil
 
 import (
 	"math/big"
+
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 )
 
 // MockExecutionChainInfoFetcher is a fake implementation of the powchain.ChainInfoFetcher
 type MockExecutionChainInfoFetcher struct {
-	CurrEndpoint string
-	CurrError    error
+	CurrEndpoint  string
+	CurrError     error
+	ClientVersion []*enginev1.ClientVersionV1
 }
 
 func (*MockExecutionChainInfoFetcher) GenesisExecutionChainInfo() (uint64, *big.Int) {
@@ -25,3 +28,7 @@ func (m *MockExecutionChainInfoFetcher) ExecutionClientEndpoint() string {
 func (m *MockExecutionChainInfoFetcher) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
+
+func (m *MockExecutionChainInfoFetcher) ExecutionClientVersion() []*enginev1.ClientVersionV1 {
+	return m.ClientVersion
+}
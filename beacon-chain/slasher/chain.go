@@ -0,0 +1,48 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// RemoteChainFetcher retrieves the head of the chain from the beacon node feeding
+// a slasher which runs in its own process.
+type RemoteChainFetcher interface {
+	HeadSlot(ctx context.Context) (primitives.Slot, error)
+	NumValidators(ctx context.Context) (int, error)
+}
+
+// Returns whether the slasher runs apart from the beacon node, without access to its states.
+func (s *Service) isRemote() bool {
+	return s.serviceCfg.RemoteChainFetcher != nil
+}
+
+func (s *Service) headSlot(ctx context.Context) (primitives.Slot, error) {
+	if s.isRemote() {
+		return s.serviceCfg.RemoteChainFetcher.HeadSlot(ctx)
+	}
+	return s.serviceCfg.HeadStateFetcher.HeadSlot(), nil
+}
+
+func (s *Service) numValidators(ctx context.Context) (int, error) {
+	if s.isRemote() {
+		return s.serviceCfg.RemoteChainFetcher.NumValidators(ctx)
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get head state")
+	}
+	return headState.NumValidators(), nil
+}
+
+// Returns the head state the slashings are inserted into the operations pool with,
+// which is nil for a remote slasher whose slashings are submitted to the beacon node.
+func (s *Service) headState(ctx context.Context) (state.ReadOnlyBeaconState, error) {
+	if s.isRemote() {
+		return nil, nil
+	}
+	return s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+}
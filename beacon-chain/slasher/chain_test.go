@@ -0,0 +1,55 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockRemoteChainFetcher struct {
+	slot          primitives.Slot
+	numValidators int
+}
+
+func (m *mockRemoteChainFetcher) HeadSlot(_ context.Context) (primitives.Slot, error) {
+	return m.slot, nil
+}
+
+func (m *mockRemoteChainFetcher) NumValidators(_ context.Context) (int, error) {
+	return m.numValidators, nil
+}
+
+func TestService_RemoteChain(t *testing.T) {
+	ctx := context.Background()
+	poolInserter := &slashingsmock.PoolMock{}
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:             dbtest.SetupSlasherDB(t),
+			SlashingPoolInserter: poolInserter,
+			RemoteChainFetcher:   &mockRemoteChainFetcher{slot: 64, numValidators: 10},
+		},
+	}
+
+	slot, err := s.headSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(64), slot)
+	numValidators, err := s.numValidators(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 10, numValidators)
+
+	// Slashings are submitted without signature verification, which is left to the beacon node.
+	slashing := &ethpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{BodyRoot: bytesutil.PadTo([]byte("other"), 32)}}),
+	}
+	require.NoError(t, s.processProposerSlashings(ctx, []*ethpb.ProposerSlashing{slashing}))
+	require.Equal(t, 1, len(poolInserter.PendingPropSlashings))
+	require.DeepSSZEqual(t, slashing, poolInserter.PendingPropSlashings[0])
+}
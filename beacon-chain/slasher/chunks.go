@@ -27,6 +27,12 @@ type Chunker interface {
 		validatorIdx primitives.ValidatorIndex,
 		attestation *slashertypes.IndexedAttestationWrapper,
 	) (ethpb.AttSlashing, error)
+	ConflictingAttestation(
+		ctx context.Context,
+		slasherDB db.SlasherDatabase,
+		validatorIdx primitives.ValidatorIndex,
+		attestation *slashertypes.IndexedAttestationWrapper,
+	) (*slashertypes.IndexedAttestationWrapper, error)
 	Update(
 		chunkIndex uint64,
 		currentEpoch primitives.Epoch,
@@ -169,23 +175,14 @@ func (m *MaxSpanChunksSlice) Chunk() []uint16 {
 	return m.data
 }
 
-// CheckSlashable takes in a validator index and an incoming attestation
-// and checks if the validator is slashable depending on the data
-// within the min span chunks slice. Recall that for an incoming attestation, B, and an
-// existing attestation, A:
-//
-//	B surrounds A if and only if B.target > min_spans[B.source]
-//
-// That is, this condition is sufficient to check if an incoming attestation
-// is surrounding a previous one. We also check if we indeed have an existing
-// attestation record in the database if the condition holds true in order
-// to be confident of a slashable offense.
-func (m *MinSpanChunksSlice) CheckSlashable(
+// ConflictingAttestation returns the attestation of the validator in the database surrounded by the given attestation,
+// according to the data within the min span chunks slice, if any. Unlike CheckSlashable, it has no side effect.
+func (m *MinSpanChunksSlice) ConflictingAttestation(
 	ctx context.Context,
 	slasherDB db.SlasherDatabase,
 	validatorIdx primitives.ValidatorIndex,
 	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
-) (ethpb.AttSlashing, error) {
+) (*slashertypes.IndexedAttestationWrapper, error) {
 	sourceEpoch := incomingAttWrapper.IndexedAttestation.GetData().Source.Epoch
 	targetEpoch := incomingAttWrapper.IndexedAttestation.GetData().Target.Epoch
 
@@ -230,6 +227,34 @@ func (m *MinSpanChunksSlice) CheckSlashable(
 		return nil, nil
 	}
 
+	return existingAttWrapper, nil
+}
+
+// CheckSlashable takes in a validator index and an incoming attestation
+// and checks if the validator is slashable depending on the data
+// within the min span chunks slice. Recall that for an incoming attestation, B, and an
+// existing attestation, A:
+//
+//	B surrounds A if and only if B.target > min_spans[B.source]
+//
+// That is, this condition is sufficient to check if an incoming attestation
+// is surrounding a previous one. We also check if we indeed have an existing
+// attestation record in the database if the condition holds true in order
+// to be confident of a slashable offense.
+func (m *MinSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (ethpb.AttSlashing, error) {
+	existingAttWrapper, err := m.ConflictingAttestation(ctx, slasherDB, validatorIdx, incomingAttWrapper)
+	if err != nil {
+		return nil, err
+	}
+	if existingAttWrapper == nil {
+		return nil, nil
+	}
+
 	surroundingVotesTotal.Inc()
 
 	existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
@@ -265,23 +290,14 @@ func (m *MinSpanChunksSlice) CheckSlashable(
 	return slashing, nil
 }
 
-// CheckSlashable takes in a validator index and an incoming attestation
-// and checks if the validator is slashable depending on the data
-// within the max span chunks slice. Recall that for an incoming attestation, B, and an
-// existing attestation, A:
-//
-//	B is surrounded by A if and only if B.target < max_spans[B.source]
-//
-// That is, this condition is sufficient to check if an incoming attestation
-// is surrounded by a previous one. We also check if we indeed have an existing
-// attestation record in the database if the condition holds true in order
-// to be confident of a slashable offense.
-func (m *MaxSpanChunksSlice) CheckSlashable(
+// ConflictingAttestation returns the attestation of the validator in the database surrounding the given attestation,
+// according to the data within the max span chunks slice, if any. Unlike CheckSlashable, it has no side effect.
+func (m *MaxSpanChunksSlice) ConflictingAttestation(
 	ctx context.Context,
 	slasherDB db.SlasherDatabase,
 	validatorIdx primitives.ValidatorIndex,
 	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
-) (ethpb.AttSlashing, error) {
+) (*slashertypes.IndexedAttestationWrapper, error) {
 	sourceEpoch := incomingAttWrapper.IndexedAttestation.GetData().Source.Epoch
 	targetEpoch := incomingAttWrapper.IndexedAttestation.GetData().Target.Epoch
 
@@ -326,6 +342,34 @@ func (m *MaxSpanChunksSlice) CheckSlashable(
 		return nil, nil
 	}
 
+	return existingAttWrapper, nil
+}
+
+// CheckSlashable takes in a validator index and an incoming attestation
+// and checks if the validator is slashable depending on the data
+// within the max span chunks slice. Recall that for an incoming attestation, B, and an
+// existing attestation, A:
+//
+//	B is surrounded by A if and only if B.target < max_spans[B.source]
+//
+// That is, this condition is sufficient to check if an incoming attestation
+// is surrounded by a previous one. We also check if we indeed have an existing
+// attestation record in the database if the condition holds true in order
+// to be confident of a slashable offense.
+func (m *MaxSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx primitives.ValidatorIndex,
+	incomingAttWrapper *slashertypes.IndexedAttestationWrapper,
+) (ethpb.AttSlashing, error) {
+	existingAttWrapper, err := m.ConflictingAttestation(ctx, slasherDB, validatorIdx, incomingAttWrapper)
+	if err != nil {
+		return nil, err
+	}
+	if existingAttWrapper == nil {
+		return nil, nil
+	}
+
 	surroundedVotesTotal.Inc()
 
 	existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
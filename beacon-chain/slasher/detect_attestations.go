@@ -132,10 +132,15 @@ func (s *Service) checkSurroundVotes(
 		}
 
 		// Update the latest updated epoch for all validators involved to the current chunk.
+		// The latest updated epoch never decreases, since the spans are already written up to it.
 		indexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
+		s.latestEpochUpdatedLock.Lock()
 		for _, index := range indexes {
-			s.latestEpochUpdatedForValidator[index] = currentEpoch
+			if latest, ok := s.latestEpochUpdatedForValidator[index]; !ok || currentEpoch > latest {
+				s.latestEpochUpdatedForValidator[index] = currentEpoch
+			}
 		}
+		s.latestEpochUpdatedLock.Unlock()
 	}
 
 	// Save the updated chunks to disk.
@@ -194,15 +194,24 @@ func GetChunkFromDatabase(
 		},
 	}
 
+	return s.chunkForValidator(ctx, filters.ChunkKind, filters.ValidatorIndex, filters.SourceEpoch)
+}
+
+// Retrieves the chunk of a kind containing the spans of a validator at an epoch, as well as
+// the last epoch written for the validator. ErrSpansNotFound is returned if the epoch is not
+// within the slasher history of the validator.
+func (s *Service) chunkForValidator(
+	ctx context.Context,
+	chunkKind slashertypes.ChunkKind,
+	validatorIndex primitives.ValidatorIndex,
+	sourceEpoch primitives.Epoch,
+) (lastEpochForValidatorIndex primitives.Epoch, chunkIndex, validatorChunkIndex uint64, chunk Chunker, err error) {
 	// variables
-	validatorIndex := filters.ValidatorIndex
-	sourceEpoch := filters.SourceEpoch
-	chunkKind := filters.ChunkKind
 	validatorChunkIndex = s.params.validatorChunkIndex(validatorIndex)
 	chunkIndex = s.params.chunkIndex(sourceEpoch)
 
 	// before getting the chunk, we need to verify if the requested epoch is in database
-	lastEpochForValidator, err := s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{validatorIndex})
+	lastEpochForValidatorIndex, ok, err := s.lastEpochWrittenForValidator(ctx, validatorIndex)
 	if err != nil {
 		return lastEpochForValidatorIndex,
 			chunkIndex,
@@ -211,26 +220,25 @@ func GetChunkFromDatabase(
 			fmt.Errorf("could not get last epoch written for validator %d: %w", validatorIndex, err)
 	}
 
-	if len(lastEpochForValidator) == 0 {
+	if !ok {
 		return lastEpochForValidatorIndex,
 			chunkIndex,
 			validatorChunkIndex,
 			chunk,
-			fmt.Errorf("could not get information at epoch %d for validator %d: there's no record found in slasher database",
-				sourceEpoch, validatorIndex,
+			fmt.Errorf("could not get information at epoch %d for validator %d: %w",
+				sourceEpoch, validatorIndex, ErrSpansNotFound,
 			)
 	}
-	lastEpochForValidatorIndex = lastEpochForValidator[0].Epoch
 
 	// if the epoch requested is within the range, we can proceed to get the chunk, otherwise return error
-	atBestSmallestEpoch := lastEpochForValidatorIndex.Sub(uint64(params.historyLength))
+	atBestSmallestEpoch := s.params.historyStartEpoch(lastEpochForValidatorIndex)
 	if sourceEpoch < atBestSmallestEpoch || sourceEpoch > lastEpochForValidatorIndex {
 		return lastEpochForValidatorIndex,
 			chunkIndex,
 			validatorChunkIndex,
 			chunk,
-			fmt.Errorf("requested epoch %d is outside the slasher history length %d, data can be provided within the epoch range [%d:%d] for validator %d",
-				sourceEpoch, params.historyLength, atBestSmallestEpoch, lastEpochForValidatorIndex, validatorIndex,
+			fmt.Errorf("requested epoch %d is outside the slasher history length %d, data can be provided within the epoch range [%d:%d] for validator %d: %w",
+				sourceEpoch, s.params.historyLength, atBestSmallestEpoch, lastEpochForValidatorIndex, validatorIndex, ErrSpansNotFound,
 			)
 	}
 
@@ -247,6 +255,25 @@ func GetChunkFromDatabase(
 	return lastEpochForValidatorIndex, chunkIndex, validatorChunkIndex, chunk, nil
 }
 
+// Returns the last epoch written for a validator, if any. The running service keeps
+// these epochs in memory and only flushes them to the database when stopping.
+func (s *Service) lastEpochWrittenForValidator(
+	ctx context.Context, validatorIndex primitives.ValidatorIndex,
+) (primitives.Epoch, bool, error) {
+	if s.latestEpochUpdatedForValidator != nil {
+		s.latestEpochUpdatedLock.RLock()
+		defer s.latestEpochUpdatedLock.RUnlock()
+		epoch, ok := s.latestEpochUpdatedForValidator[validatorIndex]
+		return epoch, ok, nil
+	}
+
+	epochs, err := s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, []primitives.ValidatorIndex{validatorIndex})
+	if err != nil || len(epochs) == 0 {
+		return 0, false, err
+	}
+	return epochs[0].Epoch, true, nil
+}
+
 func closeDB(d *slasherkv.Store) {
 	if err := d.Close(); err != nil {
 		log.WithError(err).Error("could not close database")
@@ -101,6 +101,15 @@ func (p *Parameters) lastEpoch(chunkIndex uint64) primitives.Epoch {
 	return p.firstEpoch(chunkIndex).Add(p.chunkSize - 1)
 }
 
+// Returns the lowest epoch within the history ending at the specified last epoch.
+// Older epochs of a chunk have been overwritten by more recent ones.
+func (p *Parameters) historyStartEpoch(lastEpoch primitives.Epoch) primitives.Epoch {
+	if lastEpoch < p.historyLength {
+		return 0
+	}
+	return lastEpoch - p.historyLength
+}
+
 // Given a validator index, and epoch, we compute the exact index
 // into our flat slice on disk which stores K validators' chunks, each
 // chunk of size C. For example, if C = 3 and K = 3, the data we store
@@ -2,9 +2,11 @@ package slasher
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -23,7 +25,7 @@ func (s *Service) processAttesterSlashings(
 	}
 
 	// Get the head state.
-	beaconState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	beaconState, err := s.headState(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get head state")
 	}
@@ -56,6 +58,12 @@ func (s *Service) processAttesterSlashings(
 		processedSlashings[root] = slashing
 	}
 
+	detected := make([]*slashertypes.DetectedSlashing, 0, len(processedSlashings))
+	for _, slashing := range processedSlashings {
+		detected = append(detected, &slashertypes.DetectedSlashing{DetectedAt: time.Now(), AttesterSlashing: slashing})
+	}
+	s.saveDetectedSlashings(ctx, detected)
+
 	return processedSlashings, nil
 }
 
@@ -68,11 +76,12 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 	}
 
 	// Get the head state.
-	beaconState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	beaconState, err := s.headState(ctx)
 	if err != nil {
 		return err
 	}
 
+	detected := make([]*slashertypes.DetectedSlashing, 0, len(slashings))
 	for _, slashing := range slashings {
 		// Verify the signature of the first block.
 		if err := s.verifyBlockSignature(ctx, slashing.Header_1); err != nil {
@@ -97,12 +106,30 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
 			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
 		}
+
+		detected = append(detected, &slashertypes.DetectedSlashing{DetectedAt: time.Now(), ProposerSlashing: slashing})
 	}
 
+	s.saveDetectedSlashings(ctx, detected)
 	return nil
 }
 
+// Records the detected slashings in the database, to be queried through the API.
+// Failing to record them does not prevent them from being included in blocks.
+func (s *Service) saveDetectedSlashings(ctx context.Context, slashings []*slashertypes.DetectedSlashing) {
+	if len(slashings) == 0 {
+		return
+	}
+	if err := s.serviceCfg.Database.SaveDetectedSlashings(ctx, slashings); err != nil {
+		log.WithError(err).Error("Could not save detected slashings")
+	}
+}
+
 func (s *Service) verifyBlockSignature(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) error {
+	if s.isRemote() {
+		// The beacon node verifies the slashing when it is submitted.
+		return nil
+	}
 	parentState, err := s.serviceCfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(header.Header.ParentRoot))
 	if err != nil {
 		return err
@@ -111,7 +138,11 @@ func (s *Service) verifyBlockSignature(ctx context.Context, header *ethpb.Signed
 }
 
 func (s *Service) verifyAttSignature(ctx context.Context, att ethpb.IndexedAtt) error {
-	preState, err := s.serviceCfg.AttestationStateFetcher.AttestationTargetState(ctx, att.GetData().Target)
+	if s.isRemote() {
+		// The beacon node verifies the slashing when it is submitted.
+		return nil
+	}
+	preState, err := s.attestationTargetState(ctx, att.GetData().Target)
 	if err != nil {
 		return err
 	}
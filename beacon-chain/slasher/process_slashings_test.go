@@ -139,6 +139,11 @@ func TestService_processAttesterSlashings(t *testing.T) {
 		_, err = s.processAttesterSlashings(ctx, slashings)
 		require.NoError(tt, err)
 		require.LogsDoNotContain(tt, hook, "Invalid signature")
+
+		detected, _, err := s.DetectedSlashings(ctx, nil, 100)
+		require.NoError(tt, err)
+		require.Equal(tt, 1, len(detected))
+		require.DeepSSZEqual(tt, slashing, detected[0].AttesterSlashing)
 	})
 }
 
@@ -265,5 +270,10 @@ func TestService_processProposerSlashings(t *testing.T) {
 		err = s.processProposerSlashings(ctx, slashings)
 		require.NoError(tt, err)
 		require.LogsDoNotContain(tt, hook, "Invalid signature")
+
+		detected, _, err := s.DetectedSlashings(ctx, nil, 100)
+		require.NoError(tt, err)
+		require.Equal(tt, 1, len(detected))
+		require.DeepSSZEqual(tt, slashings[0], detected[0].ProposerSlashing)
 	})
 }
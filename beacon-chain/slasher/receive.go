@@ -93,11 +93,15 @@ func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-ch
 	for {
 		select {
 		case currentSlot := <-slotTicker:
+			s.attsProcessingLock.Lock()
+
 			// Retrieve all attestations from the queue.
 			attestations := s.attsQueue.dequeue()
 
 			// Process the retrieved attestations.
 			s.processAttestations(ctx, attestations, currentSlot)
+
+			s.attsProcessingLock.Unlock()
 		case <-ctx.Done():
 			return
 		}
@@ -174,39 +178,50 @@ func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan pri
 	for {
 		select {
 		case currentSlot := <-slotTicker:
+			s.blksProcessingLock.Lock()
 			blocks := s.blksQueue.dequeue()
-			currentEpoch := slots.ToEpoch(currentSlot)
-
-			receivedBlocksTotal.Add(float64(len(blocks)))
+			s.processBlocks(ctx, blocks, currentSlot)
+			s.blksProcessingLock.Unlock()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
 
-			log.WithFields(logrus.Fields{
-				"currentSlot":  currentSlot,
-				"currentEpoch": currentEpoch,
-				"numBlocks":    len(blocks),
-			}).Info("Processing queued blocks for slashing detection")
+func (s *Service) processBlocks(
+	ctx context.Context,
+	blocks []*slashertypes.SignedBlockHeaderWrapper,
+	currentSlot primitives.Slot,
+) []*ethpb.ProposerSlashing {
+	currentEpoch := slots.ToEpoch(currentSlot)
 
-			start := time.Now()
-			// Check for slashings.
-			slashings, err := s.detectProposerSlashings(ctx, blocks)
-			if err != nil {
-				log.WithError(err).Error("Could not detect proposer slashings")
-				continue
-			}
+	receivedBlocksTotal.Add(float64(len(blocks)))
 
-			// Process proposer slashings by verifying their signatures, submitting
-			// to the beacon node's operations pool, and logging them.
-			if err := s.processProposerSlashings(ctx, slashings); err != nil {
-				log.WithError(err).Error("Could not process proposer slashings")
-				continue
-			}
+	log.WithFields(logrus.Fields{
+		"currentSlot":  currentSlot,
+		"currentEpoch": currentEpoch,
+		"numBlocks":    len(blocks),
+	}).Info("Processing queued blocks for slashing detection")
 
-			log.WithField("elapsed", time.Since(start)).Debug("Done checking slashable blocks")
+	start := time.Now()
+	// Check for slashings.
+	slashings, err := s.detectProposerSlashings(ctx, blocks)
+	if err != nil {
+		log.WithError(err).Error("Could not detect proposer slashings")
+		return nil
+	}
 
-			processedBlocksTotal.Add(float64(len(blocks)))
-		case <-ctx.Done():
-			return
-		}
+	// Process proposer slashings by verifying their signatures, submitting
+	// to the beacon node's operations pool, and logging them.
+	if err := s.processProposerSlashings(ctx, slashings); err != nil {
+		log.WithError(err).Error("Could not process proposer slashings")
+		return nil
 	}
+
+	log.WithField("elapsed", time.Since(start)).Debug("Done checking slashable blocks")
+
+	processedBlocksTotal.Add(float64(len(blocks)))
+	return slashings
 }
 
 // Prunes slasher data on each slot tick to prevent unnecessary build-up of disk space usage.
@@ -216,8 +231,12 @@ func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan primit
 	for {
 		select {
 		case <-slotTicker:
-			headEpoch := slots.ToEpoch(s.serviceCfg.HeadStateFetcher.HeadSlot())
-			if err := s.pruneSlasherDataWithinSlidingWindow(ctx, headEpoch); err != nil {
+			headSlot, err := s.headSlot(ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not get head slot")
+				continue
+			}
+			if err := s.pruneSlasherDataWithinSlidingWindow(ctx, slots.ToEpoch(headSlot)); err != nil {
 				log.WithError(err).Error("Could not prune slasher data")
 				continue
 			}
@@ -257,6 +276,12 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if err != nil {
 		return errors.Wrap(err, "Could not prune proposals")
 	}
+	numPrunedSlashings, err := s.serviceCfg.Database.PruneDetectedSlashingsAtEpoch(
+		ctx, maxPruningEpoch,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Could not prune detected slashings")
+	}
 	fields := logrus.Fields{}
 	if numPrunedAtts > 0 {
 		fields["numPrunedAtts"] = numPrunedAtts
@@ -264,6 +289,9 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if numPrunedProposals > 0 {
 		fields["numPrunedProposals"] = numPrunedProposals
 	}
+	if numPrunedSlashings > 0 {
+		fields["numPrunedSlashings"] = numPrunedSlashings
+	}
 	fields["elapsed"] = time.Since(start)
 	log.WithFields(fields).Info("Done pruning old attestations and proposals for slasher")
 	return nil
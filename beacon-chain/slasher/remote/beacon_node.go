@@ -0,0 +1,167 @@
+// Package remote allows running the slasher in its own process, fed by a beacon node with
+// the attestations and block headers received through its event stream. The detected
+// slashings are submitted to the operations pool of that beacon node.
+package remote
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+const (
+	// Interval between two attempts to reach the beacon node.
+	retryInterval = 5 * time.Second
+	// Timeout of the requests made outside of a context.
+	requestTimeout = 10 * time.Second
+)
+
+var (
+	_ = startup.ClockWaiter(&BeaconNode{})
+	_ = sync.Checker(&BeaconNode{})
+	_ = slasher.RemoteChainFetcher(&BeaconNode{})
+	_ = slashings.PoolInserter(&BeaconNode{})
+)
+
+// validatorStatuses are the statuses covering all the validators of the registry.
+var validatorStatuses = []string{"pending", "active", "exited", "withdrawal"}
+
+// BeaconNode is the beacon node a standalone slasher reads the chain from and
+// submits the detected slashings to.
+type BeaconNode struct {
+	client *beacon.Client
+}
+
+// NewBeaconNode returns the beacon node reached through the given API client.
+func NewBeaconNode(client *beacon.Client) *BeaconNode {
+	return &BeaconNode{client: client}
+}
+
+// WaitForClock waits for the beacon node to know the genesis of the chain.
+func (b *BeaconNode) WaitForClock(ctx context.Context) (*startup.Clock, error) {
+	for {
+		genesis, err := b.client.GetGenesis(ctx)
+		if err == nil {
+			return clockFromGenesis(genesis)
+		}
+		log.WithError(err).Warn("Could not get genesis from beacon node, retrying")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// Initialized returns whether the beacon node knows the genesis of the chain.
+func (b *BeaconNode) Initialized() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := b.client.GetGenesis(ctx)
+	return err == nil
+}
+
+// Syncing returns whether the beacon node is syncing. A beacon node which
+// can not be reached is considered syncing.
+func (b *BeaconNode) Syncing() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	status, err := b.client.GetSyncStatus(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not get sync status from beacon node")
+		return true
+	}
+	return status.IsSyncing
+}
+
+// Synced returns whether the beacon node is synced.
+func (b *BeaconNode) Synced() bool {
+	return !b.Syncing()
+}
+
+// Status returns an error if the sync status of the beacon node can not be retrieved.
+func (b *BeaconNode) Status() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := b.client.GetSyncStatus(ctx)
+	return err
+}
+
+// Resync is not supported for a remote beacon node.
+func (*BeaconNode) Resync() error {
+	return errors.New("can not resync a remote beacon node")
+}
+
+// HeadSlot returns the slot of the head of the beacon node.
+func (b *BeaconNode) HeadSlot(ctx context.Context) (primitives.Slot, error) {
+	status, err := b.client.GetSyncStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+	slot, err := strconv.ParseUint(status.HeadSlot, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not parse head slot %s", status.HeadSlot)
+	}
+	return primitives.Slot(slot), nil
+}
+
+// NumValidators returns the number of validators in the head state of the beacon node.
+func (b *BeaconNode) NumValidators(ctx context.Context) (int, error) {
+	resp, err := b.client.GetValidatorCount(ctx, beacon.IdHead, validatorStatuses...)
+	if err != nil {
+		return 0, err
+	}
+	numValidators := 0
+	for _, count := range resp.Data {
+		c, err := strconv.Atoi(count.Count)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not parse %s validator count %s", count.Status, count.Count)
+		}
+		numValidators += c
+	}
+	return numValidators, nil
+}
+
+// InsertAttesterSlashing submits an attester slashing to the beacon node, which verifies it.
+// The state is not used.
+func (b *BeaconNode) InsertAttesterSlashing(ctx context.Context, _ state.ReadOnlyBeaconState, slashing ethpb.AttSlashing) error {
+	switch s := slashing.(type) {
+	case *ethpb.AttesterSlashing:
+		return b.client.SubmitAttesterSlashing(ctx, structs.AttesterSlashingFromConsensus(s))
+	case *ethpb.AttesterSlashingElectra:
+		return b.client.SubmitAttesterSlashingElectra(ctx, structs.AttesterSlashingElectraFromConsensus(s))
+	default:
+		return errors.Errorf("attester slashing of type %T can not be submitted to the beacon node", slashing)
+	}
+}
+
+// InsertProposerSlashing submits a proposer slashing to the beacon node, which verifies it.
+// The state is not used.
+func (b *BeaconNode) InsertProposerSlashing(ctx context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.ProposerSlashing) error {
+	return b.client.SubmitProposerSlashing(ctx, structs.ProposerSlashingFromConsensus(slashing))
+}
+
+func clockFromGenesis(genesis *structs.Genesis) (*startup.Clock, error) {
+	genesisTime, err := strconv.ParseInt(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse genesis time %s", genesis.GenesisTime)
+	}
+	root, err := hexutil.Decode(genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode genesis validators root %s", genesis.GenesisValidatorsRoot)
+	}
+	return startup.NewClock(time.Unix(genesisTime, 0), bytesutil.ToBytes32(root)), nil
+}
@@ -0,0 +1,136 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testBeaconNode(t *testing.T, handler http.HandlerFunc) *BeaconNode {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	client, err := beacon.NewClient(srv.URL)
+	require.NoError(t, err)
+	return NewBeaconNode(client)
+}
+
+func TestBeaconNode_WaitForClock(t *testing.T) {
+	bn := testBeaconNode(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/beacon/genesis", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(&structs.GetGenesisResponse{Data: &structs.Genesis{
+			GenesisTime:           "1606824023",
+			GenesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+		}}))
+	})
+	clock, err := bn.WaitForClock(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1606824023, 0), clock.GenesisTime())
+	root := clock.GenesisValidatorsRoot()
+	assert.Equal(t, "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95", hexutil.Encode(root[:]))
+}
+
+func TestBeaconNode_SyncStatus(t *testing.T) {
+	syncing := true
+	bn := testBeaconNode(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/node/syncing", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(&structs.SyncStatusResponse{Data: &structs.SyncStatusResponseData{
+			HeadSlot:  "1234",
+			IsSyncing: syncing,
+		}}))
+	})
+	assert.Equal(t, true, bn.Syncing())
+	syncing = false
+	assert.Equal(t, false, bn.Syncing())
+	assert.Equal(t, true, bn.Synced())
+	slot, err := bn.HeadSlot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(1234), slot)
+
+	unreachable := testBeaconNode(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	assert.Equal(t, true, unreachable.Syncing())
+	assert.NotNil(t, unreachable.Status())
+}
+
+func TestBeaconNode_NumValidators(t *testing.T) {
+	bn := testBeaconNode(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/prysm/v1/beacon/states/head/validator_count", r.URL.Path)
+		require.DeepEqual(t, validatorStatuses, r.URL.Query()["status"])
+		require.NoError(t, json.NewEncoder(w).Encode(&structs.GetValidatorCountResponse{Data: []*structs.ValidatorCount{
+			{Status: "pending", Count: "10"},
+			{Status: "active", Count: "1000"},
+			{Status: "exited", Count: "5"},
+			{Status: "withdrawal", Count: "2"},
+		}}))
+	})
+	numValidators, err := bn.NumValidators(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1017, numValidators)
+}
+
+func TestBeaconNode_InsertSlashings(t *testing.T) {
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{BodyRoot: make([]byte, 32)}}),
+	}
+	attesterSlashingElectra := &ethpb.AttesterSlashingElectra{
+		Attestation_1: &ethpb.IndexedAttestationElectra{
+			AttestingIndices: []uint64{1},
+			Data:             util.HydrateAttestationData(&ethpb.AttestationData{}),
+			Signature:        make([]byte, 96),
+		},
+		Attestation_2: &ethpb.IndexedAttestationElectra{
+			AttestingIndices: []uint64{1},
+			Data:             util.HydrateAttestationData(&ethpb.AttestationData{Slot: 1}),
+			Signature:        make([]byte, 96),
+		},
+	}
+	var gotAttester *structs.AttesterSlashing
+	var gotAttesterElectra *structs.AttesterSlashingElectra
+	var gotProposer *structs.ProposerSlashing
+	bn := testBeaconNode(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		switch r.URL.Path {
+		case "/eth/v1/beacon/pool/attester_slashings":
+			gotAttester = &structs.AttesterSlashing{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(gotAttester))
+		case "/eth/v2/beacon/pool/attester_slashings":
+			require.Equal(t, "electra", r.Header.Get(api.VersionHeader))
+			gotAttesterElectra = &structs.AttesterSlashingElectra{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(gotAttesterElectra))
+		case "/eth/v1/beacon/pool/proposer_slashings":
+			gotProposer = &structs.ProposerSlashing{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(gotProposer))
+			// The beacon node rejects slashings failing its verification.
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	ctx := context.Background()
+
+	require.NoError(t, bn.InsertAttesterSlashing(ctx, nil, attesterSlashing))
+	assert.DeepEqual(t, structs.AttesterSlashingFromConsensus(attesterSlashing), gotAttester)
+
+	require.ErrorContains(t, "code=400", bn.InsertProposerSlashing(ctx, nil, proposerSlashing))
+	assert.DeepEqual(t, structs.ProposerSlashingFromConsensus(proposerSlashing), gotProposer)
+
+	require.NoError(t, bn.InsertAttesterSlashing(ctx, nil, attesterSlashingElectra))
+	assert.DeepEqual(t, structs.AttesterSlashingElectraFromConsensus(attesterSlashingElectra), gotAttesterElectra)
+}
@@ -0,0 +1,151 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	eventClient "github.com/prysmaticlabs/prysm/v5/api/client/event"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// The standard attestation topic carries attestations without their attesting indices, which
+// can only be computed from the committees of the beacon state, and the standard block topic only
+// reports imported blocks, which excludes the second block of a double proposal. The slasher is
+// instead fed by the indexed_attestation and block_header topics, which the beacon node streams
+// with the --slasher-event-stream flag.
+var topics = []string{eventClient.EventBlockHeader, eventClient.EventIndexedAttestation}
+
+const eventsBuffer = 1000
+
+// EventFeeder feeds the slasher with the indexed attestations and block headers received
+// by a beacon node, which are streamed by its event stream.
+type EventFeeder struct {
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	stream                  eventClient.EventStreamClient
+	indexedAttestationsFeed *event.Feed
+	beaconBlockHeadersFeed  *event.Feed
+}
+
+// NewEventFeeder returns a feeder sending the indexed attestations and block headers streamed by
+// the beacon node reached through the given API client to the slasher feeds.
+func NewEventFeeder(
+	ctx context.Context, client *beacon.Client, indexedAttestationsFeed, beaconBlockHeadersFeed *event.Feed,
+) (*EventFeeder, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	// The event stream is long-lived, so its HTTP client has no timeout.
+	stream, err := eventClient.NewEventStream(ctx, &http.Client{}, client.NodeURL(), topics)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create event stream")
+	}
+	return &EventFeeder{
+		ctx:                     ctx,
+		cancel:                  cancel,
+		stream:                  stream,
+		indexedAttestationsFeed: indexedAttestationsFeed,
+		beaconBlockHeadersFeed:  beaconBlockHeadersFeed,
+	}, nil
+}
+
+// Start streaming the events of the beacon node.
+func (f *EventFeeder) Start() {
+	go f.run()
+}
+
+// Stop streaming the events of the beacon node.
+func (f *EventFeeder) Stop() error {
+	f.cancel()
+	return nil
+}
+
+// Status of the event feeder.
+func (*EventFeeder) Status() error {
+	return nil
+}
+
+func (f *EventFeeder) run() {
+	eventsChan := make(chan *eventClient.Event, eventsBuffer)
+	go f.subscribe(eventsChan)
+	for {
+		select {
+		case e, ok := <-eventsChan:
+			if !ok {
+				return
+			}
+			if err := f.handleEvent(e); err != nil {
+				log.WithError(err).WithField("eventType", e.EventType).Error("Could not handle beacon node event")
+			}
+		case <-f.ctx.Done():
+			return
+		}
+	}
+}
+
+// Subscribes to the event stream of the beacon node, subscribing again when the stream is interrupted.
+func (f *EventFeeder) subscribe(eventsChan chan<- *eventClient.Event) {
+	for {
+		f.stream.Subscribe(eventsChan)
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (f *EventFeeder) handleEvent(e *eventClient.Event) error {
+	switch e.EventType {
+	case eventClient.EventBlockHeader:
+		header := &structs.SignedBeaconBlockHeader{}
+		if err := json.Unmarshal(e.Data, header); err != nil {
+			return errors.Wrap(err, "could not decode block header event")
+		}
+		consensusHeader, err := header.ToConsensus()
+		if err != nil {
+			return errors.Wrap(err, "could not convert block header event")
+		}
+		f.beaconBlockHeadersFeed.Send(consensusHeader)
+	case eventClient.EventIndexedAttestation:
+		att, err := indexedAttestationFromEvent(e.Data)
+		if err != nil {
+			return err
+		}
+		f.indexedAttestationsFeed.Send(&slashertypes.WrappedIndexedAtt{IndexedAtt: att})
+	case eventClient.EventConnectionError, eventClient.EventError:
+		log.WithField("error", string(e.Data)).Warn("Beacon node event stream interrupted, subscribing again")
+	}
+	return nil
+}
+
+// Decodes a streamed indexed attestation. Indexed attestations have the same JSON representation
+// before and after Electra, so the fork is given by the epoch of the attestation.
+func indexedAttestationFromEvent(data []byte) (ethpb.IndexedAtt, error) {
+	att := &structs.IndexedAttestation{}
+	if err := json.Unmarshal(data, att); err != nil {
+		return nil, errors.Wrap(err, "could not decode indexed attestation event")
+	}
+	if att.Data == nil {
+		return nil, errors.New("indexed attestation event has no data")
+	}
+	slot, err := strconv.ParseUint(att.Data.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse attestation slot %s", att.Data.Slot)
+	}
+	if slots.ToEpoch(primitives.Slot(slot)) >= params.BeaconConfig().ElectraForkEpoch {
+		electraAtt := structs.IndexedAttestationElectra(*att)
+		return electraAtt.ToConsensus()
+	}
+	return att.ToConsensus()
+}
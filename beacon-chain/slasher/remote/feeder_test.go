@@ -0,0 +1,78 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	eventClient "github.com/prysmaticlabs/prysm/v5/api/client/event"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestEventFeeder_HandleEvent(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	client, err := beacon.NewClient(srv.URL)
+	require.NoError(t, err)
+
+	indexedAttsFeed := new(event.Feed)
+	headersFeed := new(event.Feed)
+	feeder, err := NewEventFeeder(context.Background(), client, indexedAttsFeed, headersFeed)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, feeder.Stop())
+	}()
+	indexedAttsChan := make(chan *slashertypes.WrappedIndexedAtt, 1)
+	indexedAttsSub := indexedAttsFeed.Subscribe(indexedAttsChan)
+	defer indexedAttsSub.Unsubscribe()
+	headersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	headersSub := headersFeed.Subscribe(headersChan)
+	defer headersSub.Unsubscribe()
+
+	t.Run("block header", func(t *testing.T) {
+		header := util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{Slot: 5, ProposerIndex: 3}})
+		data, err := json.Marshal(structs.SignedBeaconBlockHeaderFromConsensus(header))
+		require.NoError(t, err)
+		require.NoError(t, feeder.handleEvent(&eventClient.Event{EventType: eventClient.EventBlockHeader, Data: data}))
+		assert.DeepSSZEqual(t, header, <-headersChan)
+	})
+	t.Run("phase0 indexed attestation", func(t *testing.T) {
+		att := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}})
+		data, err := json.Marshal(structs.IndexedAttestationFromConsensus(att))
+		require.NoError(t, err)
+		require.NoError(t, feeder.handleEvent(&eventClient.Event{EventType: eventClient.EventIndexedAttestation, Data: data}))
+		got := <-indexedAttsChan
+		assert.DeepSSZEqual(t, att, got.IndexedAtt)
+	})
+	t.Run("electra indexed attestation", func(t *testing.T) {
+		att := &ethpb.IndexedAttestationElectra{
+			AttestingIndices: []uint64{1, 2},
+			Data:             util.HydrateAttestationData(&ethpb.AttestationData{Slot: params.BeaconConfig().SlotsPerEpoch * 10}),
+			Signature:        make([]byte, 96),
+		}
+		data, err := json.Marshal(structs.IndexedAttestationElectraFromConsensus(att))
+		require.NoError(t, err)
+		require.NoError(t, feeder.handleEvent(&eventClient.Event{EventType: eventClient.EventIndexedAttestation, Data: data}))
+		got := <-indexedAttsChan
+		assert.DeepSSZEqual(t, att, got.IndexedAtt)
+	})
+	t.Run("invalid indexed attestation", func(t *testing.T) {
+		err := feeder.handleEvent(&eventClient.Event{EventType: eventClient.EventIndexedAttestation, Data: []byte(`{"attesting_indices":["1"]}`)})
+		require.ErrorContains(t, "no data", err)
+	})
+}
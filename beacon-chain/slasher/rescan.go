@@ -0,0 +1,194 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+type checkpointKey struct {
+	root  [32]byte
+	epoch primitives.Epoch
+}
+
+// Rescans the blocks of the beacon database, finalized and hot, from the configured epoch up to
+// the head. The attestations and headers of the blocks are processed epoch by epoch, as if they
+// had just been received, so that the slashable offenses committed before the slasher was enabled
+// are detected. The detected slashings are submitted to the slashings pool, which only accepts the
+// ones of validators which can still be slashed. The attestations whose committees cannot be
+// determined are skipped. The epochs are processed in turn with the attestations and blocks received
+// meanwhile, so that the rescan does not delay their processing. Each epoch is processed at the
+// current slot, as the received attestations and blocks are, so that the rescan does not move back
+// the latest epoch updated for the validators.
+func (s *Service) rescan(ctx context.Context) error {
+	headSlot, err := s.headSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head slot")
+	}
+	headEpoch := slots.ToEpoch(headSlot)
+
+	// Attestations older than the history length are dropped by the slasher.
+	fromEpoch := s.serviceCfg.RescanFromEpoch
+	if headEpoch >= s.params.historyLength && fromEpoch < headEpoch-s.params.historyLength {
+		fromEpoch = headEpoch - s.params.historyLength
+	}
+	log.WithFields(logrus.Fields{
+		"fromEpoch": fromEpoch,
+		"toEpoch":   headEpoch,
+	}).Info("Rescanning beacon database for slashable offenses")
+
+	start := time.Now()
+	numAttesterSlashings, numProposerSlashings, numSkippedAtts := 0, 0, 0
+	targetStates := make(map[checkpointKey]state.ReadOnlyBeaconState)
+	for epoch := fromEpoch; epoch <= headEpoch; epoch++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// The blocks of an epoch include attestations targeting the epoch or the previous one.
+		for key := range targetStates {
+			if key.epoch+1 < epoch {
+				delete(targetStates, key)
+			}
+		}
+		atts, blocks, numSkipped, err := s.epochFromBeaconDB(ctx, epoch, targetStates)
+		if err != nil {
+			return errors.Wrapf(err, "could not read epoch %d from beacon database", epoch)
+		}
+		numSkippedAtts += numSkipped
+
+		s.attsProcessingLock.Lock()
+		numAttesterSlashings += len(s.processAttestations(ctx, atts, s.rescanSlot(headSlot)))
+		s.attsProcessingLock.Unlock()
+
+		s.blksProcessingLock.Lock()
+		numProposerSlashings += len(s.processBlocks(ctx, blocks, s.rescanSlot(headSlot)))
+		s.blksProcessingLock.Unlock()
+	}
+
+	log.WithFields(logrus.Fields{
+		"elapsed":              time.Since(start),
+		"numAttesterSlashings": numAttesterSlashings,
+		"numProposerSlashings": numProposerSlashings,
+		"numSkippedAtts":       numSkippedAtts,
+	}).Info("Done rescanning beacon database")
+	return nil
+}
+
+// Returns the slot at which rescanned attestations and blocks are processed: the current slot,
+// or the head slot the rescan started from if the clock is behind it.
+func (s *Service) rescanSlot(headSlot primitives.Slot) primitives.Slot {
+	return max(headSlot, slots.SinceGenesis(s.genesisTime))
+}
+
+// Reads the attestations and headers of the blocks of an epoch from the beacon database. The
+// attestations whose committees cannot be determined, for instance because the state of their
+// target cannot be regenerated, are skipped and counted. The target states which cannot be
+// retrieved are cached as nil, so that they are not retrieved again for each attestation.
+func (s *Service) epochFromBeaconDB(
+	ctx context.Context, epoch primitives.Epoch, targetStates map[checkpointKey]state.ReadOnlyBeaconState,
+) ([]*slashertypes.IndexedAttestationWrapper, []*slashertypes.SignedBlockHeaderWrapper, int, error) {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch - 1
+	blks, roots, err := s.serviceCfg.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	atts := make([]*slashertypes.IndexedAttestationWrapper, 0)
+	headers := make([]*slashertypes.SignedBlockHeaderWrapper, 0, len(blks))
+	numSkipped := 0
+	for i, blk := range blks {
+		header, err := blk.Header()
+		if err != nil {
+			return nil, nil, 0, errors.Wrapf(err, "could not get header of block %#x", roots[i])
+		}
+		if validateBlockHeaderIntegrity(header) {
+			headers = append(headers, &slashertypes.SignedBlockHeaderWrapper{
+				SignedBeaconBlockHeader: header,
+				HeaderRoot:              roots[i],
+			})
+		}
+
+		for _, att := range blk.Block().Body().Attestations() {
+			target := att.GetData().Target
+			key := checkpointKey{root: bytesutil.ToBytes32(target.Root), epoch: target.Epoch}
+			targetState, ok := targetStates[key]
+			if !ok {
+				targetState, err = s.attestationTargetState(ctx, target)
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil, nil, 0, ctx.Err()
+					}
+					log.WithError(err).WithField("blockRoot", fmt.Sprintf("%#x", roots[i])).Debug("Could not get target state of rescanned attestation")
+					targetState = nil
+				}
+				targetStates[key] = targetState
+			}
+			if targetState == nil {
+				numSkipped++
+				continue
+			}
+			committees, err := helpers.AttestationCommittees(ctx, targetState, att)
+			if err != nil {
+				log.WithError(err).WithField("blockRoot", fmt.Sprintf("%#x", roots[i])).Debug("Could not get committees of rescanned attestation")
+				numSkipped++
+				continue
+			}
+			indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committees...)
+			if err != nil {
+				log.WithError(err).WithField("blockRoot", fmt.Sprintf("%#x", roots[i])).Debug("Could not convert rescanned attestation to indexed attestation")
+				numSkipped++
+				continue
+			}
+			if !validateAttestationIntegrity(indexedAtt) {
+				continue
+			}
+			dataRoot, err := indexedAtt.GetData().HashTreeRoot()
+			if err != nil {
+				return nil, nil, 0, errors.Wrap(err, "could not get hash tree root of attestation")
+			}
+			atts = append(atts, &slashertypes.IndexedAttestationWrapper{
+				IndexedAttestation: indexedAtt,
+				DataRoot:           dataRoot,
+			})
+		}
+	}
+	return atts, headers, numSkipped, nil
+}
+
+// Returns the state of an attestation target checkpoint, which determines the committees of the
+// attestation. The states of the checkpoints fork choice no longer holds, such as the ones of
+// rescanned attestations, are regenerated.
+func (s *Service) attestationTargetState(ctx context.Context, target *ethpb.Checkpoint) (state.ReadOnlyBeaconState, error) {
+	targetState, err := s.serviceCfg.AttestationStateFetcher.AttestationTargetState(ctx, target)
+	if err == nil || !errors.Is(err, blockchain.ErrNotCheckpoint) {
+		return targetState, err
+	}
+	baseState, err := s.serviceCfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(target.Root))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state of checkpoint root %#x", target.Root)
+	}
+	epochStart, err := slots.EpochStart(target.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	return transition.ProcessSlotsIfPossible(ctx, baseState, epochStart)
+}
@@ -0,0 +1,205 @@
+package slasher
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestService_rescan(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	mockChain := &mock.ChainService{State: beaconState}
+	unknownRoot := bytesutil.ToBytes32([]byte("unknown"))
+	stateFetcher := &unknownTargetStateFetcher{AttestationStateFetcher: mockChain, unknownRoot: unknownRoot}
+	poolInserter := &slashingsmock.PoolMock{}
+	s, err := New(ctx, &ServiceConfig{
+		Database:                dbtest.SetupSlasherDB(t),
+		AttestationStateFetcher: stateFetcher,
+		StateGen:                stategen.New(beaconDB, doublylinkedtree.New()),
+		SlashingPoolInserter:    poolInserter,
+		HeadStateFetcher:        mockChain,
+		BeaconDB:                beaconDB,
+	})
+	require.NoError(t, err)
+
+	s.genesisTime = time.Now()
+
+	parentRoot := bytesutil.ToBytes32([]byte("parent"))
+	require.NoError(t, s.serviceCfg.StateGen.SaveState(ctx, parentRoot, beaconState))
+	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, 0, 0)
+	require.NoError(t, err)
+	saveDoubleVoteBlocks(t, beaconDB, beaconState, privKeys, parentRoot, committee[0])
+
+	// The attestations whose target state cannot be retrieved are skipped, without failing the rescan.
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 2
+	blk.Block.ParentRoot = parentRoot[:]
+	for _, blockRoot := range [][]byte{[]byte("c"), []byte("d")} {
+		blk.Block.Body.Attestations = append(blk.Block.Body.Attestations, util.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bitfield.NewBitlist(uint64(len(committee))),
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo(blockRoot, 32),
+				Target:          &ethpb.Checkpoint{Root: unknownRoot[:]},
+			},
+		}))
+	}
+	wsb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+
+	require.NoError(t, s.rescan(ctx))
+	require.Equal(t, 1, stateFetcher.numUnknown)
+	require.Equal(t, 1, len(poolInserter.PendingAttSlashings))
+	require.DeepEqual(t, []uint64{uint64(committee[0])}, poolInserter.PendingAttSlashings[0].FirstAttestation().GetAttestingIndices())
+	require.Equal(t, 1, len(poolInserter.PendingPropSlashings))
+	require.Equal(t, primitives.ValidatorIndex(0), poolInserter.PendingPropSlashings[0].Header_1.Header.ProposerIndex)
+	detected, _, err := s.DetectedSlashings(ctx, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(detected))
+}
+
+// saveDoubleVoteBlocks saves two blocks proposed by validator 0 at slot 1, including attestations of the
+// given validator voting for two different block roots with the same target.
+func saveDoubleVoteBlocks(
+	t *testing.T,
+	beaconDB db.Database,
+	beaconState state.BeaconState,
+	privKeys []bls.SecretKey,
+	targetRoot [32]byte,
+	validatorIndex primitives.ValidatorIndex,
+) {
+	ctx := context.Background()
+	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, 0, 0)
+	require.NoError(t, err)
+	for _, blockRoot := range [][]byte{[]byte("a"), []byte("b")} {
+		att := util.HydrateAttestation(&ethpb.Attestation{
+			AggregationBits: bitfield.NewBitlist(uint64(len(committee))),
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo(blockRoot, 32),
+				Target:          &ethpb.Checkpoint{Root: targetRoot[:]},
+			},
+		})
+		att.AggregationBits.SetBitAt(uint64(slices.Index(committee, validatorIndex)), true)
+		att.Signature, err = signing.ComputeDomainAndSign(beaconState, 0, att.Data, params.BeaconConfig().DomainBeaconAttester, privKeys[validatorIndex])
+		require.NoError(t, err)
+
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = 1
+		blk.Block.ParentRoot = targetRoot[:]
+		blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+		blk.Signature, err = signing.ComputeDomainAndSign(beaconState, 0, blk.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[0])
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	}
+}
+
+func TestService_rescan_ReceivedAttestationsMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	mockChain := &mock.ChainService{State: beaconState}
+	poolInserter := &slashingsmock.PoolMock{}
+	rescannedDB := &receivingBeaconDB{ReadOnlyDatabase: beaconDB}
+	s, err := New(ctx, &ServiceConfig{
+		Database:                dbtest.SetupSlasherDB(t),
+		AttestationStateFetcher: mockChain,
+		StateGen:                stategen.New(beaconDB, doublylinkedtree.New()),
+		SlashingPoolInserter:    poolInserter,
+		HeadStateFetcher:        mockChain,
+		BeaconDB:                rescannedDB,
+	})
+	require.NoError(t, err)
+
+	// The head is at genesis, while the clock is at epoch 3.
+	const currentEpoch = primitives.Epoch(3)
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	s.genesisTime = time.Now().Add(-time.Duration(uint64(currentEpoch)*slotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	parentRoot := bytesutil.ToBytes32([]byte("parent"))
+	require.NoError(t, s.serviceCfg.StateGen.SaveState(ctx, parentRoot, beaconState))
+	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, 0, 0)
+	require.NoError(t, err)
+	validatorIndex := committee[0]
+	saveDoubleVoteBlocks(t, beaconDB, beaconState, privKeys, parentRoot, validatorIndex)
+
+	// An attestation of the validator is received and processed while the rescan reads the beacon database.
+	currentSlot, err := slots.EpochStart(currentEpoch)
+	require.NoError(t, err)
+	rescannedDB.receive = func() {
+		s.attsProcessingLock.Lock()
+		defer s.attsProcessingLock.Unlock()
+		received := createAttestationWrapperEmptySig(t, 1, 2, []uint64{uint64(validatorIndex)}, []byte("e"))
+		s.processAttestations(ctx, []*slashertypes.IndexedAttestationWrapper{received}, currentSlot)
+	}
+
+	require.NoError(t, s.rescan(ctx))
+	require.Equal(t, 1, len(poolInserter.PendingAttSlashings))
+	s.latestEpochUpdatedLock.RLock()
+	require.Equal(t, currentEpoch, s.latestEpochUpdatedForValidator[validatorIndex])
+	s.latestEpochUpdatedLock.RUnlock()
+
+	// The spans of the received attestation are kept, so that an attestation surrounding it is slashable.
+	surrounding := createAttestationWrapperEmptySig(t, 0, 3, []uint64{uint64(validatorIndex)}, []byte("f"))
+	slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, []*slashertypes.IndexedAttestationWrapper{surrounding})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+}
+
+// receivingBeaconDB runs receive once, the first time blocks are read.
+type receivingBeaconDB struct {
+	db.ReadOnlyDatabase
+	receive func()
+}
+
+func (d *receivingBeaconDB) Blocks(ctx context.Context, f *filters.QueryFilter) ([]interfaces.ReadOnlySignedBeaconBlock, [][32]byte, error) {
+	if d.receive != nil {
+		d.receive()
+		d.receive = nil
+	}
+	return d.ReadOnlyDatabase.Blocks(ctx, f)
+}
+
+// unknownTargetStateFetcher fails to retrieve the state of the target with the unknown root.
+type unknownTargetStateFetcher struct {
+	blockchain.AttestationStateFetcher
+	unknownRoot [32]byte
+	numUnknown  int
+}
+
+func (f *unknownTargetStateFetcher) AttestationTargetState(ctx context.Context, target *ethpb.Checkpoint) (state.ReadOnlyBeaconState, error) {
+	if bytesutil.ToBytes32(target.Root) == f.unknownRoot {
+		f.numUnknown++
+		return nil, errors.New("unknown target")
+	}
+	return f.AttestationStateFetcher.AttestationTargetState(ctx, target)
+}
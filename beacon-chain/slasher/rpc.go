@@ -0,0 +1,216 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+var (
+	// ErrSpansNotFound is returned when the slasher database has no spans for a validator at an epoch.
+	ErrSpansNotFound = errors.New("spans not found in slasher database")
+	// ErrInvalidAttestation is returned when checking a malformed attestation.
+	ErrInvalidAttestation = errors.New("attestation is malformed or its source epoch is not before its target epoch")
+	// ErrInvalidBlockHeader is returned when checking a malformed block header.
+	ErrInvalidBlockHeader = errors.New("block header is malformed or its signature is empty")
+)
+
+// Querier queries the slashings detected by the slasher and the slasher database.
+type Querier interface {
+	DetectedSlashings(ctx context.Context, pageToken []byte, pageSize int) ([]*slashertypes.DetectedSlashing, []byte, error)
+	IsSlashableAttestation(ctx context.Context, attestation ethpb.IndexedAtt) ([]ethpb.AttSlashing, error)
+	IsSlashableBlock(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) ([]*ethpb.ProposerSlashing, error)
+	ValidatorSpans(
+		ctx context.Context, kind slashertypes.ChunkKind, validatorIndex primitives.ValidatorIndex, epoch primitives.Epoch,
+	) (*ValidatorSpans, error)
+}
+
+// ValidatorSpans are the min or max spans of a validator for the epochs of the chunk
+// containing an epoch, limited to the epochs within the slasher history of the validator.
+type ValidatorSpans struct {
+	Kind             slashertypes.ChunkKind
+	ValidatorIndex   primitives.ValidatorIndex
+	LastEpochWritten primitives.Epoch
+	Spans            []*EpochSpan
+}
+
+// EpochSpan is the span of a validator at an epoch, the distance between the epoch
+// and the target epoch of the closest attestation surrounding (for min spans) or
+// surrounded by (for max spans) an attestation with this source epoch.
+type EpochSpan struct {
+	Epoch primitives.Epoch
+	Span  uint16
+}
+
+// DetectedSlashings returns a page of at most pageSize slashings detected by the slasher, sorted by
+// the epoch of the offense, starting from the given page token, along with the token of the next page
+// which is empty on the last page.
+func (s *Service) DetectedSlashings(
+	ctx context.Context, pageToken []byte, pageSize int,
+) ([]*slashertypes.DetectedSlashing, []byte, error) {
+	return s.serviceCfg.Database.DetectedSlashings(ctx, pageToken, pageSize)
+}
+
+// IsSlashableAttestation checks if an indexed attestation would be slashable with respect
+// to the attestations in the slasher database, without recording it.
+func (s *Service) IsSlashableAttestation(ctx context.Context, attestation ethpb.IndexedAtt) ([]ethpb.AttSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if !validateAttestationIntegrity(attestation) {
+		return nil, ErrInvalidAttestation
+	}
+	dataRoot, err := attestation.GetData().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation data root")
+	}
+	attWrapper := &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: attestation,
+		DataRoot:           dataRoot,
+	}
+
+	// The attestation is checked against the database only, without side effects such as the
+	// metrics updated while processing attestations.
+	slashings := make(map[[fieldparams.RootLength]byte]ethpb.AttSlashing)
+	addSlashing := func(existing *slashertypes.IndexedAttestationWrapper) error {
+		slashing := attesterSlashing(existing, attWrapper)
+		root, err := slashing.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash tree root for attester slashing")
+		}
+		slashings[root] = slashing
+		return nil
+	}
+
+	doubleVotes, err := s.serviceCfg.Database.CheckAttesterDoubleVotes(ctx, []*slashertypes.IndexedAttestationWrapper{attWrapper})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check slashable double votes")
+	}
+	for _, doubleVote := range doubleVotes {
+		if err := addSlashing(doubleVote.Wrapper_1); err != nil {
+			return nil, err
+		}
+	}
+
+	// Check surrounding and surrounded votes against the spans on disk, which are only
+	// updated while processing attestations.
+	sourceEpoch := attestation.GetData().Source.Epoch
+	for _, index := range attestation.GetAttestingIndices() {
+		validatorIndex := primitives.ValidatorIndex(index)
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			chunk, err := s.getChunkFromDatabase(ctx, kind, s.params.validatorChunkIndex(validatorIndex), s.params.chunkIndex(sourceEpoch))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get %s chunk for validator index %d", kind, validatorIndex)
+			}
+			existing, err := chunk.ConflictingAttestation(ctx, s.serviceCfg.Database, validatorIndex, attWrapper)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not check if attestation for validator index %d is slashable", validatorIndex)
+			}
+			if existing == nil {
+				continue
+			}
+			if err := addSlashing(existing); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sortedAttesterSlashings(slashings), nil
+}
+
+// IsSlashableBlock checks if a signed block header would be slashable with respect to
+// the block proposals in the slasher database, without recording it.
+func (s *Service) IsSlashableBlock(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if !validateBlockHeaderIntegrity(header) {
+		return nil, ErrInvalidBlockHeader
+	}
+	headerRoot, err := header.Header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header root")
+	}
+	proposal := &slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: header,
+		HeaderRoot:              headerRoot,
+	}
+	slashings, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{proposal})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double proposals on disk")
+	}
+	return slashings, nil
+}
+
+// attesterSlashing returns the slashing of the incoming attestation conflicting with an existing one, of the
+// fork of the incoming attestation. The attestation with the lower data root is the first attestation.
+func attesterSlashing(existing, incoming *slashertypes.IndexedAttestationWrapper) ethpb.AttSlashing {
+	first, second := existing.IndexedAttestation, incoming.IndexedAttestation
+	if bytes.Compare(existing.DataRoot[:], incoming.DataRoot[:]) > 0 {
+		first, second = second, first
+	}
+	if incoming.IndexedAttestation.Version() >= version.Electra {
+		return &ethpb.AttesterSlashingElectra{
+			Attestation_1: &ethpb.IndexedAttestationElectra{AttestingIndices: first.GetAttestingIndices(), Data: first.GetData(), Signature: first.GetSignature()},
+			Attestation_2: &ethpb.IndexedAttestationElectra{AttestingIndices: second.GetAttestingIndices(), Data: second.GetData(), Signature: second.GetSignature()},
+		}
+	}
+	return &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: first.GetAttestingIndices(), Data: first.GetData(), Signature: first.GetSignature()},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: second.GetAttestingIndices(), Data: second.GetData(), Signature: second.GetSignature()},
+	}
+}
+
+// ValidatorSpans returns the min or max spans of a validator for the epochs of the chunk
+// containing an epoch. These are the spans displayed by `prysmctl db slasher-span-display`.
+func (s *Service) ValidatorSpans(
+	ctx context.Context, kind slashertypes.ChunkKind, validatorIndex primitives.ValidatorIndex, epoch primitives.Epoch,
+) (*ValidatorSpans, error) {
+	lastEpoch, _, _, chunk, err := s.chunkForValidator(ctx, kind, validatorIndex, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Because chunks are circular, the epochs of the chunk outside the history of the
+	// validator hold the spans of other epochs and are skipped.
+	firstEpoch := epoch - epoch.Mod(s.params.chunkSize)
+	lowestEpoch := s.params.historyStartEpoch(lastEpoch)
+	spans := make([]*EpochSpan, 0, s.params.chunkSize)
+	for e := firstEpoch; e < firstEpoch.Add(s.params.chunkSize); e++ {
+		if e < lowestEpoch || e > lastEpoch {
+			continue
+		}
+		spans = append(spans, &EpochSpan{Epoch: e, Span: chunk.Chunk()[s.params.cellIndex(validatorIndex, e)]})
+	}
+
+	return &ValidatorSpans{
+		Kind:             kind,
+		ValidatorIndex:   validatorIndex,
+		LastEpochWritten: lastEpoch,
+		Spans:            spans,
+	}, nil
+}
+
+// Returns the attester slashings ordered by root, for deterministic responses.
+func sortedAttesterSlashings(slashings map[[fieldparams.RootLength]byte]ethpb.AttSlashing) []ethpb.AttSlashing {
+	roots := make([][fieldparams.RootLength]byte, 0, len(slashings))
+	for root := range slashings {
+		roots = append(roots, root)
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return bytes.Compare(roots[i][:], roots[j][:]) < 0
+	})
+	sorted := make([]ethpb.AttSlashing, len(roots))
+	for i, root := range roots {
+		sorted[i] = slashings[root]
+	}
+	return sorted
+}
@@ -0,0 +1,124 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_IsSlashableAttestation(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params:                         DefaultParams(),
+		serviceCfg:                     &ServiceConfig{Database: dbtest.SetupSlasherDB(t)},
+		latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{},
+	}
+	_, err := s.checkSlashableAttestations(ctx, 4, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapperEmptySig(t, 1, 2, []uint64{0, 1}, []byte{1}),
+	})
+	require.NoError(t, err)
+
+	// toElectra returns the Electra version of an attestation.
+	toElectra := func(att *slashertypes.IndexedAttestationWrapper) ethpb.IndexedAtt {
+		return &ethpb.IndexedAttestationElectra{
+			AttestingIndices: att.IndexedAttestation.GetAttestingIndices(),
+			Data:             att.IndexedAttestation.GetData(),
+			Signature:        att.IndexedAttestation.GetSignature(),
+		}
+	}
+	tests := []struct {
+		name      string
+		att       ethpb.IndexedAtt
+		slashings int
+	}{
+		{name: "double vote", att: createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, []byte{2}).IndexedAttestation, slashings: 1},
+		{name: "surrounding vote", att: createAttestationWrapperEmptySig(t, 0, 3, []uint64{1}, []byte{1}).IndexedAttestation, slashings: 1},
+		{name: "same vote", att: createAttestationWrapperEmptySig(t, 1, 2, []uint64{0, 1}, []byte{1}).IndexedAttestation, slashings: 0},
+		{name: "not slashable", att: createAttestationWrapperEmptySig(t, 2, 3, []uint64{0, 1}, []byte{1}).IndexedAttestation, slashings: 0},
+		{name: "electra double vote", att: toElectra(createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, []byte{2})), slashings: 1},
+		{name: "electra surrounding vote", att: toElectra(createAttestationWrapperEmptySig(t, 0, 3, []uint64{1}, []byte{1})), slashings: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slashings, err := s.IsSlashableAttestation(ctx, tt.att)
+			require.NoError(t, err)
+			require.Equal(t, tt.slashings, len(slashings))
+			for _, slashing := range slashings {
+				assert.Equal(t, tt.att.Version(), slashing.Version())
+			}
+		})
+	}
+
+	// Checking attestations does not record them.
+	record, err := s.serviceCfg.Database.AttestationRecordForValidator(ctx, 1, 3)
+	require.NoError(t, err)
+	require.IsNil(t, record)
+
+	_, err = s.IsSlashableAttestation(ctx, createAttestationWrapperEmptySig(t, 3, 2, []uint64{0}, []byte{1}).IndexedAttestation)
+	require.ErrorIs(t, err, ErrInvalidAttestation)
+}
+
+func TestService_IsSlashableBlock(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params:     DefaultParams(),
+		serviceCfg: &ServiceConfig{Database: dbtest.SetupSlasherDB(t)},
+	}
+	_, err := s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{createProposalWrapper(t, 4, 1, []byte{1})})
+	require.NoError(t, err)
+
+	slashings, err := s.IsSlashableBlock(ctx, createProposalWrapper(t, 4, 1, []byte{2}).SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(slashings))
+
+	slashings, err = s.IsSlashableBlock(ctx, createProposalWrapper(t, 4, 1, []byte{1}).SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(slashings))
+
+	// Checking a block header does not record it.
+	proposal, err := s.serviceCfg.Database.BlockProposalForValidator(ctx, 2, 5)
+	require.NoError(t, err)
+	require.IsNil(t, proposal)
+	_, err = s.IsSlashableBlock(ctx, createProposalWrapper(t, 5, 2, []byte{1}).SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	proposal, err = s.serviceCfg.Database.BlockProposalForValidator(ctx, 2, 5)
+	require.NoError(t, err)
+	require.IsNil(t, proposal)
+}
+
+func TestService_ValidatorSpans(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params:                         DefaultParams(),
+		serviceCfg:                     &ServiceConfig{Database: dbtest.SetupSlasherDB(t)},
+		latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{},
+	}
+	_, err := s.checkSlashableAttestations(ctx, 4, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapperEmptySig(t, 1, 3, []uint64{0}, []byte{1}),
+	})
+	require.NoError(t, err)
+
+	spans, err := s.ValidatorSpans(ctx, slashertypes.MinSpan, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(4), spans.LastEpochWritten)
+	// Only the epochs up to the last epoch written are within the history of the validator.
+	require.Equal(t, 5, len(spans.Spans))
+	assert.Equal(t, primitives.Epoch(0), spans.Spans[0].Epoch)
+	assert.Equal(t, uint16(3), spans.Spans[0].Span)
+
+	spans, err = s.ValidatorSpans(ctx, slashertypes.MaxSpan, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(2), spans.Spans[2].Epoch)
+	assert.Equal(t, uint16(1), spans.Spans[2].Span)
+
+	_, err = s.ValidatorSpans(ctx, slashertypes.MinSpan, 0, 5)
+	require.ErrorIs(t, err, ErrSpansNotFound)
+	_, err = s.ValidatorSpans(ctx, slashertypes.MinSpan, primitives.ValidatorIndex(s.params.validatorChunkSize), 2)
+	require.ErrorIs(t, err, ErrSpansNotFound)
+}
@@ -42,6 +42,15 @@ type ServiceConfig struct {
 	HeadStateFetcher        blockchain.HeadFetcher
 	SyncChecker             beaconChainSync.Checker
 	ClockWaiter             startup.ClockWaiter
+	// RemoteChainFetcher is set when the slasher runs in its own process, fed by a beacon node
+	// through its API. The head of the chain is then read from that beacon node instead of
+	// HeadStateFetcher, and the signatures of the detected slashings are verified by the beacon
+	// node they are submitted to instead of against the states of StateGen.
+	RemoteChainFetcher RemoteChainFetcher
+	// BeaconDB is set to rescan its blocks from RescanFromEpoch when the slasher starts, detecting
+	// the slashable offenses committed before the slasher was enabled.
+	BeaconDB        db.ReadOnlyDatabase
+	RescanFromEpoch primitives.Epoch
 }
 
 // Service defining a slasher implementation as part of
@@ -60,6 +69,9 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochUpdatedForValidator map[primitives.ValidatorIndex]primitives.Epoch
+	latestEpochUpdatedLock         sync.RWMutex
+	attsProcessingLock             sync.Mutex
+	blksProcessingLock             sync.Mutex
 	wg                             sync.WaitGroup
 }
 
@@ -92,12 +104,11 @@ func (s *Service) run() {
 	log.Info("Completed chain sync, starting slashing detection")
 
 	// Get the latest epoch written for each validator from disk on startup.
-	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(s.ctx)
+	numVals, err := s.numValidators(s.ctx)
 	if err != nil {
-		log.WithError(err).Error("Failed to fetch head state")
+		log.WithError(err).Error("Failed to fetch number of validators")
 		return
 	}
-	numVals := headState.NumValidators()
 	validatorIndices := make([]primitives.ValidatorIndex, numVals)
 	for i := 0; i < numVals; i++ {
 		validatorIndices[i] = primitives.ValidatorIndex(i)
@@ -111,9 +122,11 @@ func (s *Service) run() {
 		log.Error(err)
 		return
 	}
+	s.latestEpochUpdatedLock.Lock()
 	for _, item := range epochsByValidator {
 		s.latestEpochUpdatedForValidator[item.ValidatorIndex] = item.Epoch
 	}
+	s.latestEpochUpdatedLock.Unlock()
 	log.WithField("elapsed", time.Since(start)).Info(
 		"Finished retrieving last epoch written per validator",
 	)
@@ -122,7 +135,11 @@ func (s *Service) run() {
 	beaconBlockHeadersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
 
 	// This section can be totally removed once Electra is on mainnet.
-	headSlot := s.serviceCfg.HeadStateFetcher.HeadSlot()
+	headSlot, err := s.headSlot(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch head slot")
+		return
+	}
 	headEpoch := slots.ToEpoch(headSlot)
 
 	maxPruningEpoch := primitives.Epoch(0)
@@ -147,6 +164,17 @@ func (s *Service) run() {
 	s.wg.Add(1)
 	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
 
+	// The rescanned epochs are processed in turn with the attestations and blocks received meanwhile.
+	if s.serviceCfg.BeaconDB != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			if err := s.rescan(s.ctx); err != nil && s.ctx.Err() == nil {
+				log.WithError(err).Error("Could not rescan beacon database")
+			}
+		}()
+	}
+
 	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
 	s.attsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.blocksSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
@@ -70,3 +72,11 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex primitives.ValidatorIndex
 	Epoch          primitives.Epoch
 }
+
+// DetectedSlashing is a slashable offense detected by the slasher, with the
+// slashing object proving it. Exactly one of the slashings is set.
+type DetectedSlashing struct {
+	DetectedAt       time.Time
+	AttesterSlashing ethpb.AttSlashing
+	ProposerSlashing *ethpb.ProposerSlashing
+}
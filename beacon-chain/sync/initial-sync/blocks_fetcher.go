@@ -598,7 +598,9 @@ func (f *blocksFetcher) requestBlocks(
 	}
 	f.rateLimiter.Add(pid.String(), int64(req.Count))
 	l.Unlock()
-	return prysmsync.SendBeaconBlocksByRangeRequest(ctx, f.chain, f.p2p, pid, req, nil)
+	blocks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, f.chain, f.p2p, pid, req, nil)
+	f.backOff(pid, err)
+	return blocks, err
 }
 
 func (f *blocksFetcher) requestBlobs(ctx context.Context, req *p2ppb.BlobSidecarsByRangeRequest, pid peer.ID) ([]blocks.ROBlob, error) {
@@ -625,7 +627,9 @@ func (f *blocksFetcher) requestBlobs(ctx context.Context, req *p2ppb.BlobSidecar
 	}
 	f.rateLimiter.Add(pid.String(), int64(req.Count))
 	l.Unlock()
-	return prysmsync.SendBlobsByRangeRequest(ctx, f.clock, f.p2p, pid, f.ctxMap, req)
+	blobs, err := prysmsync.SendBlobsByRangeRequest(ctx, f.clock, f.p2p, pid, f.ctxMap, req)
+	f.backOff(pid, err)
+	return blobs, err
 }
 
 // requestBlocksByRoot is a wrapper for handling BeaconBlockByRootsReq requests/streams.
@@ -654,7 +658,22 @@ func (f *blocksFetcher) requestBlocksByRoot(
 	f.rateLimiter.Add(pid.String(), int64(len(*req)))
 	l.Unlock()
 
-	return prysmsync.SendBeaconBlocksByRootRequest(ctx, f.chain, f.p2p, pid, req, nil)
+	blocks, err := prysmsync.SendBeaconBlocksByRootRequest(ctx, f.chain, f.p2p, pid, req, nil)
+	f.backOff(pid, err)
+	return blocks, err
+}
+
+// backOff uses the capacity of a peer which rate limited a request or could not serve it yet, for as
+// long as the peer asked us to wait before retrying, so that the peer is not requested again before then.
+func (f *blocksFetcher) backOff(pid peer.ID, err error) {
+	retryAfter, ok := p2pTypes.RetryAfter(err)
+	if !ok {
+		return
+	}
+	log.WithField("peer", pid).WithField("retryAfter", retryAfter).Debug("Peer asked to retry our request later")
+	// Round up, the bucket leaking for slightly longer than the hinted delay.
+	amt := int64(retryAfter.Seconds()/f.rateLimiter.Period().Seconds()*f.rateLimiter.Rate()) + 1
+	f.rateLimiter.Add(pid.String(), amt)
 }
 
 // waitForBandwidth blocks up until peer's bandwidth is restored.
@@ -12,6 +12,7 @@ import (
 	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -638,6 +639,19 @@ func TestBlocksFetcher_WaitForBandwidth(t *testing.T) {
 	assert.Equal(t, float64(5), dur.Truncate(1*time.Second).Seconds(), "waited excessively for bandwidth")
 }
 
+func TestBlocksFetcher_BackOff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{p2p: p2pt.NewTestP2P(t)})
+	fetcher.rateLimiter = leakybucket.NewCollector(64, 640, time.Second, false)
+	pid := peer.ID("a")
+
+	fetcher.backOff(pid, errors.New("could not request blocks"))
+	assert.Equal(t, int64(640), fetcher.rateLimiter.Remaining(pid.String()))
+	fetcher.backOff(pid, errors.Wrap(errors.New("rate limited, retry after 2s"), "could not request blocks"))
+	assert.Equal(t, int64(640-129), fetcher.rateLimiter.Remaining(pid.String()))
+}
+
 func TestBlocksFetcher_requestBlocksFromPeerReturningInvalidBlocks(t *testing.T) {
 	p1 := p2pt.NewTestP2P(t)
 	tests := []struct {
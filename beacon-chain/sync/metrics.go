@@ -82,6 +82,13 @@ var (
 			Buckets: []float64{5, 10, 50, 100, 150, 250, 500, 1000, 2000},
 		},
 	)
+	rpcOutboundThrottleSeconds = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_outbound_throttle_seconds_total",
+			Help: "Time spent waiting for the outbound bandwidth cap of an rpc topic before serving a response chunk",
+		},
+		[]string{"topic"},
+	)
 	rpcBlobsByRangeResponseLatency = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "rpc_blobs_by_range_response_latency_milliseconds",
@@ -180,3 +180,11 @@ func WithAvailableBlocker(avb coverage.AvailableBlocker) Option {
 		return nil
 	}
 }
+
+// WithRateLimitsConfig sets the req/resp request quotas, replacing the defaults of the groups it configures.
+func WithRateLimitsConfig(cfg *RateLimitsConfig) Option {
+	return func(s *Service) error {
+		s.cfg.rateLimits = cfg
+		return nil
+	}
+}
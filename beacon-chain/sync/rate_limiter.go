@@ -1,11 +1,14 @@
 package sync
 
 import (
+	"context"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
@@ -25,64 +28,139 @@ const blockBucketPeriod = 30 * time.Second
 // Dummy topic to validate all incoming rpc requests.
 const rpcLimiterTopic = "rpc-limiter-topic"
 
+// Key of the outbound byte collectors, which are shared by all peers.
+const outboundBytesKey = "outbound"
+
+// Outbound byte collectors allow bursts of this many seconds worth of traffic.
+const outboundBurstSeconds = 2
+
+// Number of peers above which the request counters of disconnected peers are pruned.
+const rateLimitHitsPruneThreshold = 500
+
 type limiter struct {
-	limiterMap map[string]*leakybucket.Collector
-	p2p        p2p.P2P
+	limiterMap  map[string]*leakybucket.Collector
+	trustedMap  map[string]*leakybucket.Collector
+	outboundMap map[string]*leakybucket.Collector
+	p2p         p2p.P2P
 	sync.RWMutex
+
+	hitsLock sync.Mutex
+	hits     map[peer.ID]map[string]*rateLimitHits
+
+	// outboundLock makes checking and using the outbound capacity of a topic a single operation.
+	outboundLock sync.Mutex
+}
+
+// rateLimitHits counts the requests made by a peer on a topic.
+type rateLimitHits struct {
+	requests        uint64
+	rateLimited     uint64
+	lastRateLimited time.Time
+}
+
+// RateLimitUsage is the usage by a peer of the rate limit quota of a req/resp topic.
+type RateLimitUsage struct {
+	Topic           string
+	Requests        uint64
+	RateLimited     uint64
+	LastRateLimited time.Time
+	Remaining       int64
+	Capacity        int64
+}
+
+// PeerRateLimits is the usage of the rate limit quotas by a peer.
+type PeerRateLimits struct {
+	PeerID  peer.ID
+	Trusted bool
+	Usage   []*RateLimitUsage
+}
+
+// RateLimitReporter reports how peers use their req/resp rate limit quotas.
+type RateLimitReporter interface {
+	RateLimits() []*PeerRateLimits
+	PeerRateLimits(pid peer.ID) (*PeerRateLimits, bool)
 }
 
 // Instantiates a multi-rpc protocol rate limiter, providing
 // separate collectors for each topic.
 func newRateLimiter(p2pProvider p2p.P2P) *limiter {
+	return newConfiguredRateLimiter(p2pProvider, nil)
+}
+
+// Instantiates a rate limiter with the quotas of the given config, falling back
+// to the default quotas for the groups it does not configure.
+func newConfiguredRateLimiter(p2pProvider p2p.P2P, cfg *RateLimitsConfig) *limiter {
 	// add encoding suffix
 	addEncoding := func(topic string) string {
 		return topic + p2pProvider.Encoding().ProtocolSuffix()
 	}
-	// Initialize block limits.
-	allowedBlocksPerSecond := float64(flags.Get().BlockBatchLimit)
-	allowedBlocksBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
+	quotas, trustedQuotas := cfg.quotas()
+
+	// Caps on the bytes served for range requests, across all peers.
+	outboundMap := make(map[string]*leakybucket.Collector)
+	if limit := flags.Get().BlocksByRangeOutboundBytesLimit; limit > 0 {
+		blockBytesCollector := newOutboundCollector(limit)
+		outboundMap[addEncoding(p2p.RPCBlocksByRangeTopicV1)] = blockBytesCollector
+		outboundMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockBytesCollector
+	}
+	if limit := flags.Get().BlobSidecarsByRangeOutboundBytesLimit; limit > 0 {
+		outboundMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = newOutboundCollector(limit)
+	}
 
-	// Initialize blob limits.
-	allowedBlobsPerSecond := float64(flags.Get().BlobBatchLimit)
-	allowedBlobsBurst := int64(flags.Get().BlobBatchLimitBurstFactor * flags.Get().BlobBatchLimit)
+	return &limiter{
+		limiterMap:  newTopicCollectors(quotas, addEncoding),
+		trustedMap:  newTopicCollectors(trustedQuotas, addEncoding),
+		outboundMap: outboundMap,
+		p2p:         p2pProvider,
+		hits:        make(map[peer.ID]map[string]*rateLimitHits),
+	}
+}
 
+// newTopicCollectors creates the collectors of the rpc topics from the quotas of their groups.
+// Topics of a group without a quota get no collector.
+func newTopicCollectors(quotas map[string]*RateLimitQuota, addEncoding func(string) string) map[string]*leakybucket.Collector {
+	newCollector := func(group string) *leakybucket.Collector {
+		q, ok := quotas[group]
+		if !ok {
+			return nil
+		}
+		return leakybucket.NewCollector(q.Rate, q.Burst, q.Period, false /* deleteEmptyBuckets */)
+	}
 	// Set topic map for all rpc topics.
 	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
+	set := func(collector *leakybucket.Collector, topics ...string) {
+		if collector == nil {
+			return
+		}
+		for _, topic := range topics {
+			topicMap[topic] = collector
+		}
+	}
 	// Goodbye Message
-	topicMap[addEncoding(p2p.RPCGoodByeTopicV1)] = leakybucket.NewCollector(1, 1, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	set(newCollector(RateLimitGroupGoodbye), addEncoding(p2p.RPCGoodByeTopicV1))
 	// MetadataV0 Message
-	topicMap[addEncoding(p2p.RPCMetaDataTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
-	topicMap[addEncoding(p2p.RPCMetaDataTopicV2)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	set(newCollector(RateLimitGroupMetadata), addEncoding(p2p.RPCMetaDataTopicV1))
+	set(newCollector(RateLimitGroupMetadata), addEncoding(p2p.RPCMetaDataTopicV2))
 	// Ping Message
-	topicMap[addEncoding(p2p.RPCPingTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	set(newCollector(RateLimitGroupPing), addEncoding(p2p.RPCPingTopicV1))
 	// Status Message
-	topicMap[addEncoding(p2p.RPCStatusTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	set(newCollector(RateLimitGroupStatus), addEncoding(p2p.RPCStatusTopicV1))
 
-	// Use a single collector for block requests
-	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
-	// Collector for V2
-	blockCollectorV2 := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
+	// Use a single collector for BlocksByRoots and BlockByRange requests, and another one for V2.
+	set(newCollector(RateLimitGroupBlocks), addEncoding(p2p.RPCBlocksByRootTopicV1), addEncoding(p2p.RPCBlocksByRangeTopicV1))
+	set(newCollector(RateLimitGroupBlocks), addEncoding(p2p.RPCBlocksByRootTopicV2), addEncoding(p2p.RPCBlocksByRangeTopicV2))
 
 	// for BlobSidecarsByRoot and BlobSidecarsByRange
-	blobCollector := leakybucket.NewCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod, false)
-
-	// BlocksByRoots requests
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV1)] = blockCollector
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV2)] = blockCollectorV2
-
-	// BlockByRange requests
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV1)] = blockCollector
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockCollectorV2
-
-	// BlobSidecarsByRootV1
-	topicMap[addEncoding(p2p.RPCBlobSidecarsByRootTopicV1)] = blobCollector
-	// BlobSidecarsByRangeV1
-	topicMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = blobCollector
+	set(newCollector(RateLimitGroupBlobs), addEncoding(p2p.RPCBlobSidecarsByRootTopicV1), addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1))
 
 	// General topic for all rpc requests.
-	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	set(newCollector(RateLimitGroupRequests), rpcLimiterTopic)
+	return topicMap
+}
 
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+// newOutboundCollector creates a collector leaking the given number of bytes per second.
+func newOutboundCollector(bytesPerSecond uint64) *leakybucket.Collector {
+	return leakybucket.NewCollector(float64(bytesPerSecond), int64(bytesPerSecond*outboundBurstSeconds), leakyBucketPeriod, false /* deleteEmptyBuckets */)
 }
 
 // Returns the current topic collector for the provided topic.
@@ -92,26 +170,33 @@ func (l *limiter) topicCollector(topic string) (*leakybucket.Collector, error) {
 	return l.retrieveCollector(topic)
 }
 
+// Returns the collector of the provided topic which applies to the peer.
+func (l *limiter) peerCollector(topic string, pid peer.ID) (*leakybucket.Collector, error) {
+	l.RLock()
+	defer l.RUnlock()
+	return l.retrievePeerCollector(topic, pid)
+}
+
 // validates a request with the accompanying cost.
 func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	l.RLock()
 	defer l.RUnlock()
 
 	topic := string(stream.Protocol())
+	pid := stream.Conn().RemotePeer()
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrievePeerCollector(topic, pid)
 	if err != nil {
 		return err
 	}
-	key := stream.Conn().RemotePeer().String()
+	key := pid.String()
 	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
 	if amt == 0 {
 		amt = 1
 	}
 	if amt > uint64(remaining) {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
-		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
+		l.rejectRequest(stream, topic, collector, int64(amt)) // lint:ignore uintcast -- Request costs are bounded by the request limits.
 		return p2ptypes.ErrRateLimited
 	}
 	return nil
@@ -123,38 +208,51 @@ func (l *limiter) validateRawRpcRequest(stream network.Stream) error {
 	defer l.RUnlock()
 
 	topic := rpcLimiterTopic
+	pid := stream.Conn().RemotePeer()
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrievePeerCollector(topic, pid)
 	if err != nil {
 		return err
 	}
-	key := stream.Conn().RemotePeer().String()
+	key := pid.String()
 	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
 	amt := int64(1)
 	if amt > remaining {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
-		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
+		l.rejectRequest(stream, topic, collector, amt)
 		return p2ptypes.ErrRateLimited
 	}
 	return nil
 }
 
+// rejectRequest responds to a rate limited request, telling the peer when it may retry. Untrusted
+// peers are penalized for exceeding their quota.
+func (l *limiter) rejectRequest(stream network.Stream, topic string, collector *leakybucket.Collector, amt int64) {
+	pid := stream.Conn().RemotePeer()
+	l.recordHit(pid, topic, 0, true)
+	if !l.p2p.Peers().IsTrustedPeers(pid) {
+		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+	}
+	msg := p2ptypes.ErrorMessageWithRetryAfter(p2ptypes.ErrRateLimited, retryAfter(collector, pid.String(), amt))
+	writeErrorResponseToStream(responseCodeInvalidRequest, msg, stream, l.p2p)
+}
+
 // adds the cost to our leaky bucket for the topic.
 func (l *limiter) add(stream network.Stream, amt int64) {
 	l.Lock()
 	defer l.Unlock()
 
 	topic := string(stream.Protocol())
+	pid := stream.Conn().RemotePeer()
 	log := l.topicLogger(topic)
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrievePeerCollector(topic, pid)
 	if err != nil {
 		log.Errorf("collector with topic '%s' does not exist", topic)
 		return
 	}
-	key := stream.Conn().RemotePeer().String()
-	collector.Add(key, amt)
+	collector.Add(pid.String(), amt)
+	l.recordHit(pid, topic, amt, false)
 }
 
 // adds the cost to our leaky bucket for the peer.
@@ -163,15 +261,167 @@ func (l *limiter) addRawStream(stream network.Stream) {
 	defer l.Unlock()
 
 	topic := rpcLimiterTopic
+	pid := stream.Conn().RemotePeer()
 	log := l.topicLogger(topic)
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrievePeerCollector(topic, pid)
 	if err != nil {
 		log.Errorf("collector with topic '%s' does not exist", topic)
 		return
 	}
-	key := stream.Conn().RemotePeer().String()
-	collector.Add(key, 1)
+	collector.Add(pid.String(), 1)
+	l.recordHit(pid, topic, 1, false)
+}
+
+// recordHit counts the cost of an accepted request, or a rate limited request, of the peer.
+func (l *limiter) recordHit(pid peer.ID, topic string, amt int64, rateLimited bool) {
+	l.hitsLock.Lock()
+	defer l.hitsLock.Unlock()
+
+	topics, ok := l.hits[pid]
+	if !ok {
+		if len(l.hits) >= rateLimitHitsPruneThreshold {
+			l.pruneHits()
+		}
+		topics = make(map[string]*rateLimitHits)
+		l.hits[pid] = topics
+	}
+	hits, ok := topics[topic]
+	if !ok {
+		hits = &rateLimitHits{}
+		topics[topic] = hits
+	}
+	if rateLimited {
+		hits.rateLimited++
+		hits.lastRateLimited = time.Now()
+		return
+	}
+	if amt > 0 {
+		hits.requests += uint64(amt)
+	}
+}
+
+// pruneHits removes the request counters of the peers which are not connected.
+// The caller must hold the hits lock.
+func (l *limiter) pruneHits() {
+	for pid := range l.hits {
+		if !l.p2p.Peers().IsActive(pid) {
+			delete(l.hits, pid)
+		}
+	}
+}
+
+// RateLimits returns the usage of the rate limit quotas by every peer which made requests.
+func (l *limiter) RateLimits() []*PeerRateLimits {
+	l.hitsLock.Lock()
+	pids := make([]peer.ID, 0, len(l.hits))
+	for pid := range l.hits {
+		pids = append(pids, pid)
+	}
+	l.hitsLock.Unlock()
+
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	usages := make([]*PeerRateLimits, 0, len(pids))
+	for _, pid := range pids {
+		if usage, ok := l.PeerRateLimits(pid); ok {
+			usages = append(usages, usage)
+		}
+	}
+	return usages
+}
+
+// PeerRateLimits returns the usage of the rate limit quotas by the peer, if it made any request.
+func (l *limiter) PeerRateLimits(pid peer.ID) (*PeerRateLimits, bool) {
+	l.hitsLock.Lock()
+	topics, ok := l.hits[pid]
+	if !ok {
+		l.hitsLock.Unlock()
+		return nil, false
+	}
+	usage := &PeerRateLimits{
+		PeerID:  pid,
+		Trusted: l.p2p.Peers().IsTrustedPeers(pid),
+		Usage:   make([]*RateLimitUsage, 0, len(topics)),
+	}
+	for topic, hits := range topics {
+		usage.Usage = append(usage.Usage, &RateLimitUsage{
+			Topic:           topic,
+			Requests:        hits.requests,
+			RateLimited:     hits.rateLimited,
+			LastRateLimited: hits.lastRateLimited,
+		})
+	}
+	l.hitsLock.Unlock()
+
+	l.RLock()
+	defer l.RUnlock()
+	for _, u := range usage.Usage {
+		if collector, err := l.retrievePeerCollector(u.Topic, pid); err == nil {
+			u.Remaining = collector.Remaining(pid.String())
+			u.Capacity = collector.Capacity()
+		}
+	}
+	sort.Slice(usage.Usage, func(i, j int) bool { return usage.Usage[i].Topic < usage.Usage[j].Topic })
+	return usage, true
+}
+
+// retryAfter returns how long it takes for the collector to allow amt more for the key.
+func retryAfter(collector *leakybucket.Collector, key string, amt int64) time.Duration {
+	// Such a request is never allowed, the best is to wait for the bucket to be empty.
+	if amt > collector.Capacity() {
+		return collector.TillEmpty(key)
+	}
+	missing := amt - collector.Remaining(key)
+	if missing <= 0 || collector.Rate() <= 0 {
+		return 0
+	}
+	return time.Duration(float64(missing) / collector.Rate() * float64(collector.Period()))
+}
+
+// waitForOutboundCapacity blocks until the outbound byte cap of the stream's topic allows sending amt more bytes,
+// and accounts them. It returns immediately when the topic has no outbound cap.
+func (l *limiter) waitForOutboundCapacity(ctx context.Context, stream network.Stream, amt int64) error {
+	topic := string(stream.Protocol())
+	l.RLock()
+	collector, ok := l.outboundMap[topic]
+	l.RUnlock()
+	if !ok {
+		return nil
+	}
+	// A chunk larger than the burst capacity is sent once the bucket is empty.
+	if amt > collector.Capacity() {
+		amt = collector.Capacity()
+	}
+	var throttledSince time.Time
+	for {
+		wait, ok := l.takeOutboundCapacity(collector, amt)
+		if ok {
+			if !throttledSince.IsZero() {
+				rpcOutboundThrottleSeconds.WithLabelValues(topic).Add(time.Since(throttledSince).Seconds())
+			}
+			return nil
+		}
+		if throttledSince.IsZero() {
+			throttledSince = time.Now()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// takeOutboundCapacity accounts amt bytes in the outbound collector if it has the capacity for them, or
+// else returns the delay after which it will.
+func (l *limiter) takeOutboundCapacity(collector *leakybucket.Collector, amt int64) (time.Duration, bool) {
+	l.outboundLock.Lock()
+	defer l.outboundLock.Unlock()
+	if collector.Remaining(outboundBytesKey) >= amt {
+		collector.Add(outboundBytesKey, amt)
+		return 0, true
+	}
+	return retryAfter(collector, outboundBytesKey, amt), false
 }
 
 // frees all the collectors and removes them.
@@ -194,6 +444,26 @@ func (l *limiter) free() {
 		delete(l.limiterMap, t)
 		tempMap[ptr] = true
 	}
+	for t, collector := range l.trustedMap {
+		ptr := reflect.ValueOf(collector).Pointer()
+		if !tempMap[ptr] {
+			collector.Free()
+			tempMap[ptr] = true
+		}
+		delete(l.trustedMap, t)
+	}
+	for t, collector := range l.outboundMap {
+		ptr := reflect.ValueOf(collector).Pointer()
+		if !tempMap[ptr] {
+			collector.Free()
+			tempMap[ptr] = true
+		}
+		delete(l.outboundMap, t)
+	}
+
+	l.hitsLock.Lock()
+	defer l.hitsLock.Unlock()
+	l.hits = make(map[peer.ID]map[string]*rateLimitHits)
 }
 
 // not to be used outside the rate limiter file as it is unsafe for concurrent usage
@@ -209,6 +479,15 @@ func (l *limiter) retrieveCollector(topic string) (*leakybucket.Collector, error
 	return collector, nil
 }
 
+// retrievePeerCollector returns the collector of the topic for trusted peers when there
+// is one, and the collector for all peers otherwise. Same locking rules as retrieveCollector.
+func (l *limiter) retrievePeerCollector(topic string, pid peer.ID) (*leakybucket.Collector, error) {
+	if collector, ok := l.trustedMap[topic]; ok && l.p2p.Peers().IsTrustedPeers(pid) {
+		return collector, nil
+	}
+	return l.retrieveCollector(topic)
+}
+
 func (_ *limiter) topicLogger(topic string) *logrus.Entry {
 	return log.WithField("rateLimiter", topic)
 }
@@ -2,15 +2,19 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -79,7 +83,10 @@ func TestRateLimiter_ExceedRawCapacity(t *testing.T) {
 		code, errMsg, err := readStatusCodeNoDeadline(stream, p2.Encoding())
 		require.NoError(t, err, "could not read incoming stream")
 		assert.Equal(t, responseCodeInvalidRequest, code, "not equal response codes")
-		assert.Equal(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
+		assert.StringContains(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
+		retryAfter, ok := p2ptypes.RetryAfter(errors.New(errMsg))
+		assert.Equal(t, true, ok, "no retry hint in %q", errMsg)
+		assert.Equal(t, true, retryAfter > 0 && retryAfter <= leakyBucketPeriod, "unexpected retry hint %s", retryAfter)
 	})
 	wg.Add(1)
 	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
@@ -110,3 +117,145 @@ func Test_limiter_retrieveCollector_requiresLock(t *testing.T) {
 	_, err := l.retrieveCollector("")
 	require.ErrorContains(t, "caller must hold read/write lock", err)
 }
+
+func TestRateLimiter_WaitForOutboundCapacity(t *testing.T) {
+	resetFlags := flags.Get()
+	gFlags := *resetFlags
+	gFlags.BlocksByRangeOutboundBytesLimit = 1000
+	flags.Init(&gFlags)
+	defer flags.Init(resetFlags)
+
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	rlimiter := newRateLimiter(p1)
+	assert.Equal(t, 2, len(rlimiter.outboundMap), "outbound caps should only be set for blocks by range")
+
+	topic := p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix()
+	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, stream.Close())
+	}()
+
+	// The burst capacity is available right away.
+	start := time.Now()
+	require.NoError(t, rlimiter.waitForOutboundCapacity(context.Background(), stream, 2000))
+	assert.Equal(t, true, time.Since(start) < 100*time.Millisecond)
+
+	// Further bytes have to wait for the bucket to leak.
+	start = time.Now()
+	require.NoError(t, rlimiter.waitForOutboundCapacity(context.Background(), stream, 300))
+	assert.Equal(t, true, time.Since(start) >= 250*time.Millisecond, "outbound bytes were not throttled")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, rlimiter.waitForOutboundCapacity(ctx, stream, 2000), context.Canceled)
+
+	rlimiter.free()
+	assert.Equal(t, 0, len(rlimiter.outboundMap), "outbound collectors not freed correctly")
+}
+
+func TestRateLimiter_WaitForOutboundCapacity_Concurrent(t *testing.T) {
+	resetFlags := flags.Get()
+	gFlags := *resetFlags
+	gFlags.BlocksByRangeOutboundBytesLimit = 1000
+	flags.Init(&gFlags)
+	defer flags.Init(resetFlags)
+
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	rlimiter := newRateLimiter(p1)
+
+	topic := p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix()
+	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, stream.Close())
+	}()
+
+	// Only the burst capacity is sent right away, however many streams race for it.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var sent atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if rlimiter.waitForOutboundCapacity(ctx, stream, 500) == nil {
+				sent.Add(500)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(2000), sent.Load())
+}
+
+func TestRateLimiter_TrustedPeers(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p3 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Connect(p3)
+	p1.Peers().Add(nil, p2.PeerID(), p2.BHost.Addrs()[0], network.DirOutbound)
+	p1.Peers().Add(nil, p3.PeerID(), p3.BHost.Addrs()[0], network.DirOutbound)
+	p1.Peers().SetTrustedPeers([]peer.ID{p3.PeerID()})
+
+	rlimiter := newConfiguredRateLimiter(p1, &RateLimitsConfig{
+		Quotas: map[string]*RateLimitQuota{
+			RateLimitGroupStatus: {Rate: 1, Burst: 2},
+		},
+		Trusted: map[string]*RateLimitQuota{
+			RateLimitGroupStatus: {Rate: 1, Burst: 4},
+		},
+	})
+	topic := p2p.RPCStatusTopicV1 + p1.Encoding().ProtocolSuffix()
+	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	p3.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {})
+	untrusted, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
+	require.NoError(t, err)
+	trusted, err := p1.BHost.NewStream(context.Background(), p3.PeerID(), protocol.ID(topic))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, rlimiter.validateRequest(untrusted, 1))
+		rlimiter.add(untrusted, 1)
+	}
+	require.ErrorIs(t, rlimiter.validateRequest(untrusted, 1), p2ptypes.ErrRateLimited)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, rlimiter.validateRequest(trusted, 1))
+		rlimiter.add(trusted, 1)
+	}
+	require.ErrorIs(t, rlimiter.validateRequest(trusted, 1), p2ptypes.ErrRateLimited)
+	count, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "untrusted peer should be penalized")
+	count, err = p1.Peers().Scorers().BadResponsesScorer().Count(p3.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count, "trusted peer should not be penalized")
+
+	usage, ok := rlimiter.PeerRateLimits(p2.PeerID())
+	require.Equal(t, true, ok)
+	assert.Equal(t, false, usage.Trusted)
+	require.Equal(t, 1, len(usage.Usage))
+	assert.Equal(t, topic, usage.Usage[0].Topic)
+	assert.Equal(t, uint64(2), usage.Usage[0].Requests)
+	assert.Equal(t, uint64(1), usage.Usage[0].RateLimited)
+	assert.Equal(t, false, usage.Usage[0].LastRateLimited.IsZero())
+	assert.Equal(t, int64(2), usage.Usage[0].Capacity)
+
+	usage, ok = rlimiter.PeerRateLimits(p3.PeerID())
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, usage.Trusted)
+	assert.Equal(t, uint64(4), usage.Usage[0].Requests)
+	assert.Equal(t, int64(4), usage.Usage[0].Capacity)
+	assert.Equal(t, 2, len(rlimiter.RateLimits()))
+
+	rlimiter.free()
+	assert.Equal(t, 0, len(rlimiter.trustedMap), "trusted collectors not freed correctly")
+	assert.Equal(t, 0, len(rlimiter.RateLimits()))
+}
@@ -0,0 +1,140 @@
+package sync
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"gopkg.in/yaml.v2"
+)
+
+// Rate limit quota groups, each applying to a set of req/resp topics.
+const (
+	RateLimitGroupGoodbye  = "goodbye"
+	RateLimitGroupMetadata = "metadata"
+	RateLimitGroupPing     = "ping"
+	RateLimitGroupStatus   = "status"
+	RateLimitGroupBlocks   = "blocks"
+	RateLimitGroupBlobs    = "blobs"
+	// RateLimitGroupRequests limits the number of incoming requests, regardless of their topic.
+	RateLimitGroupRequests = "requests"
+)
+
+// RateLimitQuota allows a peer to make requests costing up to Burst at once, replenished at
+// Rate per Period. The cost of block and blob requests is the number of requested items.
+type RateLimitQuota struct {
+	Rate   float64       `yaml:"rate"`
+	Burst  int64         `yaml:"burst"`
+	Period time.Duration `yaml:"period"`
+}
+
+// RateLimitsConfig is the content of the req/resp rate limits file. Quotas are keyed by quota group
+// and override the default quota of their group. Trusted quotas apply to trusted peers only, which
+// otherwise have the same quotas as any other peer.
+//
+// Example:
+//
+//	quotas:
+//	  blocks: {rate: 64, burst: 640, period: 30s}
+//	trusted:
+//	  blocks: {rate: 512, burst: 5120, period: 30s}
+type RateLimitsConfig struct {
+	Quotas  map[string]*RateLimitQuota `yaml:"quotas"`
+	Trusted map[string]*RateLimitQuota `yaml:"trusted"`
+}
+
+// defaultRateLimitQuotas returns the quotas applied when no rate limits file is provided.
+func defaultRateLimitQuotas() map[string]*RateLimitQuota {
+	return map[string]*RateLimitQuota{
+		RateLimitGroupGoodbye:  {Rate: 1, Burst: 1, Period: leakyBucketPeriod},
+		RateLimitGroupMetadata: {Rate: 1, Burst: defaultBurstLimit, Period: leakyBucketPeriod},
+		RateLimitGroupPing:     {Rate: 1, Burst: defaultBurstLimit, Period: leakyBucketPeriod},
+		RateLimitGroupStatus:   {Rate: 1, Burst: defaultBurstLimit, Period: leakyBucketPeriod},
+		RateLimitGroupBlocks: {
+			Rate:   float64(flags.Get().BlockBatchLimit),
+			Burst:  int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit),
+			Period: blockBucketPeriod,
+		},
+		RateLimitGroupBlobs: {
+			Rate:   float64(flags.Get().BlobBatchLimit),
+			Burst:  int64(flags.Get().BlobBatchLimitBurstFactor * flags.Get().BlobBatchLimit),
+			Period: blockBucketPeriod,
+		},
+		RateLimitGroupRequests: {Rate: 5, Burst: defaultBurstLimit * 2, Period: leakyBucketPeriod},
+	}
+}
+
+// LoadRateLimitsConfig reads and validates a req/resp rate limits file.
+func LoadRateLimitsConfig(path string) (*RateLimitsConfig, error) {
+	enc, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read rate limits file %s", path)
+	}
+	cfg := &RateLimitsConfig{}
+	if err := yaml.UnmarshalStrict(enc, cfg); err != nil {
+		return nil, errors.Wrapf(err, "could not decode rate limits file %s", path)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid rate limits file %s", path)
+	}
+	return cfg, nil
+}
+
+func (c *RateLimitsConfig) validate() error {
+	defaults := defaultRateLimitQuotas()
+	for _, quotas := range []map[string]*RateLimitQuota{c.Quotas, c.Trusted} {
+		for group, quota := range quotas {
+			if _, ok := defaults[group]; !ok {
+				return errors.Errorf("unknown quota group %q, expected one of %s", group, strings.Join(rateLimitGroups(), ", "))
+			}
+			if quota == nil || quota.Rate <= 0 || quota.Burst <= 0 {
+				return errors.Errorf("quota of group %q must have a positive rate and burst", group)
+			}
+			if quota.Period < 0 {
+				return errors.Errorf("quota of group %q has a negative period", group)
+			}
+		}
+	}
+	return nil
+}
+
+// quotas returns the quotas of all groups for regular peers. Trusted peers only have the quotas
+// explicitly configured for them.
+func (c *RateLimitsConfig) quotas() (regular map[string]*RateLimitQuota, trusted map[string]*RateLimitQuota) {
+	defaults := defaultRateLimitQuotas()
+	regular = defaults
+	trusted = make(map[string]*RateLimitQuota)
+	if c == nil {
+		return regular, trusted
+	}
+	withDefaultPeriod := func(group string, q *RateLimitQuota) *RateLimitQuota {
+		quota := *q
+		if quota.Period == 0 {
+			quota.Period = defaults[group].Period
+		}
+		return &quota
+	}
+	regular = make(map[string]*RateLimitQuota, len(defaults))
+	for group, q := range defaults {
+		if configured, ok := c.Quotas[group]; ok {
+			q = withDefaultPeriod(group, configured)
+		}
+		regular[group] = q
+	}
+	for group, q := range c.Trusted {
+		trusted[group] = withDefaultPeriod(group, q)
+	}
+	return regular, trusted
+}
+
+func rateLimitGroups() []string {
+	groups := make([]string, 0, len(defaultRateLimitQuotas()))
+	for group := range defaultRateLimitQuotas() {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestLoadRateLimitsConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			content: `quotas:
+  blocks: {rate: 128, burst: 1024}
+  requests: {rate: 10, burst: 20, period: 2s}
+trusted:
+  blocks: {rate: 512, burst: 4096, period: 1m}
+`,
+		},
+		{
+			name:    "unknown group",
+			content: "quotas:\n  light_client_bootstrap: {rate: 1, burst: 1}\n",
+			wantErr: `unknown quota group "light_client_bootstrap"`,
+		},
+		{
+			name:    "missing burst",
+			content: "trusted:\n  blobs: {rate: 1}\n",
+			wantErr: `quota of group "blobs" must have a positive rate and burst`,
+		},
+		{
+			name:    "negative period",
+			content: "quotas:\n  ping: {rate: 1, burst: 1, period: -1s}\n",
+			wantErr: `quota of group "ping" has a negative period`,
+		},
+		{
+			name:    "unknown field",
+			content: "quota:\n  ping: {rate: 1, burst: 1}\n",
+			wantErr: "could not decode rate limits file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rate-limits.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+			cfg, err := LoadRateLimitsConfig(path)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+
+			quotas, trusted := cfg.quotas()
+			assert.Equal(t, len(defaultRateLimitQuotas()), len(quotas))
+			assert.DeepEqual(t, &RateLimitQuota{Rate: 128, Burst: 1024, Period: blockBucketPeriod}, quotas[RateLimitGroupBlocks], "missing period should default to the period of the group")
+			assert.DeepEqual(t, &RateLimitQuota{Rate: 10, Burst: 20, Period: 2 * time.Second}, quotas[RateLimitGroupRequests])
+			assert.DeepEqual(t, defaultRateLimitQuotas()[RateLimitGroupBlobs], quotas[RateLimitGroupBlobs])
+			require.Equal(t, 1, len(trusted))
+			assert.DeepEqual(t, &RateLimitQuota{Rate: 512, Burst: 4096, Period: time.Minute}, trusted[RateLimitGroupBlocks])
+		})
+	}
+	_, err := LoadRateLimitsConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, "could not read rate limits file", err)
+}
+
+func TestRateLimitsConfig_DefaultQuotas(t *testing.T) {
+	var cfg *RateLimitsConfig
+	quotas, trusted := cfg.quotas()
+	assert.DeepEqual(t, defaultRateLimitQuotas(), quotas)
+	assert.Equal(t, 0, len(trusted))
+	assert.DeepEqual(t, &RateLimitQuota{Rate: 64, Burst: 640, Period: blockBucketPeriod}, quotas[RateLimitGroupBlocks])
+}
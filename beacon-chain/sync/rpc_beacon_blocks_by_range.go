@@ -41,12 +41,14 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 	available := s.validateRangeAvailability(rp)
 	if !available {
 		log.Debug("error in validating range availability")
-		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+		// The range may become available as blocks are backfilled, so the peer may retry in an epoch.
+		retryAfter := time.Duration(params.BeaconConfig().SlotsPerEpoch) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrorMessageWithRetryAfter(p2ptypes.ErrResourceUnavailable, retryAfter), stream)
 		tracing.AnnotateError(span, err)
 		return nil
 	}
 
-	blockLimiter, err := s.rateLimiter.topicCollector(string(stream.Protocol()))
+	blockLimiter, err := s.rateLimiter.peerCollector(string(stream.Protocol()), stream.Conn().RemotePeer())
 	if err != nil {
 		return err
 	}
@@ -151,6 +153,9 @@ func (s *Service) writeBlockBatchToStream(ctx context.Context, batch blockBatch,
 			blinded = append(blinded, b.ReadOnlySignedBeaconBlock)
 			continue
 		}
+		if err := s.rateLimiter.waitForOutboundCapacity(ctx, stream, int64(b.SizeSSZ())); err != nil {
+			return err
+		}
 		if chunkErr := s.chunkBlockWriter(stream, b); chunkErr != nil {
 			log.WithError(chunkErr).Debug("Could not send a chunked response")
 			return chunkErr
@@ -172,6 +177,9 @@ func (s *Service) writeBlockBatchToStream(ctx context.Context, batch blockBatch,
 		if b.IsBlinded() {
 			continue
 		}
+		if err := s.rateLimiter.waitForOutboundCapacity(ctx, stream, int64(b.SizeSSZ())); err != nil {
+			return err
+		}
 		if chunkErr := s.chunkBlockWriter(stream, b); chunkErr != nil {
 			log.WithError(chunkErr).Debug("Could not send a chunked response")
 			return chunkErr
@@ -99,6 +99,37 @@ func TestRPCBeaconBlocksByRange_RPCHandlerReturnsBlocks(t *testing.T) {
 	}
 }
 
+func TestRPCBeaconBlocksByRange_ResourceUnavailable(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	req := &ethpb.BeaconBlocksByRangeRequest{StartSlot: 100, Step: 1, Count: 10}
+	clock := startup.NewClock(time.Unix(0, 0), [32]byte{})
+	r := &Service{cfg: &config{p2p: p1, beaconDB: db.SetupDB(t), clock: clock, chain: &chainMock.ChainService{}}, availableBlocker: mockBlocker{avail: false}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		code, errMsg, err := ReadStatusCode(stream, r.cfg.p2p.Encoding())
+		require.NoError(t, err)
+		require.Equal(t, responseCodeResourceUnavailable, code)
+		retryAfter, ok := p2ptypes.RetryAfter(errors.New(errMsg))
+		require.Equal(t, true, ok)
+		epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+		require.Equal(t, epochDuration, retryAfter)
+	})
+
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
 func TestRPCBeaconBlocksByRange_ReturnCorrectNumberBack(t *testing.T) {
 	p1 := p2ptest.NewTestP2P(t)
 	p2 := p2ptest.NewTestP2P(t)
@@ -24,7 +24,7 @@ func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota
 	if wQuota == 0 {
 		return 0, nil
 	}
-	_, span := trace.StartSpan(ctx, "sync.streamBlobBatch")
+	ctx, span := trace.StartSpan(ctx, "sync.streamBlobBatch")
 	defer span.End()
 	for _, b := range batch.canonical() {
 		root := b.Root()
@@ -44,6 +44,11 @@ func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota
 				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
 				return wQuota, errors.Wrapf(err, "could not retrieve sidecar: index %d, block root %#x", i, root)
 			}
+			if err := s.rateLimiter.waitForOutboundCapacity(ctx, stream, int64(sc.SizeSSZ())); err != nil {
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, err)
+				return wQuota, err
+			}
 			SetStreamWriteDeadline(stream, defaultWriteDuration)
 			if chunkErr := WriteBlobSidecarChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), sc); chunkErr != nil {
 				log.WithError(chunkErr).Debug("Could not send a chunked response")
@@ -96,6 +96,7 @@ type config struct {
 	clock                         *startup.Clock
 	stateNotifier                 statefeed.Notifier
 	blobStorage                   *filesystem.BlobStorage
+	rateLimits                    *RateLimitsConfig
 }
 
 // This defines the interface for interacting with block chain service
@@ -202,7 +203,7 @@ func NewService(ctx context.Context, opts ...Option) *Service {
 		}
 	})
 	r.subHandler = newSubTopicHandler()
-	r.rateLimiter = newRateLimiter(r.cfg.p2p)
+	r.rateLimiter = newConfiguredRateLimiter(r.cfg.p2p, r.cfg.rateLimits)
 	r.initCaches()
 
 	return r
@@ -339,6 +340,16 @@ func (s *Service) writeErrorResponseToStream(responseCode byte, reason string, s
 	writeErrorResponseToStream(responseCode, reason, stream, s.cfg.p2p)
 }
 
+// RateLimits returns the usage of the req/resp rate limit quotas by every peer which made requests.
+func (s *Service) RateLimits() []*PeerRateLimits {
+	return s.rateLimiter.RateLimits()
+}
+
+// PeerRateLimits returns the usage of the req/resp rate limit quotas by the peer, if it made any request.
+func (s *Service) PeerRateLimits(pid peer.ID) (*PeerRateLimits, bool) {
+	return s.rateLimiter.PeerRateLimits(pid)
+}
+
 func (s *Service) setRateCollector(topic string, c *leakybucket.Collector) {
 	s.rateLimiter.limiterMap[topic] = c
 }
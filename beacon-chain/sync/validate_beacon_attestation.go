@@ -101,7 +101,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return result, wrappedErr
 	}
 
-	if !features.Get().EnableSlasher {
+	if !features.Get().EnableSlasher && !features.Get().EnableSlasherEventStream {
 		// Verify this the first attestation received for the participating validator for the slot.
 		if s.hasSeenCommitteeIndicesSlot(data.Slot, committeeIndex, att.GetAggregationBits()) {
 			return pubsub.ValidationIgnore, nil
@@ -164,7 +164,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return validationRes, err
 	}
 
-	if features.Get().EnableSlasher {
+	if features.Get().EnableSlasher || features.Get().EnableSlasherEventStream {
 		// Feed the indexed attestation to slasher if enabled. This action
 		// is done in the background to avoid adding more load to this critical code path.
 		go func() {
@@ -81,8 +81,9 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		},
 	})
 
-	if features.Get().EnableSlasher {
-		// Feed the block header to slasher if enabled. This action
+	if features.Get().EnableSlasher || features.Get().EnableSlasherEventStream {
+		// Feed the block header to slasher if enabled, locally or through the event stream. The header is fed
+		// before the block is ignored as a duplicate, so that double proposals reach the slasher. This action
 		// is done in the background to avoid adding more load to this critical code path.
 		go func() {
 			blockHeader, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
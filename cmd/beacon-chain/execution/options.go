@@ -31,6 +31,9 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
 	}
+	if secondaries := c.StringSlice(flags.ExecutionEngineSecondaryEndpoints.Name); len(secondaries) > 0 {
+		opts = append(opts, execution.WithSecondaryHttpEndpoints(secondaries, jwtSecret))
+	}
 	return opts, nil
 }
 
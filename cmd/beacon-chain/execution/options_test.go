@@ -99,3 +99,17 @@ func TestPowchainPreregistration_EmptyWeb3Provider(t *testing.T) {
 	_, err := parseExecutionChainEndpoint(ctx)
 	assert.ErrorContains(t, "you need to specify", err)
 }
+
+func TestFlagOptions_SecondaryEndpoints(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "http://primary:8551", "")
+	secondaries := cli.NewStringSlice("http://secondary1:8551", "http://secondary2:8551")
+	set.Var(secondaries, flags.ExecutionEngineSecondaryEndpoints.Name, "")
+	ctx := cli.NewContext(&app, set, nil)
+
+	opts, err := FlagOptions(ctx)
+	require.NoError(t, err)
+	// Endpoint, header request limit, headers and secondary endpoints.
+	assert.Equal(t, 4, len(opts))
+}
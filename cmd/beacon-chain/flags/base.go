@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/urfave/cli/v2"
@@ -11,10 +13,24 @@ import (
 var (
 	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
 	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Several relays can be given as a comma separated list, in which case the highest bid is used.",
 		Value: "",
 	}
+	// MevRelayTimeout is the time each MEV builder relay has to return a bid.
+	MevRelayTimeout = &cli.DurationFlag{
+		Name:  "http-mev-relay-timeout",
+		Usage: "The time each MEV builder relay has to return a bid. Relays answering late are ignored.",
+		Value: 950 * time.Millisecond,
+	}
+	// MevRelayBlacklistPeriod is how long a misbehaving MEV builder relay is not used.
+	MevRelayBlacklistPeriod = &cli.DurationFlag{
+		Name: "http-mev-relay-blacklist-period",
+		Usage: "How long a relay is not used after it failed to reveal the payload of a signed blinded block or offered an invalid bid. " +
+			"Blocks are built locally while all the relays are blacklisted. 0 never blacklists relays.",
+		Value: time.Hour,
+	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
 		Usage: "Number of consecutive skip slot to fallback from using relay/builder to local execution engine for block construction",
@@ -31,6 +47,14 @@ var (
 			"Boost is an additional percentage to multiple local block value. Use builder block if: builder_bid_value * 100 > local_block_value * (local-block-value-boost + 100)",
 		Value: 10,
 	}
+	// PayloadDecisionHistoryEpochs sets the number of epochs for which the payload decisions of the
+	// proposals are kept.
+	PayloadDecisionHistoryEpochs = &cli.Uint64Flag{
+		Name: "payload-decision-history-epochs",
+		Usage: "Number of epochs before the finalized epoch for which the builder or local payload decisions of the proposals " +
+			"of this node are kept in the database, and served by /prysm/v1/validator/proposals/{slot}/payload_decision.",
+		Value: 4096,
+	}
 	// MinBuilderBid sets an absolute value for the builder bid that this
 	// node will accept without reverting to local building
 	MinBuilderBid = &cli.Uint64Flag{
@@ -54,6 +78,13 @@ var (
 		Usage: "An execution client http endpoint. Can contain auth header as well in the format",
 		Value: "http://localhost:8551",
 	}
+	// ExecutionEngineSecondaryEndpoints defines additional execution client http endpoints. They take over when the
+	// primary endpoint is unreachable and receive a copy of every newPayload call to cross-check payload statuses.
+	ExecutionEngineSecondaryEndpoints = &cli.StringSliceFlag{
+		Name: "execution-endpoint-secondary",
+		Usage: "Additional execution client http endpoints used for failover and to verify payload statuses returned " +
+			"by the primary endpoint. They must share the JWT secret and headers of the primary endpoint.",
+	}
 	// ExecutionEngineHeaders defines a list of HTTP headers to send with all execution client requests.
 	ExecutionEngineHeaders = &cli.StringFlag{
 		Name: "execution-headers",
@@ -191,6 +222,24 @@ var (
 		Usage: "The factor by which blob batch limit may increase on burst.",
 		Value: 2,
 	}
+	// BlocksByRangeOutboundBytesLimit caps the bandwidth used to serve blocks by range requests.
+	BlocksByRangeOutboundBytesLimit = &cli.Uint64Flag{
+		Name: "blocks-by-range-outbound-bytes-limit",
+		Usage: "The maximum number of bytes per second of blocks served to peers in response to BeaconBlocksByRange " +
+			"requests, across all peers. Responses are slowed down to stay under the limit. 0 disables the limit.",
+	}
+	// BlobSidecarsByRangeOutboundBytesLimit caps the bandwidth used to serve blob sidecars by range requests.
+	BlobSidecarsByRangeOutboundBytesLimit = &cli.Uint64Flag{
+		Name: "blob-sidecars-by-range-outbound-bytes-limit",
+		Usage: "The maximum number of bytes per second of blob sidecars served to peers in response to BlobSidecarsByRange " +
+			"requests, across all peers. Responses are slowed down to stay under the limit. 0 disables the limit.",
+	}
+	// ReqRespRateLimitsFile defines the file holding the quotas of the requests made by peers.
+	ReqRespRateLimitsFile = &cli.StringFlag{
+		Name: "req-resp-rate-limits-file",
+		Usage: "Path to a YAML file setting the rate limit quotas of the req/resp requests made by peers, per group of topics " +
+			"(goodbye, metadata, ping, status, blocks, blobs, requests), with separate quotas for trusted peers.",
+	}
 	// DisableDebugRPCEndpoints disables the debug Beacon API namespace.
 	DisableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "disable-debug-rpc-endpoints",
@@ -283,4 +332,47 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherRescanFromEpochFlag defines the epoch from which the slasher rescans the blocks of the beacon database on start.
+	SlasherRescanFromEpochFlag = &cli.Uint64Flag{
+		Name: "slasher-rescan-from-epoch",
+		Usage: "Rescans the blocks of the beacon database from the given epoch when the slasher starts, detecting the slashable " +
+			"offenses committed before the slasher was enabled. Regenerating the states of old epochs requires a node run " +
+			"with --historical-slasher-node.",
+	}
+	// ForkChoiceRecordFileFlag defines the file to which the inputs of fork choice are recorded.
+	ForkChoiceRecordFileFlag = &cli.StringFlag{
+		Name: "forkchoice-record-file",
+		Usage: "Records every input received by fork choice to the given file, one JSON event per line, so that " +
+			"its head decisions can be replayed with `prysmctl forkchoice replay`. The file is truncated on start.",
+	}
+	// ReorgWeightThresholdFlag overrides the REORG_WEIGHT_THRESHOLD parameter.
+	ReorgWeightThresholdFlag = &cli.Uint64Flag{
+		Name: "reorg-weight-threshold",
+		Usage: "Percentage of the committee weight below which a late head block is weak enough to be orphaned. " +
+			"Overrides the REORG_WEIGHT_THRESHOLD value of the network configuration.",
+	}
+	// ReorgParentWeightThresholdFlag overrides the REORG_PARENT_WEIGHT_THRESHOLD parameter.
+	ReorgParentWeightThresholdFlag = &cli.Uint64Flag{
+		Name: "reorg-parent-weight-threshold",
+		Usage: "Percentage of the committee weight above which the parent of a late head block is strong enough for the " +
+			"head to be orphaned. Overrides the REORG_PARENT_WEIGHT_THRESHOLD value of the network configuration.",
+	}
+	// ReorgMaxEpochsSinceFinalizationFlag overrides the REORG_MAX_EPOCHS_SINCE_FINALIZATION parameter.
+	ReorgMaxEpochsSinceFinalizationFlag = &cli.Uint64Flag{
+		Name: "reorg-max-epochs-since-finalization",
+		Usage: "Number of epochs since finalization after which late head blocks are no longer orphaned. " +
+			"Overrides the REORG_MAX_EPOCHS_SINCE_FINALIZATION value of the network configuration.",
+	}
+	// ReorgLateBlockCutoffMillisFlag defines the time into its slot after which a head block arrives late.
+	ReorgLateBlockCutoffMillisFlag = &cli.Uint64Flag{
+		Name: "reorg-late-block-cutoff-millis",
+		Usage: "Time into its slot, in milliseconds, after which a head block arrives late and may be orphaned. " +
+			"Defaults to the attestation deadline.",
+	}
+	// ReorgProposerCutoffMillisFlag defines the time into its slot before which a proposer may orphan a late head block.
+	ReorgProposerCutoffMillisFlag = &cli.Uint64Flag{
+		Name: "reorg-proposer-cutoff-millis",
+		Usage: "Time into its slot, in milliseconds, before which a proposer has to propose to orphan a late head block. " +
+			"Defaults to half the attestation deadline (2000 on mainnet).",
+	}
 )
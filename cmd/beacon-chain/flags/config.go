@@ -16,6 +16,9 @@ type GlobalFlags struct {
 	BlockBatchLimitBurstFactor int
 	BlobBatchLimit             int
 	BlobBatchLimitBurstFactor  int
+
+	BlocksByRangeOutboundBytesLimit       uint64
+	BlobSidecarsByRangeOutboundBytesLimit uint64
 }
 
 var globalConfig *GlobalFlags
@@ -45,6 +48,8 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	cfg.BlobBatchLimit = ctx.Int(BlobBatchLimit.Name)
 	cfg.BlobBatchLimitBurstFactor = ctx.Int(BlobBatchLimitBurstFactor.Name)
+	cfg.BlocksByRangeOutboundBytesLimit = ctx.Uint64(BlocksByRangeOutboundBytesLimit.Name)
+	cfg.BlobSidecarsByRangeOutboundBytesLimit = ctx.Uint64(BlobSidecarsByRangeOutboundBytesLimit.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
 	cfg.MaxConcurrentDials = ctx.Int(MaxConcurrentDials.Name)
 	configureMinimumPeers(ctx, cfg)
@@ -42,6 +42,7 @@ import (
 var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.ExecutionEngineEndpoint,
+	flags.ExecutionEngineSecondaryEndpoints,
 	flags.ExecutionEngineHeaders,
 	flags.ExecutionJWTSecretFlag,
 	flags.RPCHost,
@@ -60,6 +61,9 @@ var appFlags = []cli.Flag{
 	flags.BlockBatchLimitBurstFactor,
 	flags.BlobBatchLimit,
 	flags.BlobBatchLimitBurstFactor,
+	flags.BlocksByRangeOutboundBytesLimit,
+	flags.BlobSidecarsByRangeOutboundBytesLimit,
+	flags.ReqRespRateLimitsFile,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
@@ -78,10 +82,13 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.MevRelayEndpoint,
+	flags.MevRelayTimeout,
+	flags.MevRelayBlacklistPeriod,
 	flags.MaxBuilderEpochMissedSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
 	flags.EngineEndpointTimeoutSeconds,
 	flags.LocalBlockValueBoost,
+	flags.PayloadDecisionHistoryEpochs,
 	flags.MinBuilderBid,
 	flags.MinBuilderDiff,
 	cmd.BackupWebhookOutputDir,
@@ -104,7 +111,14 @@ var appFlags = []cli.Flag{
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
+	cmd.P2PInboundIPv4PrefixLimit,
+	cmd.P2PInboundIPv6PrefixLimit,
+	cmd.P2PDisablePeerPersistence,
+	cmd.P2PSentry,
+	cmd.P2PPrivatePeerID,
 	cmd.PubsubQueueSize,
+	cmd.PubsubTraceFile,
+	cmd.PubsubTraceFileMaxSize,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -135,6 +149,7 @@ var appFlags = []cli.Flag{
 	cmd.RestoreSourceFileFlag,
 	cmd.RestoreTargetDirFlag,
 	cmd.ValidatorMonitorIndicesFlag,
+	cmd.ValidatorMonitorHistoryEpochsFlag,
 	cmd.ApiTimeoutFlag,
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
@@ -142,6 +157,13 @@ var appFlags = []cli.Flag{
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
+	flags.SlasherRescanFromEpochFlag,
+	flags.ForkChoiceRecordFileFlag,
+	flags.ReorgWeightThresholdFlag,
+	flags.ReorgParentWeightThresholdFlag,
+	flags.ReorgMaxEpochsSinceFinalizationFlag,
+	flags.ReorgLateBlockCutoffMillisFlag,
+	flags.ReorgProposerCutoffMillisFlag,
 	flags.JwtId,
 	storage.BlobStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
@@ -76,6 +76,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RestoreSourceFileFlag,
 			cmd.RestoreTargetDirFlag,
 			cmd.ValidatorMonitorIndicesFlag,
+			cmd.ValidatorMonitorHistoryEpochsFlag,
 			cmd.ApiTimeoutFlag,
 		},
 	},
@@ -108,6 +109,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
 			flags.ExecutionEngineEndpoint,
+			flags.ExecutionEngineSecondaryEndpoints,
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
@@ -116,6 +118,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
 			flags.BlobBatchLimitBurstFactor,
+			flags.BlocksByRangeOutboundBytesLimit,
+			flags.BlobSidecarsByRangeOutboundBytesLimit,
+			flags.ReqRespRateLimitsFile,
 			flags.DisableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
@@ -126,11 +131,21 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MinPeersPerSubnet,
 			flags.MaxConcurrentDials,
 			flags.MevRelayEndpoint,
+			flags.MevRelayTimeout,
+			flags.MevRelayBlacklistPeriod,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherRescanFromEpochFlag,
+			flags.ForkChoiceRecordFileFlag,
+			flags.ReorgWeightThresholdFlag,
+			flags.ReorgParentWeightThresholdFlag,
+			flags.ReorgMaxEpochsSinceFinalizationFlag,
+			flags.ReorgLateBlockCutoffMillisFlag,
+			flags.ReorgProposerCutoffMillisFlag,
 			flags.LocalBlockValueBoost,
+			flags.PayloadDecisionHistoryEpochs,
 			flags.MinBuilderBid,
 			flags.MinBuilderDiff,
 			flags.JwtId,
@@ -168,7 +183,14 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PInboundIPv4PrefixLimit,
+			cmd.P2PInboundIPv6PrefixLimit,
+			cmd.P2PDisablePeerPersistence,
+			cmd.P2PSentry,
+			cmd.P2PPrivatePeerID,
 			cmd.PubsubQueueSize,
+			cmd.PubsubTraceFile,
+			cmd.PubsubTraceFileMaxSize,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
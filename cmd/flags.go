@@ -185,11 +185,54 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PInboundIPv4PrefixLimit defines the maximum number of inbound peers sharing a /24 IPv4 prefix.
+	P2PInboundIPv4PrefixLimit = &cli.UintFlag{
+		Name: "p2p-inbound-ipv4-prefix-limit",
+		Usage: "The maximum number of inbound peers sharing the same /24 IPv4 prefix. Private and loopback " +
+			"addresses, as well as addresses allowed at runtime, are exempt. 0 disables the limit.",
+	}
+	// P2PInboundIPv6PrefixLimit defines the maximum number of inbound peers sharing a /48 IPv6 prefix.
+	P2PInboundIPv6PrefixLimit = &cli.UintFlag{
+		Name: "p2p-inbound-ipv6-prefix-limit",
+		Usage: "The maximum number of inbound peers sharing the same /48 IPv6 prefix. Private and loopback " +
+			"addresses, as well as addresses allowed at runtime, are exempt. 0 disables the limit.",
+	}
+	// P2PDisablePeerPersistence disables persisting the known peers across restarts.
+	P2PDisablePeerPersistence = &cli.BoolFlag{
+		Name: "p2p-disable-peer-persistence",
+		Usage: "Disables persisting the known peers, with their scores and bans, to the data directory. " +
+			"Persisted peers are reloaded on startup, historically good peers being dialed first.",
+	}
+	// P2PSentry defines the sentry nodes of a private node.
+	P2PSentry = &cli.StringSliceFlag{
+		Name: "p2p-sentry",
+		Usage: "The multiaddr or ENR, including the peer ID, of a sentry node. When set, the node runs in private mode: " +
+			"discovery is disabled and the node only connects to, and accepts connections from, its sentries. " +
+			"This flag can be specified multiple times.",
+	}
+	// P2PPrivatePeerID defines the private nodes shielded by a sentry node.
+	P2PPrivatePeerID = &cli.StringSliceFlag{
+		Name: "p2p-private-peer-id",
+		Usage: "The peer ID of a private node shielded by this sentry node. Private nodes are always accepted and " +
+			"never pruned, and their records are never shared with other peers. This flag can be specified multiple times.",
+	}
 	PubsubQueueSize = &cli.IntFlag{
 		Name:  "pubsub-queue-size",
 		Usage: "The size of the pubsub validation and outbound queue for the node.",
 		Value: 1000,
 	}
+	// PubsubTraceFile defines a flag to record gossip events to a file.
+	PubsubTraceFile = &cli.StringFlag{
+		Name: "pubsub-trace-file",
+		Usage: "Path of a file where every graft, prune and received, delivered, rejected or duplicate gossip message is " +
+			"recorded as a JSON line. Use `prysmctl p2p trace analyze` to inspect it.",
+	}
+	// PubsubTraceFileMaxSize defines a flag for the size at which the pubsub trace file is rotated.
+	PubsubTraceFileMaxSize = &cli.Uint64Flag{
+		Name:  "pubsub-trace-file-max-size",
+		Usage: "The size in megabytes at which the pubsub trace file is rotated. The 5 most recent rotated files are kept.",
+		Value: 100,
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
@@ -253,6 +296,14 @@ var (
 		Name:  "monitor-indices",
 		Usage: "List of validator indices to track performance",
 	}
+	// ValidatorMonitorHistoryEpochsFlag specifies the number of epochs for which the performances
+	// of the tracked validators are kept.
+	ValidatorMonitorHistoryEpochsFlag = &cli.Uint64Flag{
+		Name: "monitor-history-epochs",
+		Usage: "Number of epochs for which the per epoch performances of the validators tracked with --monitor-indices " +
+			"are kept in the database, and served by /prysm/v1/validators/monitor/{index}. 0 disables the history.",
+		Value: 4096,
+	}
 
 	// RestoreSourceFileFlag specifies the filepath to the backed-up database file
 	// which will be used to restore the database.
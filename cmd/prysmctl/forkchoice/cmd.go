@@ -0,0 +1,13 @@
+package forkchoice
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "forkchoice",
+		Usage: "commands to work with the prysm fork choice store",
+		Subcommands: []*cli.Command{
+			replayCmd,
+		},
+	},
+}
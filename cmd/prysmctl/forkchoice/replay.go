@@ -0,0 +1,71 @@
+package forkchoice
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/urfave/cli/v2"
+)
+
+var replayFlags = struct {
+	File         string
+	MismatchOnly bool
+}{}
+
+var replayCmd = &cli.Command{
+	Name:   "replay",
+	Usage:  "replay the fork choice inputs recorded by a beacon node run with --forkchoice-record-file, printing every head decision",
+	Action: replayAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "file",
+			Usage:       "path to the fork choice record file",
+			Destination: &replayFlags.File,
+			Required:    true,
+		},
+		&cli.BoolFlag{
+			Name:        "mismatch-only",
+			Usage:       "only print the head decisions which differ from the recorded ones",
+			Destination: &replayFlags.MismatchOnly,
+		},
+	},
+}
+
+func replayAction(cliCtx *cli.Context) error {
+	f, err := os.Open(replayFlags.File) // #nosec G304 -- The record file is provided by the user.
+	if err != nil {
+		return errors.Wrap(err, "could not open fork choice record file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			fmt.Printf("Could not close fork choice record file: %v\n", err)
+		}
+	}()
+
+	numHeads, numMismatches := 0, 0
+	err = doublylinkedtree.Replay(cliCtx.Context, f, func(h *doublylinkedtree.ReplayedHead) {
+		numHeads++
+		mismatch := h.Root != h.RecordedRoot
+		if mismatch {
+			numMismatches++
+		} else if replayFlags.MismatchOnly {
+			return
+		}
+		fmt.Printf("slot=%d head=%#x", h.Slot, h.Root)
+		if mismatch {
+			fmt.Printf(" recorded=%#x MISMATCH", h.RecordedRoot)
+		}
+		fmt.Printf("\n  justified=%d/%#x finalized=%d/%#x\n",
+			h.JustifiedCheckpoint.Epoch, h.JustifiedCheckpoint.Root, h.FinalizedCheckpoint.Epoch, h.FinalizedCheckpoint.Root)
+		for _, tip := range h.Tips {
+			fmt.Printf("  tip slot=%d root=%#x weight=%d\n", tip.Slot, tip.Root, tip.Weight)
+		}
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not replay fork choice record file")
+	}
+	fmt.Printf("Replayed %d head decisions, %d differ from the recorded ones\n", numHeads, numMismatches)
+	return nil
+}
@@ -0,0 +1,168 @@
+package p2p
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	apiclient "github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	crawlFormatCSV  = "csv"
+	crawlFormatJSON = "json"
+)
+
+var crawlFlags = struct {
+	BeaconNodeHost string
+	Format         string
+	Output         string
+	Attnet         int64
+	Syncnet        int64
+	ForkDigest     string
+	Client         string
+	Timeout        time.Duration
+}{}
+
+var crawlCmd = &cli.Command{
+	Name:  "crawl",
+	Usage: "Export the beacon nodes found by the discovery crawler of a beacon node",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionCrawl(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export crawled nodes")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &crawlFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "output format of the crawled nodes, either csv or json",
+			Destination: &crawlFlags.Format,
+			Value:       crawlFormatCSV,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the crawled nodes to, defaults to stdout",
+			Destination: &crawlFlags.Output,
+		},
+		&cli.Int64Flag{
+			Name:        "attnet",
+			Usage:       "only export nodes subscribed to this attestation subnet",
+			Destination: &crawlFlags.Attnet,
+			Value:       -1,
+		},
+		&cli.Int64Flag{
+			Name:        "syncnet",
+			Usage:       "only export nodes subscribed to this sync committee subnet",
+			Destination: &crawlFlags.Syncnet,
+			Value:       -1,
+		},
+		&cli.StringFlag{
+			Name:        "fork-digest",
+			Usage:       "only export nodes advertising this hex encoded fork digest",
+			Destination: &crawlFlags.ForkDigest,
+		},
+		&cli.StringFlag{
+			Name:        "client",
+			Usage:       "only export nodes running this client, ex: prysm, lighthouse",
+			Destination: &crawlFlags.Client,
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to the beacon node (uses duration format, ex: 2m31s)",
+			Destination: &crawlFlags.Timeout,
+			Value:       time.Second * 30,
+		},
+	},
+}
+
+func cliActionCrawl(_ *cli.Context) error {
+	if crawlFlags.Format != crawlFormatCSV && crawlFlags.Format != crawlFormatJSON {
+		return errors.Errorf("unknown format %q, expected %s or %s", crawlFlags.Format, crawlFormatCSV, crawlFormatJSON)
+	}
+	c, err := beacon.NewClient(crawlFlags.BeaconNodeHost, apiclient.WithTimeout(crawlFlags.Timeout))
+	if err != nil {
+		return err
+	}
+	var opts []apiclient.ReqOption
+	if crawlFlags.Attnet >= 0 {
+		opts = append(opts, apiclient.WithQueryParam("attnet", strconv.FormatInt(crawlFlags.Attnet, 10)))
+	}
+	if crawlFlags.Syncnet >= 0 {
+		opts = append(opts, apiclient.WithQueryParam("syncnet", strconv.FormatInt(crawlFlags.Syncnet, 10)))
+	}
+	if crawlFlags.ForkDigest != "" {
+		opts = append(opts, apiclient.WithQueryParam("fork_digest", crawlFlags.ForkDigest))
+	}
+	if crawlFlags.Client != "" {
+		opts = append(opts, apiclient.WithQueryParam("client", crawlFlags.Client))
+	}
+	resp, err := c.GetCrawledNodes(context.Background(), opts...)
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if crawlFlags.Output != "" {
+		f, err := os.Create(crawlFlags.Output)
+		if err != nil {
+			return errors.Wrapf(err, "could not create output file %s", crawlFlags.Output)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Error("Could not close output file")
+			}
+		}()
+		w = f
+	}
+	if crawlFlags.Format == crawlFormatJSON {
+		return writeCrawledNodesJSON(w, resp.Data)
+	}
+	if err := writeCrawledNodesCSV(w, resp.Data); err != nil {
+		return err
+	}
+	log.WithField("nodes", len(resp.Data)).Info("Exported crawled nodes")
+	return nil
+}
+
+func writeCrawledNodesJSON(w io.Writer, nodes []*structs.CrawledNode) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(nodes)
+}
+
+var crawledNodesCSVHeader = []string{
+	"node_id", "peer_id", "ip", "tcp_port", "udp_port", "quic_port", "fork_digest", "next_fork_version",
+	"next_fork_epoch", "attnets", "syncnets", "client", "first_seen", "last_seen", "enr",
+}
+
+func writeCrawledNodesCSV(w io.Writer, nodes []*structs.CrawledNode) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(crawledNodesCSVHeader); err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		if err := cw.Write([]string{
+			n.NodeId, n.PeerId, n.Ip, n.TcpPort, n.UdpPort, n.QuicPort, n.ForkDigest, n.NextForkVersion,
+			n.NextForkEpoch, n.Attnets, n.Syncnets, n.Client, n.FirstSeen, n.LastSeen, n.Enr,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
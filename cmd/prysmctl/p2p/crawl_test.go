@@ -0,0 +1,40 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestWriteCrawledNodes(t *testing.T) {
+	nodes := []*structs.CrawledNode{
+		{NodeId: "01", PeerId: "peer", Ip: "192.0.2.1", TcpPort: "13000", ForkDigest: "0xaabbccdd", Client: "prysm", Enr: "enr:-test"},
+		{NodeId: "02", PeerId: "other", Ip: "192.0.2.2", UdpPort: "12000", ForkDigest: "0xaabbccdd"},
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, writeCrawledNodesCSV(buf, nodes))
+		records, err := csv.NewReader(buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, 3, len(records))
+		assert.DeepEqual(t, crawledNodesCSVHeader, records[0])
+		assert.Equal(t, "01", records[1][0])
+		assert.Equal(t, "13000", records[1][3])
+		assert.Equal(t, "prysm", records[1][11])
+		assert.Equal(t, "enr:-test", records[1][14])
+		assert.Equal(t, "12000", records[2][4])
+	})
+	t.Run("json", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, writeCrawledNodesJSON(buf, nodes))
+		var decoded []*structs.CrawledNode
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.DeepEqual(t, nodes, decoded)
+	})
+}
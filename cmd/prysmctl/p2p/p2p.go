@@ -12,6 +12,13 @@ var Commands = []*cli.Command{
 				Usage:       "commands for sending p2p rpc requests to beacon nodes",
 				Subcommands: []*cli.Command{requestBlocksCmd, requestBlobsCmd},
 			},
+			{
+				Name:        "trace",
+				Usage:       "commands for inspecting pubsub trace files",
+				Subcommands: []*cli.Command{traceAnalyzeCmd},
+			},
+			peerScoresCmd,
+			crawlCmd,
 		},
 	},
 }
@@ -0,0 +1,131 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	apiclient "github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/urfave/cli/v2"
+)
+
+var peerScoresFlags = struct {
+	BeaconNodeHost string
+	PeerID         string
+	Topics         bool
+	Timeout        time.Duration
+}{}
+
+var peerScoresCmd = &cli.Command{
+	Name:  "scores",
+	Usage: "Print the gossipsub and Prysm peer scores of the peers connected to a beacon node",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionPeerScores(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not get peer scores")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &peerScoresFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.StringFlag{
+			Name:        "peer-id",
+			Usage:       "only print the scores of the peer with this ID",
+			Destination: &peerScoresFlags.PeerID,
+		},
+		&cli.BoolFlag{
+			Name:        "topics",
+			Usage:       "also print the per-topic gossipsub score components of each peer",
+			Destination: &peerScoresFlags.Topics,
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to the beacon node (uses duration format, ex: 2m31s)",
+			Destination: &peerScoresFlags.Timeout,
+			Value:       time.Second * 30,
+		},
+	},
+}
+
+func cliActionPeerScores(_ *cli.Context) error {
+	c, err := beacon.NewClient(peerScoresFlags.BeaconNodeHost, apiclient.WithTimeout(peerScoresFlags.Timeout))
+	if err != nil {
+		return err
+	}
+	resp, err := c.GetPeerScores(context.Background(), peerScoresFlags.PeerID)
+	if err != nil {
+		return err
+	}
+	return printPeerScores(os.Stdout, resp.Data, peerScoresFlags.Topics)
+}
+
+// printPeerScores writes the peer scores as a table, ordered from the lowest to the highest Prysm score.
+func printPeerScores(w io.Writer, scores []*structs.PeerScore, withTopics bool) error {
+	sort.SliceStable(scores, func(i, j int) bool {
+		return parseScore(scores[i].Prysm.Score) < parseScore(scores[j].Prysm.Score)
+	})
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "PEER\tDIRECTION\tPRYSM\tBAD_RESP\tBLOCK_PROV\tGOSSIP\tGOSSIPSUB\tAPP\tIP_COLOC\tBEHAVIOUR"); err != nil {
+		return err
+	}
+	for _, s := range scores {
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			s.PeerId, s.Direction, s.Prysm.Score, s.Prysm.BadResponsesScore, s.Prysm.BlockProviderScore, s.Prysm.GossipScore,
+			s.Gossipsub.Score, s.Gossipsub.AppSpecificScore, s.Gossipsub.IPColocationFactor, s.Gossipsub.BehaviourPenalty); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if !withTopics {
+		return nil
+	}
+	for _, s := range scores {
+		if len(s.Gossipsub.Topics) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "\nPeer %s\n", s.PeerId); err != nil {
+			return err
+		}
+		topics := make([]string, 0, len(s.Gossipsub.Topics))
+		for topic := range s.Gossipsub.Topics {
+			topics = append(topics, topic)
+		}
+		sort.Strings(topics)
+		tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if _, err := fmt.Fprintln(tw, "TOPIC\tTIME_IN_MESH_MS\tFIRST_DELIVERIES\tMESH_DELIVERIES\tINVALID"); err != nil {
+			return err
+		}
+		for _, topic := range topics {
+			ts := s.Gossipsub.Topics[topic]
+			if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+				topic, ts.TimeInMesh, ts.FirstMessageDeliveries, ts.MeshMessageDeliveries, ts.InvalidMessageDeliveries); err != nil {
+				return err
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseScore(s string) float64 {
+	score, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return score
+}
@@ -0,0 +1,43 @@
+package p2p
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestPrintPeerScores(t *testing.T) {
+	scores := []*structs.PeerScore{
+		{
+			PeerId:    "good",
+			Direction: "OUTBOUND",
+			Gossipsub: &structs.GossipsubScore{Score: "10"},
+			Prysm:     &structs.PrysmPeerScore{Score: "0.5"},
+		},
+		{
+			PeerId:    "bad",
+			Direction: "INBOUND",
+			Gossipsub: &structs.GossipsubScore{
+				Score: "-40",
+				Topics: map[string]*structs.GossipsubTopicScore{
+					"/eth2/beacon_block": {TimeInMesh: "1500", InvalidMessageDeliveries: "3"},
+				},
+			},
+			Prysm: &structs.PrysmPeerScore{Score: "-2.25"},
+		},
+	}
+	buf := &bytes.Buffer{}
+	require.NoError(t, printPeerScores(buf, scores, true))
+	out := buf.String()
+	lines := strings.Split(out, "\n")
+	require.Equal(t, true, len(lines) > 3)
+	assert.Equal(t, true, strings.HasPrefix(lines[1], "bad "), "lowest scored peer should be printed first")
+	assert.Equal(t, true, strings.HasPrefix(lines[2], "good "))
+	assert.StringContains(t, "Peer bad", out)
+	assert.StringContains(t, "/eth2/beacon_block", out)
+	assert.Equal(t, false, strings.Contains(out, "Peer good"))
+}
@@ -0,0 +1,139 @@
+package p2p
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/urfave/cli/v2"
+)
+
+var traceAnalyzeFlags = struct {
+	TraceFiles *cli.StringSlice
+	BlockRoot  string
+}{
+	TraceFiles: cli.NewStringSlice(),
+}
+
+var traceAnalyzeCmd = &cli.Command{
+	Name:  "analyze",
+	Usage: "Reconstruct the propagation timeline of a block from pubsub trace files written with --pubsub-trace-file",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionTraceAnalyze(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not analyze pubsub trace")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:        "trace-file",
+			Usage:       "pubsub trace file(s) to read, including rotated files",
+			Destination: traceAnalyzeFlags.TraceFiles,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "block-root",
+			Usage:       "hex encoded root of the block to analyze",
+			Destination: &traceAnalyzeFlags.BlockRoot,
+			Required:    true,
+		},
+	},
+}
+
+// propagationTimeline contains the gossip events related to a single block, ordered by time.
+type propagationTimeline struct {
+	blockRoot  string
+	topic      string
+	messageIDs map[string]bool
+	events     []*p2p.GossipTraceEvent
+}
+
+func cliActionTraceAnalyze(_ *cli.Context) error {
+	var events []*p2p.GossipTraceEvent
+	for _, path := range traceAnalyzeFlags.TraceFiles.Value() {
+		f, err := os.Open(path) // #nosec G304 -- path is provided by the user.
+		if err != nil {
+			return errors.Wrapf(err, "could not open trace file %s", path)
+		}
+		fileEvents, err := p2p.ReadGossipTrace(f)
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close trace file")
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not read trace file %s", path)
+		}
+		events = append(events, fileEvents...)
+	}
+	timeline, err := blockPropagationTimeline(events, traceAnalyzeFlags.BlockRoot)
+	if err != nil {
+		return err
+	}
+	return timeline.print(os.Stdout)
+}
+
+// blockPropagationTimeline collects the events of the messages which delivered the block with the given root.
+func blockPropagationTimeline(events []*p2p.GossipTraceEvent, blockRoot string) (*propagationTimeline, error) {
+	root := strings.ToLower(blockRoot)
+	if !strings.HasPrefix(root, "0x") {
+		root = "0x" + root
+	}
+	timeline := &propagationTimeline{blockRoot: root, messageIDs: make(map[string]bool)}
+	for _, ev := range events {
+		if ev.Type == p2p.GossipTraceDeliver && strings.ToLower(ev.BlockRoot) == root {
+			timeline.messageIDs[ev.MessageID] = true
+			timeline.topic = ev.Topic
+		}
+	}
+	if len(timeline.messageIDs) == 0 {
+		return nil, fmt.Errorf("block %s was not delivered in the provided trace files", root)
+	}
+	for _, ev := range events {
+		if ev.MessageID != "" && timeline.messageIDs[ev.MessageID] {
+			timeline.events = append(timeline.events, ev)
+		}
+	}
+	sort.SliceStable(timeline.events, func(i, j int) bool {
+		return timeline.events[i].Time.Before(timeline.events[j].Time)
+	})
+	return timeline, nil
+}
+
+// firstSeen returns the time at which the block was first received.
+func (t *propagationTimeline) firstSeen() time.Time {
+	return t.events[0].Time
+}
+
+// peers returns the number of distinct peers which sent the block.
+func (t *propagationTimeline) peers() int {
+	seen := make(map[string]bool)
+	for _, ev := range t.events {
+		if ev.Type == p2p.GossipTraceReceive || ev.Type == p2p.GossipTraceDuplicate {
+			seen[ev.Peer] = true
+		}
+	}
+	return len(seen)
+}
+
+func (t *propagationTimeline) print(w io.Writer) error {
+	first := t.firstSeen()
+	if _, err := fmt.Fprintf(w, "Block %s on topic %s\nFirst seen at %s, received from %d peer(s)\n\n",
+		t.blockRoot, t.topic, first.UTC().Format(time.RFC3339Nano), t.peers()); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "OFFSET\tEVENT\tPEER\tREASON"); err != nil {
+		return err
+	}
+	for _, ev := range t.events {
+		if _, err := fmt.Fprintf(tw, "+%s\t%s\t%s\t%s\n", ev.Time.Sub(first), ev.Type, ev.Peer, ev.Reason); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,44 @@
+package p2p
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestBlockPropagationTimeline(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	topic := "/eth2/abcdef00/beacon_block/ssz_snappy"
+	events := []*p2p.GossipTraceEvent{
+		{Time: start, Type: p2p.GossipTraceGraft, Topic: topic, Peer: "a"},
+		{Time: start.Add(300 * time.Millisecond), Type: p2p.GossipTraceDuplicate, Topic: topic, MessageID: "0x01", Peer: "b"},
+		{Time: start.Add(100 * time.Millisecond), Type: p2p.GossipTraceReceive, Topic: topic, MessageID: "0x01", Peer: "a"},
+		{Time: start.Add(150 * time.Millisecond), Type: p2p.GossipTraceDeliver, Topic: topic, MessageID: "0x01", Peer: "a", BlockRoot: "0xaabb"},
+		{Time: start.Add(200 * time.Millisecond), Type: p2p.GossipTraceReceive, Topic: topic, MessageID: "0x02", Peer: "c"},
+		{Time: start.Add(250 * time.Millisecond), Type: p2p.GossipTraceDeliver, Topic: topic, MessageID: "0x02", Peer: "c", BlockRoot: "0xccdd"},
+	}
+
+	t.Run("unknown block", func(t *testing.T) {
+		_, err := blockPropagationTimeline(events, "0xeeff")
+		require.ErrorContains(t, "was not delivered", err)
+	})
+	t.Run("timeline", func(t *testing.T) {
+		timeline, err := blockPropagationTimeline(events, "AABB")
+		require.NoError(t, err)
+		require.Equal(t, 3, len(timeline.events))
+		assert.Equal(t, p2p.GossipTraceReceive, timeline.events[0].Type)
+		assert.Equal(t, p2p.GossipTraceDeliver, timeline.events[1].Type)
+		assert.Equal(t, p2p.GossipTraceDuplicate, timeline.events[2].Type)
+		assert.Equal(t, start.Add(100*time.Millisecond), timeline.firstSeen())
+		assert.Equal(t, 2, timeline.peers())
+
+		var buf bytes.Buffer
+		require.NoError(t, timeline.print(&buf))
+		assert.StringContains(t, "received from 2 peer(s)", buf.String())
+		assert.StringContains(t, "+200ms", buf.String())
+	})
+}
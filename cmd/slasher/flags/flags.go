@@ -0,0 +1,17 @@
+// Package flags contains all configuration runtime flags for
+// the standalone slasher.
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// BeaconRESTApiProviderFlag defines a flag for the REST API of the beacon node feeding the slasher.
+	BeaconRESTApiProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint. The beacon node must run with --slasher-event-stream " +
+			"to stream the indexed attestations it receives.",
+		Value: "http://127.0.0.1:3500",
+	}
+)
@@ -0,0 +1,190 @@
+// Package main defines a slasher running in its own process, fed by a beacon node
+// through its REST API instead of running within the beacon node with --slasher.
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	runtimeDebug "runtime/debug"
+	"syscall"
+
+	joonix "github.com/joonix/log"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/remote"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	beaconflags "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/slasher/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/journald"
+	"github.com/prysmaticlabs/prysm/v5/runtime"
+	prefixed "github.com/prysmaticlabs/prysm/v5/runtime/logging/logrus-prefixed-formatter"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var appFlags = []cli.Flag{
+	cmd.VerbosityFlag,
+	cmd.LogFormat,
+	cmd.LogFileName,
+	cmd.ConfigFileFlag,
+	cmd.ChainConfigFileFlag,
+	beaconflags.SlasherDirFlag,
+	flags.BeaconRESTApiProviderFlag,
+}
+
+func init() {
+	appFlags = append(appFlags, features.NetworkFlags...)
+	appFlags = cmd.WrapFlags(appFlags)
+}
+
+func main() {
+	app := cli.App{}
+	app.Name = "slasher"
+	app.Usage = "detects slashable offenses in the attestations and blocks received by a beacon node"
+	app.Action = run
+	app.Version = version.Version()
+
+	app.Flags = appFlags
+
+	app.Before = func(ctx *cli.Context) error {
+		// Load flags from config file, if specified.
+		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
+			return err
+		}
+
+		verbosity := ctx.String(cmd.VerbosityFlag.Name)
+		level, err := logrus.ParseLevel(verbosity)
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(level)
+
+		format := ctx.String(cmd.LogFormat.Name)
+		switch format {
+		case "text":
+			formatter := new(prefixed.TextFormatter)
+			formatter.TimestampFormat = "2006-01-02 15:04:05"
+			formatter.FullTimestamp = true
+			// If persistent log files are written - we disable the log messages coloring because
+			// the colors are ANSI codes and seen as gibberish in the log files.
+			formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
+			logrus.SetFormatter(formatter)
+		case "fluentd":
+			f := joonix.NewFormatter()
+			if err := joonix.DisableTimestampFormat(f); err != nil {
+				panic(err)
+			}
+			logrus.SetFormatter(f)
+		case "json":
+			logrus.SetFormatter(&logrus.JSONFormatter{})
+		case "journald":
+			if err := journald.Enable(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown log format %s", format)
+		}
+
+		logFileName := ctx.String(cmd.LogFileName.Name)
+		if logFileName != "" {
+			if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+				log.WithError(err).Error("Failed to configuring logging to disk.")
+			}
+		}
+
+		if err := features.ConfigureSlasher(ctx); err != nil {
+			return err
+		}
+		if ctx.IsSet(cmd.ChainConfigFileFlag.Name) {
+			if err := params.LoadChainConfigFile(ctx.String(cmd.ChainConfigFileFlag.Name), nil); err != nil {
+				return err
+			}
+		}
+		return cmd.ValidateNoArgs(ctx)
+	}
+
+	defer func() {
+		if x := recover(); x != nil {
+			log.Errorf("Runtime panic: %v\n%v", x, string(runtimeDebug.Stack()))
+			panic(x)
+		}
+	}()
+
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+	}
+}
+
+func run(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+
+	// The database is laid out as the one of the slasher running within the beacon node,
+	// so that the history of an existing slasher can be kept.
+	dbPath := filepath.Join(cliCtx.String(beaconflags.SlasherDirFlag.Name), kv.BeaconNodeDbDirName)
+	log.WithField("databasePath", dbPath).Info("Opening slasher database")
+	db, err := slasherkv.NewKVStore(ctx, dbPath)
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+
+	client, err := beacon.NewClient(cliCtx.String(flags.BeaconRESTApiProviderFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not create beacon node client")
+	}
+	beaconNode := remote.NewBeaconNode(client)
+	indexedAttestationsFeed := new(event.Feed)
+	beaconBlockHeadersFeed := new(event.Feed)
+
+	services := runtime.NewServiceRegistry()
+	feeder, err := remote.NewEventFeeder(ctx, client, indexedAttestationsFeed, beaconBlockHeadersFeed)
+	if err != nil {
+		return err
+	}
+	if err := services.RegisterService(feeder); err != nil {
+		return err
+	}
+	slasherSrv, err := slasher.New(ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: indexedAttestationsFeed,
+		BeaconBlockHeadersFeed:  beaconBlockHeadersFeed,
+		Database:                db,
+		SlashingPoolInserter:    beaconNode,
+		SyncChecker:             beaconNode,
+		ClockWaiter:             beaconNode,
+		RemoteChainFetcher:      beaconNode,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create slasher")
+	}
+	if err := services.RegisterService(slasherSrv); err != nil {
+		return err
+	}
+
+	log.WithField("beaconNode", client.NodeURL()).Info("Starting slasher")
+	services.StartAll()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Got interrupt, shutting down...")
+	case <-ctx.Done():
+	}
+	services.StopAll()
+	return nil
+}
@@ -83,9 +83,17 @@ var appFlags = []cli.Flag{
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
 	flags.ProposerSettingsFlag,
+	flags.ProposerSettingsRefreshIntervalFlag,
+	flags.ProposerSettingsSignatureURLFlag,
+	flags.ProposerSettingsVerificationKeyFlag,
+	flags.ProposerSettingsAuditLogFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
 	flags.ValidatorsRegistrationBatchSizeFlag,
+	flags.SlashingProtectionDBDriverFlag,
+	flags.SlashingProtectionDBDSNFlag,
+	flags.SlashingProtectionDBClientIDFlag,
+	flags.DoppelgangerEpochsFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
 	cmd.MonitoringHostFlag,
@@ -4,16 +4,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqldb"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 	"github.com/urfave/cli/v2"
@@ -45,6 +50,21 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 			"a file that can then be imported into any other Prysm setup across computers",
 	)
 
+	// Use the SQL database if requested.
+	if cliCtx.IsSet(flags.SlashingProtectionDBDSNFlag.Name) {
+		validatorDB, err = sqldb.NewStore(
+			cliCtx.Context,
+			cliCtx.String(flags.SlashingProtectionDBDriverFlag.Name),
+			cliCtx.String(flags.SlashingProtectionDBDSNFlag.Name),
+			nil,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not access SQL validator database")
+		}
+
+		return exportSlashingProtectionJSONFromDB(cliCtx, validatorDB)
+	}
+
 	// Check if a minimal database is requested
 	isDatabaseMinimal := cliCtx.Bool(features.EnableMinimalSlashingProtection.Name)
 
@@ -87,6 +107,12 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
 	}
 
+	return exportSlashingProtectionJSONFromDB(cliCtx, validatorDB)
+}
+
+// exportSlashingProtectionJSONFromDB exports the slashing protection history of the validator database
+// into the output directory selected by the user, and closes the database.
+func exportSlashingProtectionJSONFromDB(cliCtx *cli.Context, validatorDB iface.ValidatorDB) error {
 	// Close the database when we're done.
 	defer func() {
 		if err := validatorDB.Close(); err != nil {
@@ -94,14 +120,31 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 		}
 	}()
 
+	// Only export the history of the public keys requested by the user, if any.
+	var (
+		pubKeys [][fieldparams.BLSPubkeyLength]byte
+		err     error
+	)
+	if cliCtx.IsSet(flags.SlashingProtectionPublicKeysFlag.Name) {
+		pubKeys, err = parsePublicKeys(cliCtx.String(flags.SlashingProtectionPublicKeysFlag.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	filteredKeys := make([][]byte, len(pubKeys))
+	for i := range pubKeys {
+		filteredKeys[i] = pubKeys[i][:]
+	}
+
 	// Export the slashing protection history from the validator's database.
-	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB, filteredKeys...)
 	if err != nil {
 		return errors.Wrap(err, "could not export slashing protection history")
 	}
 
 	// Check if JSON data is empty and issue a warning about common problems to the user.
-	if eipJSON == nil || len(eipJSON.Data) == 0 {
+	if len(pubKeys) == 0 && (eipJSON == nil || len(eipJSON.Data) == 0) {
 		log.Fatal(
 			"No slashing protection data was found in your database. This is likely because an older version of " +
 				"Prysm would place your validator database in your wallet directory as a validator.db file. Now, " +
@@ -111,6 +154,11 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 		)
 	}
 
+	// Only keep the history requested by the user for an incremental export.
+	if err := filterSlashingProtectionJSON(cliCtx, eipJSON, pubKeys); err != nil {
+		return err
+	}
+
 	// Write the result to the output file
 	if err := writeToOutput(cliCtx, eipJSON); err != nil {
 		return errors.Wrap(err, "could not write slashing protection history to output file")
@@ -119,6 +167,63 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	return nil
 }
 
+// filterSlashingProtectionJSON filters the slashing protection history exported for the requested
+// public keys according to the epoch selected by the user, if any, and reports the public keys
+// without any history.
+func filterSlashingProtectionJSON(
+	cliCtx *cli.Context,
+	eipJSON *format.EIPSlashingProtectionFormat,
+	pubKeys [][fieldparams.BLSPubkeyLength]byte,
+) error {
+	if len(pubKeys) > 0 {
+		missing, err := slashingprotection.MissingStandardProtectionJSONPubKeys(eipJSON, pubKeys)
+		if err != nil {
+			return errors.Wrap(err, "could not find the exported public keys")
+		}
+
+		for _, pubKey := range missing {
+			log.WithField("pubkey", fmt.Sprintf("%#x", pubKey)).Warn("No slashing protection history found for requested public key")
+		}
+	}
+
+	if cliCtx.IsSet(flags.SlashingProtectionSinceEpochFlag.Name) {
+		sinceEpoch := primitives.Epoch(cliCtx.Uint64(flags.SlashingProtectionSinceEpochFlag.Name))
+		if err := slashingprotection.FilterStandardProtectionJSONSinceEpoch(eipJSON, sinceEpoch); err != nil {
+			return errors.Wrap(err, "could not filter slashing protection history by epoch")
+		}
+	}
+
+	if len(eipJSON.Data) == 0 {
+		return errors.New("no slashing protection history matches the requested public keys and epoch")
+	}
+
+	return nil
+}
+
+// parsePublicKeys parses a comma separated list of hex string public keys.
+func parsePublicKeys(pubKeysStr string) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for _, pubKeyStr := range strings.Split(pubKeysStr, ",") {
+		pubKeyStr = strings.TrimSpace(pubKeyStr)
+		if pubKeyStr == "" {
+			continue
+		}
+
+		pubKey, err := helpers.PubKeyFromHex(pubKeyStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid public key", pubKeyStr)
+		}
+
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public key specified")
+	}
+
+	return pubKeys, nil
+}
+
 func writeToOutput(cliCtx *cli.Context, eipJSON *format.EIPSlashingProtectionFormat) error {
 	// Get the output directory where the slashing protection history file will be stored
 	outputDir, err := userprompt.InputDirectory(
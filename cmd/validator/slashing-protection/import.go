@@ -2,17 +2,23 @@ package historycmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqldb"
+	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
@@ -32,6 +38,24 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 		err   error
 	)
 
+	// A dry run must not write anything to the database.
+	dryRun := cliCtx.Bool(flags.SlashingProtectionDryRunFlag.Name)
+
+	// Use the SQL database if requested.
+	if cliCtx.IsSet(flags.SlashingProtectionDBDSNFlag.Name) {
+		valDB, err = sqldb.NewStore(
+			cliCtx.Context,
+			cliCtx.String(flags.SlashingProtectionDBDriverFlag.Name),
+			cliCtx.String(flags.SlashingProtectionDBDSNFlag.Name),
+			&sqldb.Config{ReadOnly: dryRun},
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not access SQL validator database")
+		}
+
+		return importSlashingProtectionJSONIntoDB(cliCtx, valDB, valDB.DatabasePath())
+	}
+
 	// Check if a minimal database is requested
 	isDatabaseMimimal := cliCtx.Bool(features.EnableMinimalSlashingProtection.Name)
 
@@ -55,6 +79,11 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
 	}
 
+	// A dry run must not create a database.
+	if !found && dryRun {
+		return fmt.Errorf("did not find existing database inside of %s, nothing to compare with", dataDir)
+	}
+
 	message := "Found existing database inside of %s"
 	if !found {
 		message = "Did not find existing database inside of %s, creating a new one"
@@ -66,13 +95,19 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 	if isDatabaseMimimal {
 		valDB, err = filesystem.NewStore(dataDir, nil)
 	} else {
-		valDB, err = kv.NewKVStore(cliCtx.Context, dataDir, nil)
+		valDB, err = kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{ReadOnly: dryRun})
 	}
 
 	if err != nil {
 		return errors.Wrapf(err, "could not access validator database at path: %s", dataDir)
 	}
 
+	return importSlashingProtectionJSONIntoDB(cliCtx, valDB, dataDir)
+}
+
+// importSlashingProtectionJSONIntoDB reads the slashing protection JSON file selected by the user,
+// imports it into the validator database and closes the database.
+func importSlashingProtectionJSONIntoDB(cliCtx *cli.Context, valDB iface.ValidatorDB, location string) error {
 	// Close the database when we're done.
 	defer func() {
 		if err := valDB.Close(); err != nil {
@@ -99,6 +134,11 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 		return err
 	}
 
+	// Only report the effect of the import if requested.
+	if cliCtx.Bool(flags.SlashingProtectionDryRunFlag.Name) {
+		return reportSlashingProtectionJSONImport(cliCtx, valDB, protectionFilePath, enc)
+	}
+
 	// Import the data from the standard slashing protection JSON file into our database.
 	log.Infof("Starting import of slashing protection file %s", protectionFilePath)
 	buf := bytes.NewBuffer(enc)
@@ -107,7 +147,72 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 		return errors.Wrapf(err, "could not import slashing protection JSON file %s", protectionFilePath)
 	}
 
-	log.Infof("Slashing protection JSON successfully imported into %s", dataDir)
+	log.Infof("Slashing protection JSON successfully imported into %s", location)
 
 	return nil
 }
+
+// reportSlashingProtectionJSONImport logs how importing the slashing protection JSON file
+// would change the validator database, without importing it.
+func reportSlashingProtectionJSONImport(cliCtx *cli.Context, valDB iface.ValidatorDB, protectionFilePath string, enc []byte) error {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(enc, interchangeJSON); err != nil {
+		return errors.Wrapf(err, "could not unmarshal slashing protection JSON file %s", protectionFilePath)
+	}
+
+	report, err := slashingprotection.CompareStandardProtectionJSON(cliCtx.Context, valDB, interchangeJSON)
+	if err != nil {
+		return errors.Wrapf(err, "could not compare slashing protection JSON file %s with validator database", protectionFilePath)
+	}
+
+	tightenedCount := 0
+	for _, keyReport := range report.Keys {
+		if !keyReport.Tightened() {
+			log.WithField("pubkey", keyReport.PubKey).Info("Import would not change slashing protection")
+			continue
+		}
+
+		tightenedCount++
+		log.WithFields(logrus.Fields{
+			"pubkey":                 keyReport.PubKey,
+			"tighteningBlocks":       keyReport.TighteningBlocks,
+			"tighteningAttestations": keyReport.TighteningAttestations,
+			"highestSlot":            protectionTransition(keyReport.Before.HighestSlot, keyReport.After.HighestSlot),
+			"highestSourceEpoch":     protectionTransition(keyReport.Before.HighestSourceEpoch, keyReport.After.HighestSourceEpoch),
+			"highestTargetEpoch":     protectionTransition(keyReport.Before.HighestTargetEpoch, keyReport.After.HighestTargetEpoch),
+		}).Info("Import would tighten slashing protection")
+	}
+
+	for _, conflict := range report.Conflicts {
+		log.WithFields(logrus.Fields{
+			"pubkey": conflict.PubKey,
+			"kind":   conflict.Kind,
+		}).Warn(conflict.Description)
+	}
+
+	log.Infof(
+		"Dry run: importing %s would tighten the slashing protection of %d out of %d public keys, nothing was imported",
+		protectionFilePath, tightenedCount, len(report.Keys),
+	)
+
+	if len(report.Conflicts) > 0 {
+		return fmt.Errorf("found %d conflicting records in slashing protection JSON file %s", len(report.Conflicts), protectionFilePath)
+	}
+
+	return nil
+}
+
+// protectionTransition describes the change of a minimal slashing protection value.
+func protectionTransition[T primitives.Slot | primitives.Epoch](before, after *T) string {
+	beforeStr := "none"
+	if before != nil {
+		beforeStr = fmt.Sprintf("%d", *before)
+	}
+
+	afterStr := "none"
+	if after != nil {
+		afterStr = fmt.Sprintf("%d", *after)
+	}
+
+	return fmt.Sprintf("%s -> %s", beforeStr, afterStr)
+}
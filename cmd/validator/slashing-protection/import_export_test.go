@@ -1,17 +1,23 @@
 package historycmd
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"flag"
+	"fmt"
 	"path/filepath"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqldb"
 	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 	mocks "github.com/prysmaticlabs/prysm/v5/validator/testing"
@@ -188,3 +194,143 @@ func TestImportExportSlashingProtectionCli_EmptyData(t *testing.T) {
 		require.DeepEqual(t, make([]*format.SignedAttestation, 0), item.SignedAttestations)
 	}
 }
+
+// TestImportSlashingProtectionCli_DryRun validates a EIP-3076 interchange format JSON file
+// and reports its import, checking nothing is written to the database.
+func TestImportSlashingProtectionCli_DryRun(t *testing.T) {
+	numValidators := 10
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+
+	// Create some mock slashing protection history and JSON file.
+	pubKeys, err := mocks.CreateRandomPubKeys(numValidators)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	protectionFilePath := filepath.Join(outputPath, "slashing_history_import.json")
+	require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+
+	validatorDB := dbTest.SetupDB(t, pubKeys, false)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	dbFile := filepath.Join(dbPath, kv.ProtectionDbFileName)
+	dbBefore, err := file.ReadFileAsBytes(dbFile)
+	require.NoError(t, err)
+
+	cliCtx := setupCliCtx(t, dbPath, protectionFilePath, outputPath)
+	require.NoError(t, validateSlashingProtectionJSON(cliCtx))
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dbPath, "")
+	set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
+	set.Bool(flags.SlashingProtectionDryRunFlag.Name, true, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dbPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
+	require.NoError(t, importSlashingProtectionJSON(cli.NewContext(&cli.App{}, set, nil)))
+
+	// The database file was not written to.
+	dbAfter, err := file.ReadFileAsBytes(dbFile)
+	require.NoError(t, err)
+	require.Equal(t, true, bytes.Equal(dbBefore, dbAfter), "the database was written to")
+
+	// Nothing was imported, so there is nothing to export.
+	err = exportSlashingProtectionJSON(cliCtx)
+	require.ErrorContains(t, "genesis validators root is empty", err)
+}
+
+// TestImportSlashingProtectionCli_DryRunSQL checks a dry run neither creates a missing SQL database
+// nor its schema, and writes nothing to an existing one.
+func TestImportSlashingProtectionCli_DryRunSQL(t *testing.T) {
+	pubKeys, err := mocks.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	protectionFilePath := filepath.Join(t.TempDir(), "slashing_history_import.json")
+	require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+
+	dryRunCliCtx := func(dataSourceName string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.SlashingProtectionDBDriverFlag.Name, sqldb.SQLiteDriverName, "")
+		set.String(flags.SlashingProtectionDBDSNFlag.Name, dataSourceName, "")
+		set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
+		set.Bool(flags.SlashingProtectionDryRunFlag.Name, true, "")
+		require.NoError(t, set.Set(flags.SlashingProtectionDBDriverFlag.Name, sqldb.SQLiteDriverName))
+		require.NoError(t, set.Set(flags.SlashingProtectionDBDSNFlag.Name, dataSourceName))
+		require.NoError(t, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+
+	// A missing database is not created.
+	missingPath := filepath.Join(t.TempDir(), "missing.sqlite")
+	require.ErrorContains(t, "could not access SQL validator database", importSlashingProtectionJSON(dryRunCliCtx(missingPath)))
+	exists, err := file.Exists(missingPath, file.Regular)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	// The schema of an existing database is not created.
+	emptyPath := filepath.Join(t.TempDir(), "empty.sqlite")
+	require.NoError(t, file.WriteFile(emptyPath, nil))
+	require.ErrorContains(t, "could not find slashing protection database schema", importSlashingProtectionJSON(dryRunCliCtx(emptyPath)))
+	emptyAfter, err := file.ReadFileAsBytes(emptyPath)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(emptyAfter))
+
+	// Nothing is written to an existing database.
+	dbPath := filepath.Join(t.TempDir(), "slashing-protection.sqlite")
+	store, err := sqldb.NewStore(context.Background(), sqldb.SQLiteDriverName, dbPath, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+	dbBefore, err := file.ReadFileAsBytes(dbPath)
+	require.NoError(t, err)
+	require.NoError(t, importSlashingProtectionJSON(dryRunCliCtx(dbPath)))
+	dbAfter, err := file.ReadFileAsBytes(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, true, bytes.Equal(dbBefore, dbAfter), "the database was written to")
+}
+
+// TestExportSlashingProtectionCli_PublicKeys only exports the history of the requested public keys.
+func TestExportSlashingProtectionCli_PublicKeys(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+
+	pubKeys, err := mocks.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	protectionFilePath := filepath.Join(outputPath, "slashing_history_import.json")
+	require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+
+	validatorDB := dbTest.SetupDB(t, pubKeys, false)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	require.NoError(t, importSlashingProtectionJSON(setupCliCtx(t, dbPath, protectionFilePath, outputPath)))
+
+	// Request the first key and a key without any history.
+	missingPubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	requested := fmt.Sprintf("%#x,%#x", pubKeys[0], missingPubKey)
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dbPath, "")
+	set.String(flags.SlashingProtectionExportDirFlag.Name, outputPath, "")
+	set.String(flags.SlashingProtectionPublicKeysFlag.Name, requested, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dbPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionPublicKeysFlag.Name, requested))
+	require.NoError(t, exportSlashingProtectionJSON(cli.NewContext(&cli.App{}, set, nil)))
+
+	enc, err := file.ReadFileAsBytes(filepath.Join(outputPath, jsonExportFileName))
+	require.NoError(t, err)
+	receivedJSON := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, receivedJSON))
+	require.Equal(t, 1, len(receivedJSON.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[0]), receivedJSON.Data[0].Pubkey)
+}
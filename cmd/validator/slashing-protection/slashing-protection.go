@@ -21,10 +21,14 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionSinceEpochFlag,
+				flags.SlashingProtectionPublicKeysFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
 				features.EnableMinimalSlashingProtection,
+				flags.SlashingProtectionDBDriverFlag,
+				flags.SlashingProtectionDBDSNFlag,
 				cmd.AcceptTosFlag,
 			}),
 			Before: func(cliCtx *cli.Context) error {
@@ -49,10 +53,13 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionJSONFileFlag,
+				flags.SlashingProtectionDryRunFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
 				features.EnableMinimalSlashingProtection,
+				flags.SlashingProtectionDBDriverFlag,
+				flags.SlashingProtectionDBDSNFlag,
 				cmd.AcceptTosFlag,
 			}),
 			Before: func(cliCtx *cli.Context) error {
@@ -72,5 +79,21 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "validate",
+			Description: `checks a selected EIP-3076 compliant slashing protection JSON for slashable records before importing it`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFileFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := validateSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not validate slashing protection file: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
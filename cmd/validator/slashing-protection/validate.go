@@ -0,0 +1,63 @@
+package historycmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
+	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Checks an input slashing protection EIP-3076 standard JSON file
+// for signed messages which are slashable with respect to each other,
+// so the file can be fixed before being imported into any validator DB.
+func validateSlashingProtectionJSON(cliCtx *cli.Context) error {
+	// Get the path to the slashing protection JSON file from the CLI context.
+	protectionFilePath, err := userprompt.InputDirectory(cliCtx, userprompt.SlashingProtectionJSONPromptText, flags.SlashingProtectionJSONFileFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if protectionFilePath == "" {
+		return fmt.Errorf(
+			"no path to a slashing_protection.json file specified, please retry or "+
+				"you can also specify it with the %s flag",
+			flags.SlashingProtectionJSONFileFlag.Name,
+		)
+	}
+
+	enc, err := file.ReadFileAsBytes(protectionFilePath)
+	if err != nil {
+		return err
+	}
+
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(enc, interchangeJSON); err != nil {
+		return errors.Wrapf(err, "could not unmarshal slashing protection JSON file %s", protectionFilePath)
+	}
+
+	conflicts, err := slashingprotection.ValidateStandardProtectionJSON(interchangeJSON)
+	if err != nil {
+		return errors.Wrapf(err, "could not validate slashing protection JSON file %s", protectionFilePath)
+	}
+
+	for _, conflict := range conflicts {
+		log.WithFields(logrus.Fields{
+			"pubkey": conflict.PubKey,
+			"kind":   conflict.Kind,
+		}).Warn(conflict.Description)
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("found %d slashable records in slashing protection JSON file %s", len(conflicts), protectionFilePath)
+	}
+
+	log.Infof("Slashing protection JSON file %s does not contain any slashable record", protectionFilePath)
+
+	return nil
+}
@@ -58,6 +58,10 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.DataDirFlag,
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
+			flags.SlashingProtectionDBDriverFlag,
+			flags.SlashingProtectionDBDSNFlag,
+			flags.SlashingProtectionDBClientIDFlag,
+			flags.DoppelgangerEpochsFlag,
 			cmd.ClearDB,
 			cmd.ForceClearDB,
 			cmd.EnableBackupWebhookFlag,
@@ -114,6 +118,10 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
+			flags.ProposerSettingsRefreshIntervalFlag,
+			flags.ProposerSettingsSignatureURLFlag,
+			flags.ProposerSettingsVerificationKeyFlag,
+			flags.ProposerSettingsAuditLogFlag,
 			flags.SuggestedFeeRecipientFlag,
 			flags.EnableBuilderFlag,
 			flags.BuilderGasLimitFlag,
@@ -55,6 +55,7 @@ type Flags struct {
 
 	// Slasher toggles.
 	DisableBroadcastSlashings bool // DisableBroadcastSlashings disables p2p broadcasting of proposer and attester slashings.
+	EnableSlasherEventStream  bool // EnableSlasherEventStream streams the indexed attestations and block headers to a standalone slasher.
 
 	// Bug fixes related flags.
 	AttestTimely bool // AttestTimely fixes #8185. It is gated behind a flag to ensure beacon node's fix can safely roll out first. We'll invert this in v1.1.0.
@@ -210,6 +211,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		log.WithField(enableSlasherFlag.Name, enableSlasherFlag.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableSlasher = true
 	}
+	if ctx.Bool(enableSlasherEventStreamFlag.Name) {
+		logEnabled(enableSlasherEventStreamFlag)
+		cfg.EnableSlasherEventStream = true
+	}
 	if ctx.Bool(enableHistoricalSpaceRepresentation.Name) {
 		log.WithField(enableHistoricalSpaceRepresentation.Name, enableHistoricalSpaceRepresentation.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableHistoricalSpaceRepresentation = true
@@ -302,6 +307,17 @@ func ConfigureValidator(ctx *cli.Context) error {
 	return nil
 }
 
+// ConfigureSlasher sets the global config based
+// on what flags are enabled for the standalone slasher.
+func ConfigureSlasher(ctx *cli.Context) error {
+	complainOnDeprecatedFlags(ctx)
+	if err := configureTestnet(ctx); err != nil {
+		return err
+	}
+	Init(&Flags{})
+	return nil
+}
+
 // enableDevModeFlags switches development mode features on.
 func enableDevModeFlags(ctx *cli.Context) {
 	log.Warn("Enabling development mode flags")
@@ -93,6 +93,11 @@ var (
 		Name:  "slasher",
 		Usage: "Enables a slasher in the beacon node for detecting slashable offenses.",
 	}
+	enableSlasherEventStreamFlag = &cli.BoolFlag{
+		Name: "slasher-event-stream",
+		Usage: "Streams the indexed attestations and block headers received by the beacon node on the indexed_attestation " +
+			"and block_header event topics, to feed a standalone slasher.",
+	}
 	enableSlashingProtectionPruning = &cli.BoolFlag{
 		Name:  "enable-slashing-protection-history-pruning",
 		Usage: "Enables the pruning of the validator client's slashing protection database.",
@@ -212,6 +217,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disablePeerScorer,
 	disableBroadcastSlashingFlag,
 	enableSlasherFlag,
+	enableSlasherEventStreamFlag,
 	enableHistoricalSpaceRepresentation,
 	disableStakinContractCheck,
 	SaveFullExecutionPayloads,
@@ -225,6 +225,10 @@ type BeaconChainConfig struct {
 	LocalBlockValueBoost             uint64          // LocalBlockValueBoost is the value boost for local block construction. This is used to prioritize local block construction over relay/builder block construction.
 	MinBuilderBid                    uint64          // MinBuilderBid is the minimum value that the builder's block can have to be considered by this node.
 	MinBuilderDiff                   uint64          // MinBuilderDiff is the minimum value above the local block value that the builder has to bid to be considered by this node
+	// Late block reorg
+	ReorgLateBlockCutoffMillis uint64 // ReorgLateBlockCutoffMillis defines the time into its slot after which a head block arrives late and may be orphaned. Zero defaults to the attestation deadline.
+	ReorgProposerCutoffMillis  uint64 // ReorgProposerCutoffMillis defines the time into its slot before which a proposer has to propose to orphan a late head block. Zero defaults to half the attestation deadline.
+
 	// Execution engine timeout value
 	ExecutionEngineTimeoutValue uint64 // ExecutionEngineTimeoutValue defines the seconds to wait before timing out engine endpoints with execution payload execution semantics (newPayload, forkchoiceUpdated).
 
@@ -353,6 +357,29 @@ func (b *BeaconChainConfig) MaximumGossipClockDisparityDuration() time.Duration
 	return time.Duration(b.MaximumGossipClockDisparity) * time.Millisecond
 }
 
+// ReorgLateBlockCutoff returns the time into its slot after which a head block arrives late, which
+// defaults to the attestation deadline.
+func (b *BeaconChainConfig) ReorgLateBlockCutoff() time.Duration {
+	if b.ReorgLateBlockCutoffMillis == 0 {
+		return b.attestationDeadline()
+	}
+	return time.Duration(b.ReorgLateBlockCutoffMillis) * time.Millisecond
+}
+
+// ReorgProposerCutoff returns the time into its slot before which a proposer has to propose to
+// orphan a late head block, which defaults to half the attestation deadline (2 seconds on mainnet).
+func (b *BeaconChainConfig) ReorgProposerCutoff() time.Duration {
+	if b.ReorgProposerCutoffMillis == 0 {
+		return b.attestationDeadline() / 2
+	}
+	return time.Duration(b.ReorgProposerCutoffMillis) * time.Millisecond
+}
+
+// attestationDeadline returns the time into its slot at which attestations are due.
+func (b *BeaconChainConfig) attestationDeadline() time.Duration {
+	return time.Duration(b.SecondsPerSlot) * time.Second / time.Duration(b.IntervalsPerSlot)
+}
+
 // DenebEnabled centralizes the check to determine if code paths
 // that are specific to deneb should be allowed to execute. This will make it easier to find call sites that do this
 // kind of check and remove them post-deneb.
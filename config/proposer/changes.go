@@ -0,0 +1,104 @@
+package proposer
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+)
+
+// DefaultConfigKey identifies the default configuration in setting changes.
+const DefaultConfigKey = "default"
+
+// SettingChange is the change of a single proposer setting of a public key or of the default configuration.
+// An unset value is represented by an empty string.
+type SettingChange struct {
+	PubKey string `json:"pubkey"`
+	Field  string `json:"field"`
+	Old    string `json:"old"`
+	New    string `json:"new"`
+}
+
+// SettingsChanges returns the changes of fee recipient, builder and graffiti settings from one proposer settings to another,
+// starting with the default configuration, followed by the public keys in lexicographic order.
+func SettingsChanges(from, to *Settings) []*SettingChange {
+	changes := optionChanges(DefaultConfigKey, defaultConfig(from), defaultConfig(to))
+
+	keys := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	if from != nil {
+		for key := range from.ProposeConfig {
+			keys[key] = true
+		}
+	}
+	if to != nil {
+		for key := range to.ProposeConfig {
+			keys[key] = true
+		}
+	}
+	hexKeys := make([]string, 0, len(keys))
+	byHexKey := make(map[string][fieldparams.BLSPubkeyLength]byte, len(keys))
+	for key := range keys {
+		hexKey := hexutil.Encode(key[:])
+		hexKeys = append(hexKeys, hexKey)
+		byHexKey[hexKey] = key
+	}
+	sort.Strings(hexKeys)
+
+	for _, hexKey := range hexKeys {
+		key := byHexKey[hexKey]
+		changes = append(changes, optionChanges(hexKey, proposeConfig(from, key), proposeConfig(to, key))...)
+	}
+	return changes
+}
+
+func defaultConfig(settings *Settings) *Option {
+	if settings == nil {
+		return nil
+	}
+	return settings.DefaultConfig
+}
+
+func proposeConfig(settings *Settings, key [fieldparams.BLSPubkeyLength]byte) *Option {
+	if settings == nil {
+		return nil
+	}
+	return settings.ProposeConfig[key]
+}
+
+func optionChanges(key string, from, to *Option) []*SettingChange {
+	fromValues, toValues := optionValues(from), optionValues(to)
+	changes := make([]*SettingChange, 0)
+	for i, value := range fromValues {
+		if value[1] != toValues[i][1] {
+			changes = append(changes, &SettingChange{PubKey: key, Field: value[0], Old: value[1], New: toValues[i][1]})
+		}
+	}
+	return changes
+}
+
+// optionValues returns the field names and string values of a proposer option, always in the same order.
+func optionValues(option *Option) [][2]string {
+	var feeRecipient, builderEnabled, gasLimit, relays, graffiti string
+	if option != nil {
+		if option.FeeRecipientConfig != nil {
+			feeRecipient = option.FeeRecipientConfig.FeeRecipient.Hex()
+		}
+		if option.BuilderConfig != nil {
+			builderEnabled = strconv.FormatBool(option.BuilderConfig.Enabled)
+			gasLimit = strconv.FormatUint(uint64(option.BuilderConfig.GasLimit), 10)
+			relays = strings.Join(option.BuilderConfig.Relays, ",")
+		}
+		if option.GraffitiConfig != nil {
+			graffiti = option.GraffitiConfig.Graffiti
+		}
+	}
+	return [][2]string{
+		{"fee_recipient", feeRecipient},
+		{"builder_enabled", builderEnabled},
+		{"gas_limit", gasLimit},
+		{"relays", relays},
+		{"graffiti", graffiti},
+	}
+}
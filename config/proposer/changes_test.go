@@ -0,0 +1,65 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSettingsChanges(t *testing.T) {
+	key1hex := "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+	key2hex := "0xb057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+	key1, err := hexutil.Decode(key1hex)
+	require.NoError(t, err)
+	key2, err := hexutil.Decode(key2hex)
+	require.NoError(t, err)
+	feeRecipient1 := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	feeRecipient2 := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+
+	from := &Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*Option{
+			bytesutil.ToBytes48(key1): {
+				FeeRecipientConfig: &FeeRecipientConfig{FeeRecipient: feeRecipient1},
+				BuilderConfig:      &BuilderConfig{Enabled: true, GasLimit: 30000000},
+			},
+			bytesutil.ToBytes48(key2): {
+				FeeRecipientConfig: &FeeRecipientConfig{FeeRecipient: feeRecipient1},
+			},
+		},
+		DefaultConfig: &Option{
+			FeeRecipientConfig: &FeeRecipientConfig{FeeRecipient: feeRecipient1},
+		},
+	}
+
+	t.Run("no change", func(t *testing.T) {
+		require.Equal(t, 0, len(SettingsChanges(from, from.Clone())))
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		to := from.Clone()
+		to.DefaultConfig.FeeRecipientConfig.FeeRecipient = feeRecipient2
+		to.ProposeConfig[bytesutil.ToBytes48(key1)].BuilderConfig.GasLimit = 36000000
+		to.ProposeConfig[bytesutil.ToBytes48(key1)].GraffitiConfig = &GraffitiConfig{Graffiti: "prysm"}
+		delete(to.ProposeConfig, bytesutil.ToBytes48(key2))
+
+		want := []*SettingChange{
+			{PubKey: DefaultConfigKey, Field: "fee_recipient", Old: feeRecipient1.Hex(), New: feeRecipient2.Hex()},
+			{PubKey: key1hex, Field: "gas_limit", Old: "30000000", New: "36000000"},
+			{PubKey: key1hex, Field: "graffiti", Old: "", New: "prysm"},
+			{PubKey: key2hex, Field: "fee_recipient", Old: feeRecipient1.Hex(), New: ""},
+		}
+		require.DeepEqual(t, want, SettingsChanges(from, to))
+	})
+
+	t.Run("from nil settings", func(t *testing.T) {
+		changes := SettingsChanges(nil, from)
+		require.Equal(t, 5, len(changes))
+		require.Equal(t, DefaultConfigKey, changes[0].PubKey)
+		require.Equal(t, "", changes[0].Old)
+		require.Equal(t, feeRecipient1.Hex(), changes[0].New)
+	})
+}
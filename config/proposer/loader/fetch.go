@@ -0,0 +1,213 @@
+package loader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// settingsFetchTimeout is the maximum duration of a request fetching the proposer settings.
+const settingsFetchTimeout = 30 * time.Second
+
+// newSettingsClient returns the HTTP client fetching the proposer settings, both at startup and when
+// refreshing them. Requests time out after settingsFetchTimeout, or after the refresh interval if shorter.
+func newSettingsClient(cliCtx *cli.Context) *http.Client {
+	timeout := settingsFetchTimeout
+	if interval := cliCtx.Duration(flags.ProposerSettingsRefreshIntervalFlag.Name); interval > 0 && interval < timeout {
+		timeout = interval
+	}
+	return &http.Client{Timeout: timeout}
+}
+
+// settingsVerifier verifies the detached signature of a proposer settings payload.
+type settingsVerifier interface {
+	verify(payload, signature []byte) error
+}
+
+// blsVerifier verifies BLS signatures of the SHA-256 hash of the payload.
+type blsVerifier struct {
+	pubKey bls.PublicKey
+}
+
+func (v *blsVerifier) verify(payload, signature []byte) error {
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return errors.Wrap(err, "could not parse BLS signature")
+	}
+	hash := sha256.Sum256(payload)
+	if !sig.Verify(v.pubKey, hash[:]) {
+		return errors.New("invalid BLS signature")
+	}
+	return nil
+}
+
+// ecdsaVerifier verifies secp256k1 signatures of the Keccak-256 hash of the payload.
+type ecdsaVerifier struct {
+	pubKey []byte
+}
+
+func (v *ecdsaVerifier) verify(payload, signature []byte) error {
+	// Signatures may contain the recovery ID as their last byte, which is not needed to verify them.
+	if len(signature) == crypto.SignatureLength {
+		signature = signature[:crypto.SignatureLength-1]
+	}
+	if len(signature) != crypto.SignatureLength-1 {
+		return fmt.Errorf("secp256k1 signature must be %d or %d bytes long, got %d", crypto.SignatureLength-1, crypto.SignatureLength, len(signature))
+	}
+	if !crypto.VerifySignature(v.pubKey, crypto.Keccak256(payload), signature) {
+		return errors.New("invalid secp256k1 signature")
+	}
+	return nil
+}
+
+// newSettingsVerifier returns the verifier matching the type of the hex encoded public key,
+// which is either a BLS public key or a compressed or uncompressed secp256k1 public key.
+func newSettingsVerifier(hexPubKey string) (settingsVerifier, error) {
+	pubKey, err := hexutil.Decode(hexPubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode verification key")
+	}
+	switch len(pubKey) {
+	case 48:
+		blsPubKey, err := bls.PublicKeyFromBytes(pubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse BLS verification key")
+		}
+		return &blsVerifier{pubKey: blsPubKey}, nil
+	case 33:
+		if _, err := crypto.DecompressPubkey(pubKey); err != nil {
+			return nil, errors.Wrap(err, "could not parse secp256k1 verification key")
+		}
+		return &ecdsaVerifier{pubKey: pubKey}, nil
+	case 65:
+		if _, err := crypto.UnmarshalPubkey(pubKey); err != nil {
+			return nil, errors.Wrap(err, "could not parse secp256k1 verification key")
+		}
+		return &ecdsaVerifier{pubKey: pubKey}, nil
+	default:
+		return nil, fmt.Errorf("verification key must be a 48 bytes BLS public key or a 33 or 65 bytes secp256k1 public key, got %d bytes", len(pubKey))
+	}
+}
+
+// fetchedSettings is the result of fetching proposer settings from a URL.
+type fetchedSettings struct {
+	// notModified is true if the server answered the settings did not change since the given ETag.
+	notModified bool
+	etag        string
+	hash        [32]byte
+	payload     *validatorpb.ProposerSettingsPayload
+}
+
+// fetchSettings gets proposer settings from a URL. If etag is not empty, it is sent in the If-None-Match header
+// so the server can answer the settings did not change. If a verifier is given, the detached signature is fetched
+// from signatureURL and verified against the raw settings before they are decoded.
+func fetchSettings(
+	ctx context.Context,
+	client *http.Client,
+	settingsURL, etag string,
+	verifier settingsVerifier,
+	signatureURL string,
+) (*fetchedSettings, error) {
+	resp, err := get(ctx, client, settingsURL, etag)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if etag != "" && resp.StatusCode == http.StatusNotModified {
+		return &fetchedSettings{notModified: true, etag: etag}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("http request to %v failed with status code %d", settingsURL, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read http response")
+	}
+
+	if verifier != nil {
+		signature, err := fetchSignature(ctx, client, signatureURL)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifier.verify(body, signature); err != nil {
+			return nil, errors.Wrap(err, "could not verify proposer settings signature")
+		}
+	}
+
+	var payload *validatorpb.ProposerSettingsPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, errors.Wrap(err, "failed to decode http response")
+	}
+	return &fetchedSettings{
+		etag:    resp.Header.Get("ETag"),
+		hash:    sha256.Sum256(body),
+		payload: payload,
+	}, nil
+}
+
+// fetchSignature gets a hex encoded signature from a URL.
+func fetchSignature(ctx context.Context, client *http.Client, signatureURL string) ([]byte, error) {
+	resp, err := get(ctx, client, signatureURL, "")
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("http request to %v failed with status code %d", signatureURL, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read http response")
+	}
+	signature, err := hexutil.Decode(strings.TrimSpace(string(body)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode proposer settings signature")
+	}
+	return signature, nil
+}
+
+func get(ctx context.Context, client *http.Client, from, etag string) (*http.Response, error) {
+	u, err := url.ParseRequestURI(from)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid URL: %s", from)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, from, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create http request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send http request")
+	}
+	return resp, nil
+}
+
+func closeBody(body io.ReadCloser) {
+	if err := body.Close(); err != nil {
+		log.WithError(err).Error("Failed to close response body")
+	}
+}
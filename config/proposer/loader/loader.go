@@ -38,6 +38,8 @@ type settingsLoader struct {
 type flagOptions struct {
 	builderConfig *proposer.BuilderConfig
 	gasLimit      *validator.Uint64
+	verifier      settingsVerifier
+	signatureURL  string
 }
 
 // SettingsLoaderOption sets additional options that affect the proposer settings
@@ -75,6 +77,32 @@ func WithGasLimit() SettingsLoaderOption {
 	}
 }
 
+// WithSettingsVerification applies the --proposer-settings-verification-key and --proposer-settings-signature-url flags,
+// verifying the signature of the proposer settings fetched from --proposer-settings-url
+func WithSettingsVerification() SettingsLoaderOption {
+	return func(cliCtx *cli.Context, psl *settingsLoader) error {
+		if !cliCtx.IsSet(flags.ProposerSettingsVerificationKeyFlag.Name) {
+			if cliCtx.IsSet(flags.ProposerSettingsSignatureURLFlag.Name) {
+				return errors.Errorf("--%s requires --%s", flags.ProposerSettingsSignatureURLFlag.Name, flags.ProposerSettingsVerificationKeyFlag.Name)
+			}
+			return nil
+		}
+		if !cliCtx.IsSet(flags.ProposerSettingsURLFlag.Name) {
+			return errors.Errorf("--%s requires --%s", flags.ProposerSettingsVerificationKeyFlag.Name, flags.ProposerSettingsURLFlag.Name)
+		}
+		if !cliCtx.IsSet(flags.ProposerSettingsSignatureURLFlag.Name) {
+			return errors.Errorf("--%s requires --%s", flags.ProposerSettingsVerificationKeyFlag.Name, flags.ProposerSettingsSignatureURLFlag.Name)
+		}
+		verifier, err := newSettingsVerifier(cliCtx.String(flags.ProposerSettingsVerificationKeyFlag.Name))
+		if err != nil {
+			return errors.Wrapf(err, "invalid --%s", flags.ProposerSettingsVerificationKeyFlag.Name)
+		}
+		psl.options.verifier = verifier
+		psl.options.signatureURL = cliCtx.String(flags.ProposerSettingsSignatureURLFlag.Name)
+		return nil
+	}
+}
+
 // NewProposerSettingsLoader returns a new proposer settings loader that can process the proposer settings based on flag options
 func NewProposerSettingsLoader(cliCtx *cli.Context, db iface.ValidatorDB, opts ...SettingsLoaderOption) (*settingsLoader, error) {
 	if cliCtx.IsSet(flags.ProposerSettingsFlag.Name) && cliCtx.IsSet(flags.ProposerSettingsURLFlag.Name) {
@@ -146,20 +174,11 @@ func (psl *settingsLoader) Load(cliCtx *cli.Context) (*proposer.Settings, error)
 				// only log the below if default flag is the only load method
 				log.Warn("Previously saved proposer settings were loaded from the DB, only default settings will be updated. Please provide new proposer settings or clear DB to reset proposer settings.")
 			}
-			suggestedFeeRecipient := cliCtx.String(flags.SuggestedFeeRecipientFlag.Name)
-			if !common.IsHexAddress(suggestedFeeRecipient) {
-				return nil, errors.Errorf("--%s is not a valid Ethereum address", flags.SuggestedFeeRecipientFlag.Name)
-			}
-			if err := config.WarnNonChecksummedAddress(suggestedFeeRecipient); err != nil {
+			suggestedFeeRecipient, err := suggestedFeeRecipientFromFlag(cliCtx)
+			if err != nil {
 				return nil, err
 			}
-			defaultConfig := &validatorpb.ProposerOptionPayload{
-				FeeRecipient: suggestedFeeRecipient,
-			}
-			if psl.options.builderConfig != nil {
-				defaultConfig.Builder = psl.options.builderConfig.ToConsensus()
-			}
-			loadConfig.DefaultConfig = defaultConfig
+			loadConfig.DefaultConfig = psl.defaultConfigFromFlags(suggestedFeeRecipient)
 		case fileFlag:
 			var settingFromFile *validatorpb.ProposerSettingsPayload
 			if err := config.UnmarshalFromFile(cliCtx.String(flags.ProposerSettingsFlag.Name), &settingFromFile); err != nil {
@@ -171,10 +190,11 @@ func (psl *settingsLoader) Load(cliCtx *cli.Context) (*proposer.Settings, error)
 			loadConfig = psl.processProposerSettings(settingFromFile, loadConfig)
 			log.WithField(flags.ProposerSettingsFlag.Name, cliCtx.String(flags.ProposerSettingsFlag.Name)).Info("Proposer settings loaded from file")
 		case urlFlag:
-			var settingFromURL *validatorpb.ProposerSettingsPayload
-			if err := config.UnmarshalFromURL(cliCtx.Context, cliCtx.String(flags.ProposerSettingsURLFlag.Name), &settingFromURL); err != nil {
+			fetched, err := fetchSettings(cliCtx.Context, newSettingsClient(cliCtx), cliCtx.String(flags.ProposerSettingsURLFlag.Name), "", psl.options.verifier, psl.options.signatureURL)
+			if err != nil {
 				return nil, err
 			}
+			settingFromURL := fetched.payload
 			if settingFromURL == nil {
 				return nil, errors.New("proposer settings is empty after unmarshalling from url")
 			}
@@ -215,6 +235,29 @@ func (psl *settingsLoader) Load(cliCtx *cli.Context) (*proposer.Settings, error)
 	return ps, nil
 }
 
+// suggestedFeeRecipientFromFlag returns the fee recipient set by --suggested-fee-recipient, once validated.
+func suggestedFeeRecipientFromFlag(cliCtx *cli.Context) (string, error) {
+	suggestedFeeRecipient := cliCtx.String(flags.SuggestedFeeRecipientFlag.Name)
+	if !common.IsHexAddress(suggestedFeeRecipient) {
+		return "", errors.Errorf("--%s is not a valid Ethereum address", flags.SuggestedFeeRecipientFlag.Name)
+	}
+	if err := config.WarnNonChecksummedAddress(suggestedFeeRecipient); err != nil {
+		return "", err
+	}
+	return suggestedFeeRecipient, nil
+}
+
+// defaultConfigFromFlags returns the default proposer config set by --suggested-fee-recipient and the builder flags.
+func (psl *settingsLoader) defaultConfigFromFlags(suggestedFeeRecipient string) *validatorpb.ProposerOptionPayload {
+	defaultConfig := &validatorpb.ProposerOptionPayload{
+		FeeRecipient: suggestedFeeRecipient,
+	}
+	if psl.options.builderConfig != nil {
+		defaultConfig.Builder = psl.options.builderConfig.ToConsensus()
+	}
+	return defaultConfig
+}
+
 func (psl *settingsLoader) processProposerSettings(loadedSettings, dbSettings *validatorpb.ProposerSettingsPayload) *validatorpb.ProposerSettingsPayload {
 	if loadedSettings == nil && dbSettings == nil {
 		return nil
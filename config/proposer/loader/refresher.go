@@ -0,0 +1,221 @@
+package loader
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// SettingsSetter gets and sets the proposer settings of the running validator client.
+type SettingsSetter interface {
+	ProposerSettings() *proposer.Settings
+	SetProposerSettings(ctx context.Context, settings *proposer.Settings) error
+}
+
+// SettingsRefresher is a service periodically fetching the proposer settings from --proposer-settings-url
+// and applying them to the running validator client.
+type SettingsRefresher struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	psl          *settingsLoader
+	setter       SettingsSetter
+	client       *http.Client
+	url          string
+	interval     time.Duration
+	auditLogPath string
+	// suggestedFeeRecipient is the default fee recipient set by --suggested-fee-recipient, if any.
+	suggestedFeeRecipient string
+
+	lock sync.Mutex
+	etag string
+	hash [32]byte
+	err  error
+}
+
+// auditEntry is a line of the proposer settings audit log.
+type auditEntry struct {
+	Time   string `json:"time"`
+	Source string `json:"source"`
+	ETag   string `json:"etag,omitempty"`
+	*proposer.SettingChange
+}
+
+// NewSettingsRefresher returns a service refreshing the proposer settings from --proposer-settings-url
+// every --proposer-settings-refresh-interval. Options are applied the same way as when loading the settings at startup.
+func NewSettingsRefresher(cliCtx *cli.Context, setter SettingsSetter, opts ...SettingsLoaderOption) (*SettingsRefresher, error) {
+	if !cliCtx.IsSet(flags.ProposerSettingsURLFlag.Name) {
+		return nil, errors.Errorf("--%s requires --%s", flags.ProposerSettingsRefreshIntervalFlag.Name, flags.ProposerSettingsURLFlag.Name)
+	}
+	interval := cliCtx.Duration(flags.ProposerSettingsRefreshIntervalFlag.Name)
+	if interval <= 0 {
+		return nil, errors.Errorf("--%s must be positive", flags.ProposerSettingsRefreshIntervalFlag.Name)
+	}
+
+	psl := &settingsLoader{options: &flagOptions{}}
+	for _, o := range opts {
+		if err := o(cliCtx, psl); err != nil {
+			return nil, err
+		}
+	}
+	if psl.options.builderConfig != nil && psl.options.gasLimit != nil {
+		psl.options.builderConfig.GasLimit = *psl.options.gasLimit
+	}
+	var suggestedFeeRecipient string
+	if cliCtx.IsSet(flags.SuggestedFeeRecipientFlag.Name) {
+		var err error
+		suggestedFeeRecipient, err = suggestedFeeRecipientFromFlag(cliCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(cliCtx.Context)
+	return &SettingsRefresher{
+		ctx:          ctx,
+		cancel:       cancel,
+		psl:          psl,
+		setter:       setter,
+		client:       newSettingsClient(cliCtx),
+		url:          cliCtx.String(flags.ProposerSettingsURLFlag.Name),
+		interval:     interval,
+		auditLogPath: cliCtx.String(flags.ProposerSettingsAuditLogFlag.Name),
+
+		suggestedFeeRecipient: suggestedFeeRecipient,
+	}, nil
+}
+
+// Start the refresh loop.
+func (r *SettingsRefresher) Start() {
+	log.WithField("url", r.url).WithField("interval", r.interval).Info("Refreshing proposer settings periodically")
+	go r.run()
+}
+
+// Stop the refresh loop.
+func (r *SettingsRefresher) Stop() error {
+	r.cancel()
+	return nil
+}
+
+// Status returns the error of the last refresh, if any.
+func (r *SettingsRefresher) Status() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+func (r *SettingsRefresher) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			err := r.Refresh(r.ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not refresh proposer settings, keeping the current ones")
+			}
+			r.lock.Lock()
+			r.err = err
+			r.lock.Unlock()
+		}
+	}
+}
+
+// Refresh fetches the proposer settings and applies them if they changed. The new settings are built from the
+// fetched ones and the defaults set by flags only, so that the settings removed from the fetched ones are removed
+// from the validator client. They are entirely processed and verified before being applied at once, so an invalid
+// update leaves the current settings untouched.
+func (r *SettingsRefresher) Refresh(ctx context.Context) error {
+	r.lock.Lock()
+	etag, hash := r.etag, r.hash
+	r.lock.Unlock()
+
+	fetched, err := fetchSettings(ctx, r.client, r.url, etag, r.psl.options.verifier, r.psl.options.signatureURL)
+	if err != nil {
+		return err
+	}
+	if fetched.notModified || fetched.hash == hash {
+		r.setVersion(fetched.etag, hash)
+		return nil
+	}
+	if fetched.payload == nil {
+		return errors.New("proposer settings is empty after unmarshalling from url")
+	}
+
+	defaults := &validatorpb.ProposerSettingsPayload{}
+	if r.suggestedFeeRecipient != "" {
+		defaults.DefaultConfig = r.psl.defaultConfigFromFlags(r.suggestedFeeRecipient)
+	}
+	loadConfig := r.psl.processProposerSettings(fetched.payload, defaults)
+	if loadConfig == nil || (loadConfig.ProposerConfig == nil && loadConfig.DefaultConfig == nil) {
+		return errors.New("no proposer settings after processing the settings fetched from url")
+	}
+	settings, err := proposer.SettingFromConsensus(loadConfig)
+	if err != nil {
+		return errors.Wrap(err, "invalid proposer settings")
+	}
+
+	changes := proposer.SettingsChanges(r.setter.ProposerSettings(), settings)
+	if len(changes) != 0 {
+		if err := r.setter.SetProposerSettings(ctx, settings); err != nil {
+			return errors.Wrap(err, "could not apply proposer settings")
+		}
+		r.audit(fetched.etag, changes)
+	}
+	r.setVersion(fetched.etag, fetched.hash)
+	return nil
+}
+
+func (r *SettingsRefresher) setVersion(etag string, hash [32]byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.etag = etag
+	r.hash = hash
+}
+
+// audit logs every applied change and appends it to the audit log file, if any.
+func (r *SettingsRefresher) audit(etag string, changes []*proposer.SettingChange) {
+	for _, change := range changes {
+		log.WithFields(log.Fields{
+			"pubkey": change.PubKey,
+			"field":  change.Field,
+			"old":    change.Old,
+			"new":    change.New,
+		}).Info("Proposer setting changed")
+	}
+	if r.auditLogPath == "" {
+		return
+	}
+
+	f, err := os.OpenFile(r.auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		log.WithError(err).Error("Could not open proposer settings audit log")
+		return
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close proposer settings audit log")
+		}
+	}()
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	encoder := json.NewEncoder(f)
+	for _, change := range changes {
+		if err := encoder.Encode(&auditEntry{Time: now, Source: r.url, ETag: etag, SettingChange: change}); err != nil {
+			log.WithError(err).Error("Could not write proposer settings audit log")
+			return
+		}
+	}
+}
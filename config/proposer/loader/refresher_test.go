@@ -0,0 +1,248 @@
+package loader
+
+import (
+	"context"
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	refreshedFeeRecipient = "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"
+	refreshedSettings     = `{"default_config":{"fee_recipient":"` + refreshedFeeRecipient + `"}}`
+)
+
+type settingsSetter struct {
+	settings *proposer.Settings
+	setCount int
+}
+
+func (s *settingsSetter) ProposerSettings() *proposer.Settings {
+	return s.settings.Clone()
+}
+
+func (s *settingsSetter) SetProposerSettings(_ context.Context, settings *proposer.Settings) error {
+	s.settings = settings
+	s.setCount++
+	return nil
+}
+
+// settingsServer serves proposer settings with an ETag and their detached signature.
+type settingsServer struct {
+	lock      sync.Mutex
+	body      string
+	signature string
+	requests  int
+}
+
+func (s *settingsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if r.URL.Path == "/signature" {
+		_, err := fmt.Fprint(w, s.signature)
+		if err != nil {
+			panic(err)
+		}
+		return
+	}
+	s.requests++
+	hash := sha256.Sum256([]byte(s.body))
+	etag := fmt.Sprintf(`"%x"`, hash[:8])
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	_, err := fmt.Fprint(w, s.body)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newRefresherCliContext(t *testing.T, values map[string]string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	for name, value := range values {
+		set.String(name, value, "")
+		require.NoError(t, set.Set(name, value))
+	}
+	ctx := cli.NewContext(&app, set, nil)
+	ctx.Context = context.Background()
+	return ctx
+}
+
+func TestSettingsRefresher_Refresh(t *testing.T) {
+	srv := &settingsServer{body: refreshedSettings}
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	auditLogPath := filepath.Join(t.TempDir(), "audit.log")
+	cliCtx := newRefresherCliContext(t, map[string]string{
+		flags.ProposerSettingsURLFlag.Name:             httpServer.URL,
+		flags.ProposerSettingsRefreshIntervalFlag.Name: "1m",
+		flags.ProposerSettingsAuditLogFlag.Name:        auditLogPath,
+	})
+	setter := &settingsSetter{}
+	r, err := NewSettingsRefresher(cliCtx, setter)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Refresh(cliCtx.Context))
+	require.Equal(t, 1, setter.setCount)
+	require.Equal(t, common.HexToAddress(refreshedFeeRecipient), setter.settings.DefaultConfig.FeeRecipientConfig.FeeRecipient)
+
+	// The server answers the settings did not change.
+	require.NoError(t, r.Refresh(cliCtx.Context))
+	require.Equal(t, 1, setter.setCount)
+	require.Equal(t, 2, srv.requests)
+
+	srv.lock.Lock()
+	srv.body = `{"default_config":{"fee_recipient":"` + refreshedFeeRecipient + `","graffiti":"refreshed"}}`
+	srv.lock.Unlock()
+	require.NoError(t, r.Refresh(cliCtx.Context))
+	require.Equal(t, 2, setter.setCount)
+	require.Equal(t, "refreshed", setter.settings.DefaultConfig.GraffitiConfig.Graffiti)
+
+	// Invalid settings are not applied.
+	srv.lock.Lock()
+	srv.body = `{"default_config":{"fee_recipient":"0x1"}}`
+	srv.lock.Unlock()
+	require.ErrorContains(t, "invalid proposer settings", r.Refresh(cliCtx.Context))
+	require.Equal(t, 2, setter.setCount)
+
+	auditLog, err := os.ReadFile(auditLogPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(auditLog)), "\n")
+	require.Equal(t, 2, len(lines))
+	assert.StringContains(t, `"pubkey":"default","field":"fee_recipient","old":"","new":"`+refreshedFeeRecipient+`"`, lines[0])
+	assert.StringContains(t, `"field":"graffiti","old":"","new":"refreshed"`, lines[1])
+}
+
+func TestSettingsRefresher_Refresh_RemovedKey(t *testing.T) {
+	const (
+		pubkey                = "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+		suggestedFeeRecipient = "0x6e35733c5af9B61374A128e6F85f553aF09ff89A"
+	)
+	srv := &settingsServer{body: `{"proposer_config":{"` + pubkey + `":{"fee_recipient":"` + refreshedFeeRecipient + `"}}}`}
+	httpServer := httptest.NewServer(srv)
+	defer httpServer.Close()
+
+	cliCtx := newRefresherCliContext(t, map[string]string{
+		flags.ProposerSettingsURLFlag.Name:             httpServer.URL,
+		flags.ProposerSettingsRefreshIntervalFlag.Name: "1m",
+		flags.SuggestedFeeRecipientFlag.Name:           suggestedFeeRecipient,
+	})
+	setter := &settingsSetter{}
+	r, err := NewSettingsRefresher(cliCtx, setter)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Refresh(cliCtx.Context))
+	require.Equal(t, 1, len(setter.settings.ProposeConfig))
+	require.Equal(t, common.HexToAddress(suggestedFeeRecipient), setter.settings.DefaultConfig.FeeRecipientConfig.FeeRecipient)
+
+	// The settings of the key removed from the fetched ones are removed, the defaults set by flags are kept.
+	srv.lock.Lock()
+	srv.body = `{}`
+	srv.lock.Unlock()
+	require.NoError(t, r.Refresh(cliCtx.Context))
+	require.Equal(t, 2, setter.setCount)
+	require.Equal(t, 0, len(setter.settings.ProposeConfig))
+	require.Equal(t, common.HexToAddress(suggestedFeeRecipient), setter.settings.DefaultConfig.FeeRecipientConfig.FeeRecipient)
+}
+
+func TestSettingsRefresher_Signature(t *testing.T) {
+	ecdsaKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ecdsaSignature, err := crypto.Sign(crypto.Keccak256([]byte(refreshedSettings)), ecdsaKey)
+	require.NoError(t, err)
+
+	blsKey, err := bls.RandKey()
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte(refreshedSettings))
+	blsSignature := blsKey.Sign(hash[:]).Marshal()
+
+	otherBLSKey, err := bls.RandKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		key       string
+		signature string
+		wantErr   string
+	}{
+		{
+			name:      "secp256k1",
+			key:       hexutil.Encode(crypto.CompressPubkey(&ecdsaKey.PublicKey)),
+			signature: hexutil.Encode(ecdsaSignature),
+		},
+		{
+			name:      "BLS",
+			key:       hexutil.Encode(blsKey.PublicKey().Marshal()),
+			signature: hexutil.Encode(blsSignature) + "\n",
+		},
+		{
+			name:      "wrong key",
+			key:       hexutil.Encode(otherBLSKey.PublicKey().Marshal()),
+			signature: hexutil.Encode(blsSignature),
+			wantErr:   "invalid BLS signature",
+		},
+		{
+			name:      "wrong signature type",
+			key:       hexutil.Encode(crypto.FromECDSAPub(&ecdsaKey.PublicKey)),
+			signature: hexutil.Encode(blsSignature),
+			wantErr:   "secp256k1 signature must be 64 or 65 bytes long",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &settingsServer{body: refreshedSettings, signature: tt.signature}
+			httpServer := httptest.NewServer(srv)
+			defer httpServer.Close()
+
+			cliCtx := newRefresherCliContext(t, map[string]string{
+				flags.ProposerSettingsURLFlag.Name:             httpServer.URL,
+				flags.ProposerSettingsRefreshIntervalFlag.Name: "1m",
+				flags.ProposerSettingsSignatureURLFlag.Name:    httpServer.URL + "/signature",
+				flags.ProposerSettingsVerificationKeyFlag.Name: tt.key,
+			})
+			setter := &settingsSetter{}
+			r, err := NewSettingsRefresher(cliCtx, setter, WithSettingsVerification())
+			require.NoError(t, err)
+
+			err = r.Refresh(cliCtx.Context)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				require.Equal(t, 0, setter.setCount)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, setter.setCount)
+		})
+	}
+}
+
+func TestWithSettingsVerification_MissingSignatureURL(t *testing.T) {
+	cliCtx := newRefresherCliContext(t, map[string]string{
+		flags.ProposerSettingsURLFlag.Name:             "http://localhost",
+		flags.ProposerSettingsRefreshIntervalFlag.Name: "1m",
+		flags.ProposerSettingsVerificationKeyFlag.Name: "0x01",
+	})
+	_, err := NewSettingsRefresher(cliCtx, &settingsSetter{}, WithSettingsVerification())
+	require.ErrorContains(t, "--proposer-settings-verification-key requires --proposer-settings-signature-url", err)
+}
@@ -58,6 +58,9 @@ func SettingFromConsensus(ps *validatorpb.ProposerSettingsPayload) (*Settings, e
 		if ps.DefaultConfig.Builder != nil {
 			d.BuilderConfig = BuilderConfigFromConsensus(ps.DefaultConfig.Builder)
 		}
+		if ps.DefaultConfig.Graffiti != nil {
+			d.GraffitiConfig = &GraffitiConfig{*ps.DefaultConfig.Graffiti}
+		}
 		settings.DefaultConfig = d
 	}
 	return settings, nil
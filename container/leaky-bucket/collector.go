@@ -81,6 +81,11 @@ func (c *Collector) Rate() float64 {
 	return c.rate
 }
 
+// Period returns the collector's period, the rate being expressed per period.
+func (c *Collector) Period() time.Duration {
+	return c.period
+}
+
 // Remaining returns the remaining capacity of the internal bucket associated
 // with key.  If key is not associated with a bucket internally, it is treated
 // as being empty.
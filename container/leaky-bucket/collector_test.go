@@ -23,6 +23,9 @@ func TestNewCollector(t *testing.T) {
 	if c.capacity != capacity || c.Capacity() != capacity {
 		t.Fatal("Wrong capacity?!")
 	}
+	if c.period != time.Second || c.Period() != time.Second {
+		t.Fatal("Wrong period?!")
+	}
 
 	c.Free()
 }
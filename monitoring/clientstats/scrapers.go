@@ -236,6 +236,23 @@ func populateBeaconNodeStats(pf metricMap) BeaconNodeStats {
 		}
 	}
 
+	f, err = pf.getFamily("powchain_execution_client_info")
+	if err != nil {
+		log.WithError(err).Debug("Failed to get powchain_execution_client_info")
+	} else {
+		m = f.Metric[0]
+		for _, l := range m.GetLabel() {
+			switch l.GetName() {
+			case "name":
+				bs.ExecutionClientName = l.GetValue()
+			case "version":
+				bs.ExecutionClientVersion = l.GetValue()
+			case "commit":
+				bs.ExecutionClientCommit = l.GetValue()
+			}
+		}
+	}
+
 	return bs
 }
 
@@ -55,6 +55,9 @@ func TestBeaconNodeScraper(t *testing.T) {
 	require.Equal(t, int64(7365341184), bs.DiskBeaconchainBytesTotal)
 	require.Equal(t, int64(37), bs.NetworkPeersConnected)
 	require.Equal(t, true, bs.SyncEth1Connected)
+	require.Equal(t, "Geth", bs.ExecutionClientName)
+	require.Equal(t, "1.14.8", bs.ExecutionClientVersion)
+	require.Equal(t, "0xa9523b64", bs.ExecutionClientCommit)
 }
 
 // helper function to wrap up all the scrape logic so tests can focus on data cases and assertions
@@ -242,6 +245,9 @@ p2p_peer_count{state="Disconnecting"} 0
 # HELP powchain_sync_eth1_connected Boolean indicating whether a fallback eth1 endpoint is currently connected: 0=false, 1=true.
 # TYPE powchain_sync_eth1_connected gauge
 powchain_sync_eth1_connected 1
+# HELP powchain_execution_client_info Name, version and commit of the connected execution client, as returned by engine_getClientVersionV1.
+# TYPE powchain_execution_client_info gauge
+powchain_execution_client_info{commit="0xa9523b64",name="Geth",version="1.14.8"} 1
 # HELP powchain_sync_eth1_fallback_configured Boolean recording whether a fallback eth1 endpoint was configured: 0=false, 1=true.
 # TYPE powchain_sync_eth1_fallback_configured gauge
 powchain_sync_eth1_fallback_configured 1
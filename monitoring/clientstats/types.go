@@ -61,7 +61,11 @@ type BeaconNodeStats struct {
 	NetworkPeersConnected int64 `json:"network_peers_connected"`
 	// beacon_head_slot
 	SyncBeaconHeadSlot int64 `json:"sync_beacon_head_slot"`
-	CommonStats        `json:",inline"`
+	// powchain_execution_client_info labels, as returned by engine_getClientVersionV1
+	ExecutionClientName    string `json:"execution_client_name"`
+	ExecutionClientVersion string `json:"execution_client_version"`
+	ExecutionClientCommit  string `json:"execution_client_commit"`
+	CommonStats            `json:",inline"`
 }
 
 // ValidatorStats embeds CommonStats and represents metrics specific to
@@ -100,6 +100,284 @@ func (x *BackfillStatus) GetOriginRoot() []byte {
 	return nil
 }
 
+type PayloadDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex        uint64   `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	Relays               []string `protobuf:"bytes,3,rep,name=relays,proto3" json:"relays,omitempty"`
+	BuilderBlockHash     []byte   `protobuf:"bytes,4,opt,name=builder_block_hash,json=builderBlockHash,proto3" json:"builder_block_hash,omitempty"`
+	BuilderValueGwei     uint64   `protobuf:"varint,5,opt,name=builder_value_gwei,json=builderValueGwei,proto3" json:"builder_value_gwei,omitempty"`
+	LocalValueGwei       uint64   `protobuf:"varint,6,opt,name=local_value_gwei,json=localValueGwei,proto3" json:"local_value_gwei,omitempty"`
+	BuilderBoostFactor   uint64   `protobuf:"varint,7,opt,name=builder_boost_factor,json=builderBoostFactor,proto3" json:"builder_boost_factor,omitempty"`
+	LocalBoostPercentage uint64   `protobuf:"varint,8,opt,name=local_boost_percentage,json=localBoostPercentage,proto3" json:"local_boost_percentage,omitempty"`
+	Source               string   `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	Reason               string   `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	Delivery             string   `protobuf:"bytes,11,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	DeliveryError        string   `protobuf:"bytes,12,opt,name=delivery_error,json=deliveryError,proto3" json:"delivery_error,omitempty"`
+}
+
+func (x *PayloadDecision) Reset() {
+	*x = PayloadDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dbval_dbval_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayloadDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadDecision) ProtoMessage() {}
+
+func (x *PayloadDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dbval_dbval_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadDecision.ProtoReflect.Descriptor instead.
+func (*PayloadDecision) Descriptor() ([]byte, []int) {
+	return file_proto_dbval_dbval_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayloadDecision) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *PayloadDecision) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *PayloadDecision) GetRelays() []string {
+	if x != nil {
+		return x.Relays
+	}
+	return nil
+}
+
+func (x *PayloadDecision) GetBuilderBlockHash() []byte {
+	if x != nil {
+		return x.BuilderBlockHash
+	}
+	return nil
+}
+
+func (x *PayloadDecision) GetBuilderValueGwei() uint64 {
+	if x != nil {
+		return x.BuilderValueGwei
+	}
+	return 0
+}
+
+func (x *PayloadDecision) GetLocalValueGwei() uint64 {
+	if x != nil {
+		return x.LocalValueGwei
+	}
+	return 0
+}
+
+func (x *PayloadDecision) GetBuilderBoostFactor() uint64 {
+	if x != nil {
+		return x.BuilderBoostFactor
+	}
+	return 0
+}
+
+func (x *PayloadDecision) GetLocalBoostPercentage() uint64 {
+	if x != nil {
+		return x.LocalBoostPercentage
+	}
+	return 0
+}
+
+func (x *PayloadDecision) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PayloadDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PayloadDecision) GetDelivery() string {
+	if x != nil {
+		return x.Delivery
+	}
+	return ""
+}
+
+func (x *PayloadDecision) GetDeliveryError() string {
+	if x != nil {
+		return x.DeliveryError
+	}
+	return ""
+}
+
+type ValidatorPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndex        uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Epoch                 uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Attested              bool     `protobuf:"varint,3,opt,name=attested,proto3" json:"attested,omitempty"`
+	AttestedSlot          uint64   `protobuf:"varint,4,opt,name=attested_slot,json=attestedSlot,proto3" json:"attested_slot,omitempty"`
+	InclusionSlot         uint64   `protobuf:"varint,5,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	CorrectSource         bool     `protobuf:"varint,6,opt,name=correct_source,json=correctSource,proto3" json:"correct_source,omitempty"`
+	CorrectTarget         bool     `protobuf:"varint,7,opt,name=correct_target,json=correctTarget,proto3" json:"correct_target,omitempty"`
+	CorrectHead           bool     `protobuf:"varint,8,opt,name=correct_head,json=correctHead,proto3" json:"correct_head,omitempty"`
+	ProposedSlots         []uint64 `protobuf:"varint,9,rep,packed,name=proposed_slots,json=proposedSlots,proto3" json:"proposed_slots,omitempty"`
+	SyncCommitteeExpected uint64   `protobuf:"varint,10,opt,name=sync_committee_expected,json=syncCommitteeExpected,proto3" json:"sync_committee_expected,omitempty"`
+	SyncCommitteeIncluded uint64   `protobuf:"varint,11,opt,name=sync_committee_included,json=syncCommitteeIncluded,proto3" json:"sync_committee_included,omitempty"`
+	Balance               uint64   `protobuf:"varint,12,opt,name=balance,proto3" json:"balance,omitempty"`
+	BalanceChange         int64    `protobuf:"varint,13,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+}
+
+func (x *ValidatorPerformance) Reset() {
+	*x = ValidatorPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_dbval_dbval_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformance) ProtoMessage() {}
+
+func (x *ValidatorPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_dbval_dbval_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPerformance.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return file_proto_dbval_dbval_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorPerformance) GetValidatorIndex() uint64 {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetAttested() bool {
+	if x != nil {
+		return x.Attested
+	}
+	return false
+}
+
+func (x *ValidatorPerformance) GetAttestedSlot() uint64 {
+	if x != nil {
+		return x.AttestedSlot
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetInclusionSlot() uint64 {
+	if x != nil {
+		return x.InclusionSlot
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetCorrectSource() bool {
+	if x != nil {
+		return x.CorrectSource
+	}
+	return false
+}
+
+func (x *ValidatorPerformance) GetCorrectTarget() bool {
+	if x != nil {
+		return x.CorrectTarget
+	}
+	return false
+}
+
+func (x *ValidatorPerformance) GetCorrectHead() bool {
+	if x != nil {
+		return x.CorrectHead
+	}
+	return false
+}
+
+func (x *ValidatorPerformance) GetProposedSlots() []uint64 {
+	if x != nil {
+		return x.ProposedSlots
+	}
+	return nil
+}
+
+func (x *ValidatorPerformance) GetSyncCommitteeExpected() uint64 {
+	if x != nil {
+		return x.SyncCommitteeExpected
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetSyncCommitteeIncluded() uint64 {
+	if x != nil {
+		return x.SyncCommitteeIncluded
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetBalanceChange() int64 {
+	if x != nil {
+		return x.BalanceChange
+	}
+	return 0
+}
+
 var File_proto_dbval_dbval_proto protoreflect.FileDescriptor
 
 var file_proto_dbval_dbval_proto_rawDesc = []byte{
@@ -117,10 +395,71 @@ var file_proto_dbval_dbval_proto_rawDesc = []byte{
 	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12,
 	0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x74,
-	0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
-	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79,
-	0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x62, 0x76, 0x61,
-	0x6c, 0x3b, 0x64, 0x62, 0x76, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0xc5, 0x03, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x67, 0x77, 0x65, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x47,
+	0x77, 0x65, 0x69, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x67, 0x77, 0x65, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x47, 0x77, 0x65, 0x69, 0x12, 0x30, 0x0a,
+	0x14, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x34, 0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x86, 0x04, 0x0a, 0x14, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x6f,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x48, 0x65, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x36, 0x0a, 0x17, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x73, 0x79, 0x6e, 0x63, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x62, 0x76,
+	0x61, 0x6c, 0x3b, 0x64, 0x62, 0x76, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -135,9 +474,11 @@ func file_proto_dbval_dbval_proto_rawDescGZIP() []byte {
 	return file_proto_dbval_dbval_proto_rawDescData
 }
 
-var file_proto_dbval_dbval_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_dbval_dbval_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_proto_dbval_dbval_proto_goTypes = []interface{}{
-	(*BackfillStatus)(nil), // 0: ethereum.eth.dbval.BackfillStatus
+	(*BackfillStatus)(nil),       // 0: ethereum.eth.dbval.BackfillStatus
+	(*PayloadDecision)(nil),      // 1: ethereum.eth.dbval.PayloadDecision
+	(*ValidatorPerformance)(nil), // 2: ethereum.eth.dbval.ValidatorPerformance
 }
 var file_proto_dbval_dbval_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -165,6 +506,30 @@ func file_proto_dbval_dbval_proto_init() {
 				return nil
 			}
 		}
+		file_proto_dbval_dbval_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayloadDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_dbval_dbval_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -172,7 +537,7 @@ func file_proto_dbval_dbval_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_dbval_dbval_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
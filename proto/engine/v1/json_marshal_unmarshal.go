@@ -328,6 +328,15 @@ type ExecutionPayloadElectraJSON struct {
 	ConsolidationRequests []ConsolidationRequestV1 `json:"consolidationRequests"`
 }
 
+// ClientVersionV1 represents an execution engine ClientVersionV1 value, identifying a client implementation.
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/identification.md#clientversionv1
+type ClientVersionV1 struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
 // ExecutionPayloadBody represents the engine API ExecutionPayloadV1 or ExecutionPayloadV2 type.
 type ExecutionPayloadBody struct {
 	Transactions          []hexutil.Bytes          `json:"transactions"`
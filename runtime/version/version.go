@@ -34,6 +34,12 @@ func SemanticVersion() string {
 	return gitTag
 }
 
+// GitCommit returns the git commit of this build.
+func GitCommit() string {
+	BuildData()
+	return gitCommit
+}
+
 // BuildData returns the git tag and commit of the current build.
 func BuildData() string {
 	// if doing a local build, these values are not interpolated
@@ -169,9 +169,10 @@ func (bb *Builder) Check(t testing.TB, c *Check) {
 		got := fmt.Sprintf("%#x", bb.service.GetProposerHead())
 		require.DeepEqual(t, want, got)
 	}
-	/* TODO: We need to mock the entire proposer system to be able to test this.
+	// The override is only considered by a node serving the next proposer, which the test sets
+	// rather than it being tracked by the node.
 	if c.ShouldOverrideFCU != nil {
-		require.DeepEqual(t, c.ShouldOverrideFCU.Result, bb.service.ShouldOverrideFCU())
+		got := c.ShouldOverrideFCU.ValidatorConnected && bb.service.ShouldOverrideFCU()
+		require.Equal(t, c.ShouldOverrideFCU.Result, got)
 	}
-	*/
 }
@@ -0,0 +1,57 @@
+package forkchoice
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/spectest/utils"
+)
+
+// RunLocal executes the "fork_choice" and "sync" tests found in a local directory rather than in the
+// bazel test data, such as the output directory of the consensus spec test generators or the tests
+// directory of a consensus spec tests release. The directory holds the tests of the preset the test
+// binary is built with under <dir>/<preset>/<fork>/, and the tests of every fork found there are run.
+func RunLocal(t *testing.T, dir string) {
+	require.NoError(t, utils.SetConfig(t, fieldparams.Preset))
+	presetDir := filepath.Join(dir, fieldparams.Preset)
+	forkFolders, err := os.ReadDir(presetDir)
+	require.NoError(t, err)
+
+	numCases := 0
+	for _, forkFolder := range forkFolders {
+		fork, err := version.FromString(forkFolder.Name())
+		if err != nil {
+			continue
+		}
+		for _, basePath := range []string{"fork_choice", "sync"} {
+			handlersPath := filepath.Join(presetDir, forkFolder.Name(), basePath)
+			handlerFolders, err := os.ReadDir(handlersPath)
+			if os.IsNotExist(err) {
+				continue
+			}
+			require.NoError(t, err)
+			for _, handlerFolder := range handlerFolders {
+				testsFolderPath := filepath.Join(handlersPath, handlerFolder.Name(), "pyspec_tests")
+				testFolders, err := os.ReadDir(testsFolderPath)
+				require.NoError(t, err)
+				for _, folder := range testFolders {
+					numCases++
+					testFolderPath := filepath.Join(testsFolderPath, folder.Name())
+					t.Run(path.Join(forkFolder.Name(), basePath, handlerFolder.Name(), folder.Name()), func(t *testing.T) {
+						runCase(t, fork, func(name string) ([]byte, error) {
+							return os.ReadFile(filepath.Join(testFolderPath, name)) // #nosec G304
+						})
+					})
+				}
+			}
+		}
+	}
+	if numCases == 0 {
+		t.Fatalf("No fork choice tests found in %s", presetDir)
+	}
+}
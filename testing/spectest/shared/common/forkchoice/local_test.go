@@ -0,0 +1,45 @@
+package forkchoice
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/snappy"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// TestLocal runs the fork choice tests found in the directory set in the FORKCHOICE_SPEC_TESTS_DIR
+// environment variable, for instance to validate fork choice changes against newly generated tests.
+func TestLocal(t *testing.T) {
+	dir := os.Getenv("FORKCHOICE_SPEC_TESTS_DIR")
+	if dir == "" {
+		t.Skip("FORKCHOICE_SPEC_TESTS_DIR is not set")
+	}
+	RunLocal(t, dir)
+}
+
+func TestRunLocal(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	blk := util.NewBeaconBlock()
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	testFolderPath := filepath.Join(dir, fieldparams.Preset, "phase0", "fork_choice", "get_head", "pyspec_tests", "genesis")
+	require.NoError(t, os.MkdirAll(testFolderPath, os.ModePerm))
+	stateSSZ, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(testFolderPath, "anchor_state.ssz_snappy"), snappy.Encode(nil, stateSSZ), 0600))
+	blockSSZ, err := blk.Block.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(testFolderPath, "anchor_block.ssz_snappy"), snappy.Encode(nil, blockSSZ), 0600))
+	steps := fmt.Sprintf("- {tick: 0}\n- checks:\n    head: {slot: 0, root: '%#x'}\n", root)
+	require.NoError(t, os.WriteFile(filepath.Join(testFolderPath, "steps.yaml"), []byte(steps), 0600))
+
+	RunLocal(t, dir)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"path"
 	"strings"
 	"testing"
@@ -37,7 +36,7 @@ func Run(t *testing.T, config string, fork int) {
 	}
 }
 
-func runTest(t *testing.T, config string, fork int, basePath string) { // nolint:gocognit
+func runTest(t *testing.T, config string, fork int, basePath string) {
 	require.NoError(t, utils.SetConfig(t, config))
 	testFolders, _ := utils.TestFolders(t, config, version.String(fork), basePath)
 	if len(testFolders) == 0 {
@@ -53,122 +52,129 @@ func runTest(t *testing.T, config string, fork int, basePath string) { // nolint
 
 		for _, folder := range testFolders {
 			t.Run(folder.Name(), func(t *testing.T) {
-				preStepsFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), "steps.yaml")
-				require.NoError(t, err)
-				var steps []Step
-				require.NoError(t, utils.UnmarshalYaml(preStepsFile, &steps))
+				runCase(t, fork, func(name string) ([]byte, error) {
+					return util.BazelFileBytes(testsFolderPath, folder.Name(), name)
+				})
+			})
+		}
+	}
+}
 
-				preBeaconStateFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), "anchor_state.ssz_snappy")
-				require.NoError(t, err)
-				preBeaconStateSSZ, err := snappy.Decode(nil /* dst */, preBeaconStateFile)
-				require.NoError(t, err)
+// runCase executes the steps of a test case, whose files are read with the given function.
+func runCase(t *testing.T, fork int, read func(name string) ([]byte, error)) { // nolint:gocognit
+	preStepsFile, err := read("steps.yaml")
+	require.NoError(t, err)
+	var steps []Step
+	require.NoError(t, utils.UnmarshalYaml(preStepsFile, &steps))
 
-				blockFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), "anchor_block.ssz_snappy")
-				require.NoError(t, err)
-				blockSSZ, err := snappy.Decode(nil /* dst */, blockFile)
-				require.NoError(t, err)
+	preBeaconStateFile, err := read("anchor_state.ssz_snappy")
+	require.NoError(t, err)
+	preBeaconStateSSZ, err := snappy.Decode(nil /* dst */, preBeaconStateFile)
+	require.NoError(t, err)
 
-				var beaconState state.BeaconState
-				var beaconBlock interfaces.ReadOnlySignedBeaconBlock
-				switch fork {
-				case version.Phase0:
-					beaconState = unmarshalPhase0State(t, preBeaconStateSSZ)
-					beaconBlock = unmarshalPhase0Block(t, blockSSZ)
-				case version.Altair:
-					beaconState = unmarshalAltairState(t, preBeaconStateSSZ)
-					beaconBlock = unmarshalAltairBlock(t, blockSSZ)
-				case version.Bellatrix:
-					beaconState = unmarshalBellatrixState(t, preBeaconStateSSZ)
-					beaconBlock = unmarshalBellatrixBlock(t, blockSSZ)
-				case version.Capella:
-					beaconState = unmarshalCapellaState(t, preBeaconStateSSZ)
-					beaconBlock = unmarshalCapellaBlock(t, blockSSZ)
-				case version.Deneb:
-					beaconState = unmarshalDenebState(t, preBeaconStateSSZ)
-					beaconBlock = unmarshalDenebBlock(t, blockSSZ)
-				case version.Electra:
-					beaconState = unmarshalElectraState(t, preBeaconStateSSZ)
-					beaconBlock = unmarshalElectraBlock(t, blockSSZ)
-				default:
-					t.Fatalf("unknown fork version: %v", fork)
-				}
-
-				builder := NewBuilder(t, beaconState, beaconBlock)
-
-				for _, step := range steps {
-					if step.Tick != nil {
-						builder.Tick(t, int64(*step.Tick))
-					}
-					var beaconBlock interfaces.ReadOnlySignedBeaconBlock
-					if step.Block != nil {
-						blockFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), fmt.Sprint(*step.Block, ".ssz_snappy"))
-						require.NoError(t, err)
-						blockSSZ, err := snappy.Decode(nil /* dst */, blockFile)
-						require.NoError(t, err)
-						switch fork {
-						case version.Phase0:
-							beaconBlock = unmarshalSignedPhase0Block(t, blockSSZ)
-						case version.Altair:
-							beaconBlock = unmarshalSignedAltairBlock(t, blockSSZ)
-						case version.Bellatrix:
-							beaconBlock = unmarshalSignedBellatrixBlock(t, blockSSZ)
-						case version.Capella:
-							beaconBlock = unmarshalSignedCapellaBlock(t, blockSSZ)
-						case version.Deneb:
-							beaconBlock = unmarshalSignedDenebBlock(t, blockSSZ)
-						case version.Electra:
-							beaconBlock = unmarshalSignedElectraBlock(t, blockSSZ)
-						default:
-							t.Fatalf("unknown fork version: %v", fork)
-						}
-					}
-					runBlobStep(t, step, beaconBlock, fork, folder, testsFolderPath, builder)
-					if beaconBlock != nil {
-						if step.Valid != nil && !*step.Valid {
-							builder.InvalidBlock(t, beaconBlock)
-						} else {
-							builder.ValidBlock(t, beaconBlock)
-						}
-					}
-					if step.AttesterSlashing != nil {
-						slashingFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), fmt.Sprint(*step.AttesterSlashing, ".ssz_snappy"))
-						require.NoError(t, err)
-						slashingSSZ, err := snappy.Decode(nil /* dst */, slashingFile)
-						require.NoError(t, err)
-						slashing := &ethpb.AttesterSlashing{}
-						require.NoError(t, slashing.UnmarshalSSZ(slashingSSZ), "Failed to unmarshal")
-						builder.AttesterSlashing(slashing)
-					}
-					if step.Attestation != nil {
-						attFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), fmt.Sprint(*step.Attestation, ".ssz_snappy"))
-						require.NoError(t, err)
-						attSSZ, err := snappy.Decode(nil /* dst */, attFile)
-						require.NoError(t, err)
-						var att ethpb.Att
-						if fork < version.Electra {
-							att = &ethpb.Attestation{}
-						} else {
-							att = &ethpb.AttestationElectra{}
-						}
-						require.NoError(t, att.UnmarshalSSZ(attSSZ), "Failed to unmarshal")
-						builder.Attestation(t, att)
-					}
-					if step.PayloadStatus != nil {
-						require.NoError(t, builder.SetPayloadStatus(step.PayloadStatus))
-					}
-					if step.PowBlock != nil {
-						powBlockFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), fmt.Sprint(*step.PowBlock, ".ssz_snappy"))
-						require.NoError(t, err)
-						p, err := snappy.Decode(nil /* dst */, powBlockFile)
-						require.NoError(t, err)
-						pb := &ethpb.PowBlock{}
-						require.NoError(t, pb.UnmarshalSSZ(p), "Failed to unmarshal")
-						builder.PoWBlock(pb)
-					}
-					builder.Check(t, step.Check)
-				}
-			})
+	blockFile, err := read("anchor_block.ssz_snappy")
+	require.NoError(t, err)
+	blockSSZ, err := snappy.Decode(nil /* dst */, blockFile)
+	require.NoError(t, err)
+
+	var beaconState state.BeaconState
+	var beaconBlock interfaces.ReadOnlySignedBeaconBlock
+	switch fork {
+	case version.Phase0:
+		beaconState = unmarshalPhase0State(t, preBeaconStateSSZ)
+		beaconBlock = unmarshalPhase0Block(t, blockSSZ)
+	case version.Altair:
+		beaconState = unmarshalAltairState(t, preBeaconStateSSZ)
+		beaconBlock = unmarshalAltairBlock(t, blockSSZ)
+	case version.Bellatrix:
+		beaconState = unmarshalBellatrixState(t, preBeaconStateSSZ)
+		beaconBlock = unmarshalBellatrixBlock(t, blockSSZ)
+	case version.Capella:
+		beaconState = unmarshalCapellaState(t, preBeaconStateSSZ)
+		beaconBlock = unmarshalCapellaBlock(t, blockSSZ)
+	case version.Deneb:
+		beaconState = unmarshalDenebState(t, preBeaconStateSSZ)
+		beaconBlock = unmarshalDenebBlock(t, blockSSZ)
+	case version.Electra:
+		beaconState = unmarshalElectraState(t, preBeaconStateSSZ)
+		beaconBlock = unmarshalElectraBlock(t, blockSSZ)
+	default:
+		t.Fatalf("unknown fork version: %v", fork)
+	}
+
+	builder := NewBuilder(t, beaconState, beaconBlock)
+
+	for _, step := range steps {
+		if step.Tick != nil {
+			builder.Tick(t, int64(*step.Tick))
+		}
+		var beaconBlock interfaces.ReadOnlySignedBeaconBlock
+		if step.Block != nil {
+			blockFile, err := read(fmt.Sprint(*step.Block, ".ssz_snappy"))
+			require.NoError(t, err)
+			blockSSZ, err := snappy.Decode(nil /* dst */, blockFile)
+			require.NoError(t, err)
+			switch fork {
+			case version.Phase0:
+				beaconBlock = unmarshalSignedPhase0Block(t, blockSSZ)
+			case version.Altair:
+				beaconBlock = unmarshalSignedAltairBlock(t, blockSSZ)
+			case version.Bellatrix:
+				beaconBlock = unmarshalSignedBellatrixBlock(t, blockSSZ)
+			case version.Capella:
+				beaconBlock = unmarshalSignedCapellaBlock(t, blockSSZ)
+			case version.Deneb:
+				beaconBlock = unmarshalSignedDenebBlock(t, blockSSZ)
+			case version.Electra:
+				beaconBlock = unmarshalSignedElectraBlock(t, blockSSZ)
+			default:
+				t.Fatalf("unknown fork version: %v", fork)
+			}
+		}
+		runBlobStep(t, step, beaconBlock, fork, read, builder)
+		if beaconBlock != nil {
+			if step.Valid != nil && !*step.Valid {
+				builder.InvalidBlock(t, beaconBlock)
+			} else {
+				builder.ValidBlock(t, beaconBlock)
+			}
+		}
+		if step.AttesterSlashing != nil {
+			slashingFile, err := read(fmt.Sprint(*step.AttesterSlashing, ".ssz_snappy"))
+			require.NoError(t, err)
+			slashingSSZ, err := snappy.Decode(nil /* dst */, slashingFile)
+			require.NoError(t, err)
+			slashing := &ethpb.AttesterSlashing{}
+			require.NoError(t, slashing.UnmarshalSSZ(slashingSSZ), "Failed to unmarshal")
+			builder.AttesterSlashing(slashing)
+		}
+		if step.Attestation != nil {
+			attFile, err := read(fmt.Sprint(*step.Attestation, ".ssz_snappy"))
+			require.NoError(t, err)
+			attSSZ, err := snappy.Decode(nil /* dst */, attFile)
+			require.NoError(t, err)
+			var att ethpb.Att
+			if fork < version.Electra {
+				att = &ethpb.Attestation{}
+			} else {
+				att = &ethpb.AttestationElectra{}
+			}
+			require.NoError(t, att.UnmarshalSSZ(attSSZ), "Failed to unmarshal")
+			builder.Attestation(t, att)
+		}
+		if step.PayloadStatus != nil {
+			require.NoError(t, builder.SetPayloadStatus(step.PayloadStatus))
+		}
+		if step.PowBlock != nil {
+			powBlockFile, err := read(fmt.Sprint(*step.PowBlock, ".ssz_snappy"))
+			require.NoError(t, err)
+			p, err := snappy.Decode(nil /* dst */, powBlockFile)
+			require.NoError(t, err)
+			pb := &ethpb.PowBlock{}
+			require.NoError(t, pb.UnmarshalSSZ(p), "Failed to unmarshal")
+			builder.PoWBlock(pb)
 		}
+		builder.Check(t, step.Check)
 	}
 }
 
@@ -320,8 +326,7 @@ func runBlobStep(t *testing.T,
 	step Step,
 	beaconBlock interfaces.ReadOnlySignedBeaconBlock,
 	fork int,
-	folder os.DirEntry,
-	testsFolderPath string,
+	read func(name string) ([]byte, error),
 	builder *Builder,
 ) {
 	blobs := step.Blobs
@@ -336,7 +341,7 @@ func runBlobStep(t *testing.T,
 		kzgs, err := block.Body().BlobKzgCommitments()
 		require.NoError(t, err)
 
-		blobsFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), fmt.Sprint(*blobs, ".ssz_snappy"))
+		blobsFile, err := read(fmt.Sprint(*blobs, ".ssz_snappy"))
 		require.NoError(t, err)
 		blobsSSZ, err := snappy.Decode(nil /* dst */, blobsFile)
 		require.NoError(t, err)
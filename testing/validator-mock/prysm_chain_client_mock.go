@@ -41,6 +41,21 @@ func (m *MockPrysmChainClient) EXPECT() *MockPrysmChainClientMockRecorder {
 	return m.recorder
 }
 
+// ExecutionClientVersion mocks base method.
+func (m *MockPrysmChainClient) ExecutionClientVersion(arg0 context.Context) ([]iface.ExecutionClientVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionClientVersion", arg0)
+	ret0, _ := ret[0].([]iface.ExecutionClientVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionClientVersion indicates an expected call of ExecutionClientVersion.
+func (mr *MockPrysmChainClientMockRecorder) ExecutionClientVersion(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionClientVersion", reflect.TypeOf((*MockPrysmChainClient)(nil).ExecutionClientVersion), arg0)
+}
+
 // ValidatorCount mocks base method.
 func (m *MockPrysmChainClient) ValidatorCount(arg0 context.Context, arg1 string, arg2 []validator.Status) ([]iface.ValidatorCount, error) {
 	m.ctrl.T.Helper()
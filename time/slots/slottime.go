@@ -269,6 +269,15 @@ func SecondsSinceSlotStart(s primitives.Slot, genesisTime, timeStamp uint64) (ui
 	return timeStamp - genesisTime - uint64(s)*params.BeaconConfig().SecondsPerSlot, nil
 }
 
+// SinceSlotStart returns the time elapsed between the start of the slot and the given time.
+func SinceSlotStart(s primitives.Slot, genesisTime uint64, t time.Time) (time.Duration, error) {
+	start := StartTime(genesisTime, s)
+	if t.Before(start) {
+		return 0, errors.New("could not compute time since slot start: invalid time")
+	}
+	return t.Sub(start), nil
+}
+
 // TimeIntoSlot returns the time duration elapsed between the current time and
 // the start of the current slot
 func TimeIntoSlot(genesisTime uint64) time.Duration {
@@ -523,6 +523,18 @@ func TestSecondsSinceSlotStart(t *testing.T) {
 	}
 }
 
+func TestSinceSlotStart(t *testing.T) {
+	slotStart := time.Unix(int64(params.BeaconConfig().SecondsPerSlot), 0)
+	d, err := SinceSlotStart(1, 0, slotStart.Add(4500*time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, 4500*time.Millisecond, d)
+	d, err = SinceSlotStart(1, 0, slotStart)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), d)
+	_, err = SinceSlotStart(1, 0, slotStart.Add(-time.Millisecond))
+	require.ErrorContains(t, "invalid time", err)
+}
+
 func TestDuration(t *testing.T) {
 	oneSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
 	cases := []struct {
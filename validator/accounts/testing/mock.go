@@ -103,9 +103,10 @@ func (_ *Wallet) InitializeKeymanager(_ context.Context, _ iface.InitKeymanagerC
 }
 
 type Validator struct {
-	Km               keymanager.IKeymanager
-	graffiti         string
-	proposerSettings *proposer.Settings
+	Km                 keymanager.IKeymanager
+	DoppelgangerStatus map[[fieldparams.BLSPubkeyLength]byte]iface2.DoppelgangerStatus
+	graffiti           string
+	proposerSettings   *proposer.Settings
 }
 
 func (_ *Validator) LogSubmittedSyncCommitteeMessages() {}
@@ -198,6 +199,11 @@ func (_ *Validator) CheckDoppelGanger(_ context.Context) error {
 	panic("implement me")
 }
 
+// DoppelgangerStatuses for mocking
+func (m *Validator) DoppelgangerStatuses() map[[fieldparams.BLSPubkeyLength]byte]iface2.DoppelgangerStatus {
+	return m.DoppelgangerStatus
+}
+
 // HasProposerSettings for mocking
 func (*Validator) HasProposerSettings() bool {
 	panic("implement me")
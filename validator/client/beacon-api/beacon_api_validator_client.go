@@ -26,6 +26,24 @@ type beaconApiValidatorClient struct {
 	beaconBlockConverter    BeaconBlockConverter
 	prysmChainClient        iface.PrysmChainClient
 	isEventStreamRunning    bool
+	doppelgangerEpochs      primitives.Epoch
+	livenessHandlers        []JsonRestHandler
+}
+
+// WithDoppelgangerEpochs sets the number of epochs during which validators are checked for liveness
+// by the doppelganger check.
+func WithDoppelgangerEpochs(epochs primitives.Epoch) ValidatorClientOpt {
+	return func(c *beaconApiValidatorClient) {
+		c.doppelgangerEpochs = epochs
+	}
+}
+
+// WithLivenessHandlers sets the beacon nodes queried for validators liveness by the doppelganger check.
+// By default, only the beacon node of the client is queried.
+func WithLivenessHandlers(handlers []JsonRestHandler) ValidatorClientOpt {
+	return func(c *beaconApiValidatorClient) {
+		c.livenessHandlers = handlers
+	}
 }
 
 func NewBeaconApiValidatorClient(jsonRestHandler JsonRestHandler, opts ...ValidatorClientOpt) iface.ValidatorClient {
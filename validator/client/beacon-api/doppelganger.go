@@ -14,21 +14,25 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
+// DefaultDoppelgangerEpochs is the default number of epochs during which validators are checked for
+// liveness by the doppelganger check.
+const DefaultDoppelgangerEpochs = primitives.Epoch(2)
+
 type DoppelGangerInfo struct {
 	validatorEpoch primitives.Epoch
 	response       *ethpb.DoppelGangerResponse_ValidatorResponse
 }
 
 func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
-	// Check if there is any doppelganger validator for the last 2 epochs.
+	// Check if there is any doppelganger validator for the last `doppelgangerEpochs` epochs (2 by default).
 	// - Check if the beacon node is synced
 	// - If we are in Phase0, we consider there is no doppelganger.
 	// - If all validators we want to check doppelganger existence were live in local antislashing
-	//   database for the last 2 epochs, we consider there is no doppelganger.
+	//   database for the last epochs, we consider there is no doppelganger.
 	//   This is typically the case when we reboot the validator client.
 	// - If some validators we want to check doppelganger existence were NOT live
-	//   in local antislashing for the last two epochs, then we check onchain if there is
-	//   some liveness for these validators. If yes, we consider there is a doppelganger.
+	//   in local antislashing for the last epochs, then we check onchain, on every configured
+	//   beacon node, if there is some liveness for these validators. If yes, we consider there is a doppelganger.
 
 	// Check inputs are correct.
 	if in == nil || in.ValidatorRequests == nil || len(in.ValidatorRequests) == 0 {
@@ -104,8 +108,14 @@ func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *et
 	headSlot := primitives.Slot(headSlotUint64)
 	currentEpoch := slots.ToEpoch(headSlot)
 
-	// Extract input pubkeys we did not validate for the 2 last epochs.
-	// If we detect onchain liveness for these keys during the 2 last epochs, a doppelganger may exist somewhere.
+	// The number of epochs is not set when the client is built without WithDoppelgangerEpochs.
+	doppelgangerEpochs := c.doppelgangerEpochs
+	if doppelgangerEpochs == 0 {
+		doppelgangerEpochs = DefaultDoppelgangerEpochs
+	}
+
+	// Extract input pubkeys we did not validate for the last `doppelgangerEpochs` epochs.
+	// If we detect onchain liveness for these keys during these epochs, a doppelganger may exist somewhere.
 	var notRecentStringPubKeys []string
 
 	for _, spk := range stringPubKeys {
@@ -114,7 +124,7 @@ func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *et
 			return nil, errors.New("failed to retrieve doppelganger info from string public key")
 		}
 
-		if dph.validatorEpoch+2 < currentEpoch {
+		if dph.validatorEpoch+doppelgangerEpochs < currentEpoch {
 			notRecentStringPubKeys = append(notRecentStringPubKeys, spk)
 		}
 	}
@@ -150,20 +160,37 @@ func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *et
 		indexes[i] = index
 	}
 
-	// Get validators liveness for the last epoch.
-	// We request a state 1 epoch ago. We are guaranteed to have currentEpoch > 2
-	// since we assume that we are not in phase0.
-	previousEpoch := currentEpoch - 1
+	// Get validators liveness for the last `doppelgangerEpochs` epochs, up to the current one.
+	firstEpoch := primitives.Epoch(0)
+	if currentEpoch+1 > doppelgangerEpochs {
+		firstEpoch = currentEpoch + 1 - doppelgangerEpochs
+	}
 
-	indexToPreviousLiveness, err := c.indexToLiveness(ctx, previousEpoch, indexes)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get map from validator index to liveness for previous epoch %d", previousEpoch)
+	// Liveness is requested from every configured beacon node, so a doppelganger is detected
+	// even if its attestations only reached some of them. The check fails if any beacon node
+	// fails to answer, as the doppelganger could be seen by that node only.
+	livenessHandlers := c.livenessHandlers
+	if len(livenessHandlers) == 0 {
+		livenessHandlers = []JsonRestHandler{c.jsonRestHandler}
 	}
 
-	// Get validators liveness for the current epoch.
-	indexToCurrentLiveness, err := c.indexToLiveness(ctx, currentEpoch, indexes)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get map from validator index to liveness for current epoch %d", currentEpoch)
+	// Lowest epoch at which each live validator index was found live.
+	liveIndexes := make(map[string]primitives.Epoch, len(indexes))
+
+	for _, handler := range livenessHandlers {
+		handlerLiveIndexes, err := indexesLiveness(ctx, handler, firstEpoch, currentEpoch, indexes)
+		if err != nil {
+			if len(livenessHandlers) == 1 {
+				return nil, err
+			}
+			return nil, errors.Wrapf(err, "failed to get validators liveness from beacon node %s", handler.Host())
+		}
+
+		for index, epoch := range handlerLiveIndexes {
+			if liveEpoch, ok := liveIndexes[index]; !ok || epoch < liveEpoch {
+				liveIndexes[index] = epoch
+			}
+		}
 	}
 
 	// Set `DuplicateExists` to `true` if needed.
@@ -174,32 +201,62 @@ func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *et
 			continue
 		}
 
-		previousLiveness, ok := indexToPreviousLiveness[index]
-		if !ok {
-			return nil, fmt.Errorf("failed to retrieve liveness for previous epoch `%d` for validator index `%s`", previousEpoch, index)
+		if epoch, ok := liveIndexes[index]; ok {
+			log.WithField("pubkey", spk).WithField("epoch", epoch).Warn("Doppelganger found")
+			stringPubKeyToDoppelGangerInfo[spk].response.DuplicateExists = true
 		}
+	}
 
-		if previousLiveness {
-			log.WithField("pubkey", spk).WithField("epoch", previousEpoch).Warn("Doppelganger found")
-		}
+	return buildResponse(stringPubKeys, stringPubKeyToDoppelGangerInfo), nil
+}
 
-		currentLiveness, ok := indexToCurrentLiveness[index]
-		if !ok {
-			return nil, fmt.Errorf("failed to retrieve liveness for current epoch `%d` for validator index `%s`", currentEpoch, index)
+// indexesLiveness returns the validator indexes found live by the beacon node between firstEpoch
+// and currentEpoch included, with the first epoch they were found live.
+func indexesLiveness(
+	ctx context.Context,
+	handler JsonRestHandler,
+	firstEpoch, currentEpoch primitives.Epoch,
+	indexes []string,
+) (map[string]primitives.Epoch, error) {
+	indexToLivenessByEpoch := make(map[primitives.Epoch]map[string]bool, currentEpoch-firstEpoch+1)
+
+	for epoch := firstEpoch; epoch <= currentEpoch; epoch++ {
+		indexToLiveness, err := indexToLiveness(ctx, handler, epoch, indexes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get map from validator index to liveness for %s %d", epochName(epoch, currentEpoch), epoch)
 		}
 
-		if currentLiveness {
-			log.WithField("pubkey", spk).WithField("epoch", currentEpoch).Warn("Doppelganger found")
-		}
+		indexToLivenessByEpoch[epoch] = indexToLiveness
+	}
 
-		globalLiveness := previousLiveness || currentLiveness
+	liveIndexes := make(map[string]primitives.Epoch, len(indexes))
 
-		if globalLiveness {
-			stringPubKeyToDoppelGangerInfo[spk].response.DuplicateExists = true
+	for epoch := firstEpoch; epoch <= currentEpoch; epoch++ {
+		for _, index := range indexes {
+			liveness, ok := indexToLivenessByEpoch[epoch][index]
+			if !ok {
+				return nil, fmt.Errorf("failed to retrieve liveness for %s `%d` for validator index `%s`", epochName(epoch, currentEpoch), epoch, index)
+			}
+
+			if _, alreadyLive := liveIndexes[index]; liveness && !alreadyLive {
+				liveIndexes[index] = epoch
+			}
 		}
 	}
 
-	return buildResponse(stringPubKeys, stringPubKeyToDoppelGangerInfo), nil
+	return liveIndexes, nil
+}
+
+// epochName names the epoch relatively to the current epoch, for logs and errors.
+func epochName(epoch, currentEpoch primitives.Epoch) string {
+	switch {
+	case epoch == currentEpoch:
+		return "current epoch"
+	case epoch+1 == currentEpoch:
+		return "previous epoch"
+	default:
+		return "epoch"
+	}
 }
 
 func buildResponse(
@@ -217,8 +274,8 @@ func buildResponse(
 	}
 }
 
-func (c *beaconApiValidatorClient) indexToLiveness(ctx context.Context, epoch primitives.Epoch, indexes []string) (map[string]bool, error) {
-	livenessResponse, err := c.liveness(ctx, epoch, indexes)
+func indexToLiveness(ctx context.Context, handler JsonRestHandler, epoch primitives.Epoch, indexes []string) (map[string]bool, error) {
+	livenessResponse, err := liveness(ctx, handler, epoch, indexes)
 	if err != nil || livenessResponse.Data == nil {
 		return nil, errors.Wrapf(err, fmt.Sprintf("failed to get liveness for epoch %d", epoch))
 	}
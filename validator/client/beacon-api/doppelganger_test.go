@@ -822,3 +822,102 @@ func TestCheckDoppelGanger_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckDoppelGanger_MultipleBeaconNodes(t *testing.T) {
+	const stringPubKey = "0x80000e851c0f53c3246ff726d7ff7766661ca5e12a07c45c114d208d54f0f8233d4380b2e9aff759d69795d1df905526"
+	pubKey, err := hexutil.Decode(stringPubKey)
+	require.NoError(t, err)
+	marshalledIndexes, err := json.Marshal([]string{"42"})
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, ctrl *gomock.Controller, livenessHandlers ...JsonRestHandler) beaconApiValidatorClient {
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Get(gomock.Any(), syncingEndpoint, &structs.SyncStatusResponse{}).SetArg(
+			2,
+			structs.SyncStatusResponse{Data: &structs.SyncStatusResponseData{IsSyncing: false}},
+		).Return(nil).Times(1)
+		jsonRestHandler.EXPECT().Get(gomock.Any(), forkEndpoint, &structs.GetStateForkResponse{}).SetArg(
+			2,
+			structs.GetStateForkResponse{Data: &structs.Fork{CurrentVersion: "0x02000000"}},
+		).Return(nil).Times(1)
+		// Slot 1000 is in epoch 31.
+		jsonRestHandler.EXPECT().Get(gomock.Any(), headersEndpoint, &structs.GetBlockHeadersResponse{}).SetArg(
+			2,
+			structs.GetBlockHeadersResponse{
+				Data: []*structs.SignedBeaconBlockHeaderContainer{
+					{Header: &structs.SignedBeaconBlockHeader{Message: &structs.BeaconBlockHeader{Slot: "1000"}}},
+				},
+			},
+		).Return(nil).Times(1)
+
+		stateValidatorsProvider := mock.NewMockStateValidatorsProvider(ctrl)
+		stateValidatorsProvider.EXPECT().StateValidators(gomock.Any(), []string{stringPubKey}, nil, nil).Return(
+			&structs.GetValidatorsResponse{
+				Data: []*structs.ValidatorContainer{{Index: "42", Validator: &structs.Validator{Pubkey: stringPubKey}}},
+			},
+			nil,
+		).Times(1)
+
+		return beaconApiValidatorClient{
+			jsonRestHandler:         jsonRestHandler,
+			stateValidatorsProvider: stateValidatorsProvider,
+			doppelgangerEpochs:      3,
+			livenessHandlers:        livenessHandlers,
+		}
+	}
+	// newLivenessHandler returns a beacon node which saw the validator live in the given epochs.
+	newLivenessHandler := func(ctrl *gomock.Controller, liveEpochs map[string]bool) *mock.MockJsonRestHandler {
+		handler := mock.NewMockJsonRestHandler(ctrl)
+		for _, epoch := range []string{"29", "30", "31"} {
+			handler.EXPECT().Post(
+				gomock.Any(),
+				"/eth/v1/validator/liveness/"+epoch,
+				nil,
+				bytes.NewBuffer(marshalledIndexes),
+				&structs.GetLivenessResponse{},
+			).SetArg(
+				4,
+				structs.GetLivenessResponse{Data: []*structs.Liveness{{Index: "42", IsLive: liveEpochs[epoch]}}},
+			).Return(nil).Times(1)
+		}
+		return handler
+	}
+	request := &ethpb.DoppelGangerRequest{
+		ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{{PublicKey: pubKey, Epoch: 27}},
+	}
+
+	t.Run("live on one beacon node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		validatorClient := setup(t, ctrl,
+			newLivenessHandler(ctrl, nil),
+			newLivenessHandler(ctrl, map[string]bool{"30": true}),
+		)
+		resp, err := validatorClient.CheckDoppelGanger(context.Background(), request)
+		require.NoError(t, err)
+		assert.DeepEqual(t, &ethpb.DoppelGangerResponse{
+			Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{{PublicKey: pubKey, DuplicateExists: true}},
+		}, resp)
+	})
+	t.Run("one beacon node fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		failingHandler := mock.NewMockJsonRestHandler(ctrl)
+		failingHandler.EXPECT().Post(
+			gomock.Any(),
+			"/eth/v1/validator/liveness/29",
+			nil,
+			bytes.NewBuffer(marshalledIndexes),
+			&structs.GetLivenessResponse{},
+		).Return(errors.New("custom error")).Times(1)
+		failingHandler.EXPECT().Host().Return("http://localhost:3500").Times(1)
+
+		// The check fails even though the other beacon node did not see the validator live.
+		validatorClient := setup(t, ctrl, newLivenessHandler(ctrl, nil), failingHandler)
+		_, err := validatorClient.CheckDoppelGanger(context.Background(), request)
+		require.ErrorContains(t, "failed to get validators liveness from beacon node http://localhost:3500: ", err)
+		require.ErrorContains(t, "custom error", err)
+	})
+}
@@ -0,0 +1,87 @@
+package beacon_api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecutionClientVersion(t *testing.T) {
+	testCases := []struct {
+		name             string
+		nodeVersion      string
+		clientVersion    structs.GetExecutionClientVersionResponse
+		clientCalled     int
+		expectedResponse []iface.ExecutionClientVersion
+		expectedError    string
+	}{
+		{
+			name:        "success",
+			nodeVersion: "prysm/v0.0.1",
+			clientVersion: structs.GetExecutionClientVersionResponse{
+				Data: []*structs.ClientVersionV1{{Code: "GE", Name: "Geth", Version: "v1.14.0", Commit: "0xfa4ff922"}},
+			},
+			clientCalled: 1,
+			expectedResponse: []iface.ExecutionClientVersion{
+				{Code: "GE", Name: "Geth", Version: "v1.14.0", Commit: "0xfa4ff922"},
+			},
+		},
+		{
+			name:          "not supported beacon node",
+			nodeVersion:   "lighthouse/v0.0.1",
+			expectedError: "endpoint not supported",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+
+			var nodeVersionResponse structs.GetVersionResponse
+			jsonRestHandler.EXPECT().Get(
+				gomock.Any(),
+				"/eth/v1/node/version",
+				&nodeVersionResponse,
+			).Return(
+				nil,
+			).SetArg(
+				2,
+				structs.GetVersionResponse{Data: &structs.Version{Version: test.nodeVersion}},
+			)
+
+			var clientVersionResponse structs.GetExecutionClientVersionResponse
+			jsonRestHandler.EXPECT().Get(
+				gomock.Any(),
+				"/prysm/v1/node/execution_client_version",
+				&clientVersionResponse,
+			).Return(
+				nil,
+			).SetArg(
+				2,
+				test.clientVersion,
+			).Times(test.clientCalled)
+
+			var client iface.PrysmChainClient = &prysmChainClient{
+				nodeClient:      &beaconApiNodeClient{jsonRestHandler: jsonRestHandler},
+				jsonRestHandler: jsonRestHandler,
+			}
+
+			resp, err := client.ExecutionClientVersion(ctx)
+			if test.expectedError != "" {
+				require.ErrorContains(t, test.expectedError, err)
+				return
+			}
+			require.NoError(t, err)
+			require.DeepEqual(t, test.expectedResponse, resp)
+		})
+	}
+}
@@ -72,3 +72,35 @@ func (c prysmChainClient) ValidatorCount(ctx context.Context, stateID string, st
 
 	return resp, nil
 }
+
+func (c prysmChainClient) ExecutionClientVersion(ctx context.Context) ([]iface.ExecutionClientVersion, error) {
+	// Check node version for prysm beacon node as it is a custom endpoint for prysm beacon node.
+	nodeVersion, err := c.nodeClient.Version(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node version")
+	}
+
+	if !strings.Contains(strings.ToLower(nodeVersion.Version), "prysm") {
+		return nil, iface.ErrNotSupported
+	}
+
+	var clientVersionResponse structs.GetExecutionClientVersionResponse
+	if err = c.jsonRestHandler.Get(ctx, "/prysm/v1/node/execution_client_version", &clientVersionResponse); err != nil {
+		return nil, err
+	}
+
+	resp := make([]iface.ExecutionClientVersion, 0, len(clientVersionResponse.Data))
+	for _, cv := range clientVersionResponse.Data {
+		if cv == nil {
+			continue
+		}
+		resp = append(resp, iface.ExecutionClientVersion{
+			Code:    cv.Code,
+			Name:    cv.Name,
+			Version: cv.Version,
+			Commit:  cv.Commit,
+		})
+	}
+
+	return resp, nil
+}
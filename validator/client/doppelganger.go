@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+// DoppelgangerStatuses returns the doppelganger protection status of the keys checked by the validator.
+func (v *validator) DoppelgangerStatuses() map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus {
+	v.doppelgangerLock.RLock()
+	defer v.doppelgangerLock.RUnlock()
+
+	statuses := make(map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus, len(v.doppelgangerStatuses))
+	for pubKey, status := range v.doppelgangerStatuses {
+		statuses[pubKey] = status
+	}
+	return statuses
+}
+
+// isDoppelgangerSafe returns true if the key may perform its duties,
+// that is if doppelganger protection is disabled or found no doppelganger for the key.
+func (v *validator) isDoppelgangerSafe(pubKey [fieldparams.BLSPubkeyLength]byte) bool {
+	if !features.Get().EnableDoppelGanger {
+		return true
+	}
+
+	v.doppelgangerLock.RLock()
+	defer v.doppelgangerLock.RUnlock()
+	return v.doppelgangerStatuses[pubKey] == iface.DoppelgangerSafe
+}
+
+func (v *validator) setDoppelgangerStatuses(pubKeys [][fieldparams.BLSPubkeyLength]byte, status iface.DoppelgangerStatus) {
+	v.doppelgangerLock.Lock()
+	defer v.doppelgangerLock.Unlock()
+
+	if v.doppelgangerStatuses == nil {
+		v.doppelgangerStatuses = make(map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus, len(pubKeys))
+	}
+	for _, pubKey := range pubKeys {
+		v.doppelgangerStatuses[pubKey] = status
+	}
+}
+
+// updateDoppelgangerStatuses records the result of a doppelganger check for the checked keys still in use.
+// Keys without any response do not exist onchain, so they cannot have a doppelganger.
+func (v *validator) updateDoppelgangerStatuses(
+	pubKeys [][fieldparams.BLSPubkeyLength]byte,
+	responses []*ethpb.DoppelGangerResponse_ValidatorResponse,
+) {
+	duplicates := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(responses))
+	for _, resp := range responses {
+		if resp.DuplicateExists {
+			var pubKey [fieldparams.BLSPubkeyLength]byte
+			copy(pubKey[:], resp.PublicKey)
+			duplicates[pubKey] = true
+		}
+	}
+
+	v.doppelgangerLock.Lock()
+	defer v.doppelgangerLock.Unlock()
+
+	for _, pubKey := range pubKeys {
+		if _, ok := v.doppelgangerStatuses[pubKey]; !ok {
+			// The key was removed in the meantime.
+			continue
+		}
+		if duplicates[pubKey] {
+			v.doppelgangerStatuses[pubKey] = iface.DoppelgangerDetected
+			continue
+		}
+		v.doppelgangerStatuses[pubKey] = iface.DoppelgangerSafe
+	}
+}
+
+// checkDoppelGangerForNewKeys runs the doppelganger check in the background for the keys added at runtime,
+// for instance through the keymanager API. These keys do not perform any duty until the check succeeds.
+// The doppelganger status of removed keys is forgotten.
+func (v *validator) checkDoppelGangerForNewKeys(ctx context.Context, currentKeys [][fieldparams.BLSPubkeyLength]byte) {
+	if !features.Get().EnableDoppelGanger {
+		return
+	}
+
+	newKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	current := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(currentKeys))
+
+	v.doppelgangerLock.Lock()
+	if v.doppelgangerStatuses == nil {
+		v.doppelgangerStatuses = make(map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus, len(currentKeys))
+	}
+	for _, pubKey := range currentKeys {
+		current[pubKey] = true
+		if _, ok := v.doppelgangerStatuses[pubKey]; !ok {
+			v.doppelgangerStatuses[pubKey] = iface.DoppelgangerPending
+			newKeys = append(newKeys, pubKey)
+		}
+	}
+	for pubKey := range v.doppelgangerStatuses {
+		if !current[pubKey] {
+			delete(v.doppelgangerStatuses, pubKey)
+		}
+	}
+	v.doppelgangerLock.Unlock()
+
+	if len(newKeys) == 0 {
+		return
+	}
+
+	log.WithField("keyCount", len(newKeys)).Info("Running doppelganger check for new keys")
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+
+	go func() {
+		for {
+			responses, err := v.doppelgangerCheck(ctx, newKeys)
+			if err == nil {
+				if err := buildDuplicateError(responses); err != nil {
+					log.WithError(err).Error("Doppelganger found, these keys will not perform any duty")
+					return
+				}
+				log.WithField("keyCount", len(newKeys)).Info("No doppelganger found for new keys")
+				return
+			}
+
+			log.WithError(err).Warn("Could not run doppelganger check for new keys, retrying in one epoch")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(epochDuration):
+			}
+		}
+	}()
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+func TestValidator_DoppelgangerStatuses(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableDoppelGanger: true,
+	})
+	defer resetCfg()
+
+	safe := randKeypair(t)
+	detected := randKeypair(t)
+	removed := randKeypair(t)
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{safe.pub, detected.pub, removed.pub}
+
+	v := &validator{}
+	v.setDoppelgangerStatuses(pubKeys, iface.DoppelgangerPending)
+	for _, pubKey := range pubKeys {
+		assert.Equal(t, false, v.isDoppelgangerSafe(pubKey))
+	}
+
+	// The key removed before the end of the check must not be added back.
+	v.doppelgangerLock.Lock()
+	delete(v.doppelgangerStatuses, removed.pub)
+	v.doppelgangerLock.Unlock()
+
+	v.updateDoppelgangerStatuses(pubKeys, []*ethpb.DoppelGangerResponse_ValidatorResponse{
+		{PublicKey: safe.pub[:], DuplicateExists: false},
+		{PublicKey: detected.pub[:], DuplicateExists: true},
+	})
+
+	statuses := v.DoppelgangerStatuses()
+	assert.Equal(t, 2, len(statuses))
+	assert.Equal(t, iface.DoppelgangerSafe, statuses[safe.pub])
+	assert.Equal(t, iface.DoppelgangerDetected, statuses[detected.pub])
+	assert.Equal(t, true, v.isDoppelgangerSafe(safe.pub))
+	assert.Equal(t, false, v.isDoppelgangerSafe(detected.pub))
+	assert.Equal(t, false, v.isDoppelgangerSafe(removed.pub))
+}
+
+func TestValidator_IsDoppelgangerSafe_Disabled(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableDoppelGanger: false,
+	})
+	defer resetCfg()
+
+	key := randKeypair(t)
+	v := &validator{}
+	v.setDoppelgangerStatuses([][fieldparams.BLSPubkeyLength]byte{key.pub}, iface.DoppelgangerDetected)
+	assert.Equal(t, true, v.isDoppelgangerSafe(key.pub))
+}
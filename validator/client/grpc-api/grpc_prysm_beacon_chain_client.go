@@ -2,11 +2,13 @@ package grpc_api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
 	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -19,6 +21,7 @@ import (
 
 type grpcPrysmChainClient struct {
 	chainClient iface.ChainClient
+	nodeClient  iface.NodeClient
 }
 
 func (g grpcPrysmChainClient) ValidatorCount(ctx context.Context, _ string, statuses []validator.Status) ([]iface.ValidatorCount, error) {
@@ -51,6 +54,36 @@ func (g grpcPrysmChainClient) ValidatorCount(ctx context.Context, _ string, stat
 	return valCount, nil
 }
 
+// ExecutionClientVersion returns the versions of the execution client, sent as JSON in the metadata of the node version.
+func (g grpcPrysmChainClient) ExecutionClientVersion(ctx context.Context) ([]iface.ExecutionClientVersion, error) {
+	v, err := g.nodeClient.Version(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node version")
+	}
+	if v.Metadata == "" {
+		return nil, iface.ErrNotSupported
+	}
+
+	var metadata structs.VersionMetadata
+	if err := json.Unmarshal([]byte(v.Metadata), &metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to decode node version metadata")
+	}
+
+	resp := make([]iface.ExecutionClientVersion, 0, len(metadata.ExecutionClient))
+	for _, cv := range metadata.ExecutionClient {
+		if cv == nil {
+			continue
+		}
+		resp = append(resp, iface.ExecutionClientVersion{
+			Code:    cv.Code,
+			Name:    cv.Name,
+			Version: cv.Version,
+			Commit:  cv.Commit,
+		})
+	}
+	return resp, nil
+}
+
 // validatorCountByStatus returns a slice of validator count for each status in the given epoch.
 func validatorCountByStatus(validators []*ethpb.Validator, statuses []validator.Status, epoch primitives.Epoch) ([]iface.ValidatorCount, error) {
 	countByStatus := make(map[validator.Status]uint64)
@@ -92,5 +125,8 @@ func validatorCountByStatus(validators []*ethpb.Validator, statuses []validator.
 }
 
 func NewGrpcPrysmChainClient(cc grpc.ClientConnInterface) iface.PrysmChainClient {
-	return &grpcPrysmChainClient{chainClient: &grpcChainClient{ethpb.NewBeaconChainClient(cc)}}
+	return &grpcPrysmChainClient{
+		chainClient: &grpcChainClient{ethpb.NewBeaconChainClient(cc)},
+		nodeClient:  NewNodeClient(cc),
+	}
 }
@@ -324,3 +324,28 @@ func TestGetValidatorCount(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutionClientVersion(t *testing.T) {
+	t.Run("metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		nodeClient := mock.NewMockNodeClient(ctrl)
+		nodeClient.EXPECT().Version(gomock.Any(), gomock.Any()).Return(&ethpb.Version{
+			Version:  "Prysm/v5.0.3/b0ba05",
+			Metadata: `{"execution_client":[{"code":"GE","name":"Geth","version":"1.14.8","commit":"0xa9523b64"}]}`,
+		}, nil)
+
+		client := &grpcPrysmChainClient{nodeClient: nodeClient}
+		resp, err := client.ExecutionClientVersion(context.Background())
+		require.NoError(t, err)
+		require.DeepEqual(t, []iface.ExecutionClientVersion{{Code: "GE", Name: "Geth", Version: "1.14.8", Commit: "0xa9523b64"}}, resp)
+	})
+	t.Run("no metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		nodeClient := mock.NewMockNodeClient(ctrl)
+		nodeClient.EXPECT().Version(gomock.Any(), gomock.Any()).Return(&ethpb.Version{Version: "Prysm/v5.0.3/b0ba05"}, nil)
+
+		client := &grpcPrysmChainClient{nodeClient: nodeClient}
+		_, err := client.ExecutionClientVersion(context.Background())
+		require.ErrorIs(t, err, iface.ErrNotSupported)
+	})
+}
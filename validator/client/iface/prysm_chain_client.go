@@ -14,7 +14,16 @@ type ValidatorCount struct {
 	Count  uint64
 }
 
+// ExecutionClientVersion identifies the execution client connected to the beacon node, as returned by engine_getClientVersionV1.
+type ExecutionClientVersion struct {
+	Code    string
+	Name    string
+	Version string
+	Commit  string
+}
+
 // PrysmChainClient defines an interface required to implement all the prysm specific custom endpoints.
 type PrysmChainClient interface {
 	ValidatorCount(context.Context, string, []validator.Status) ([]ValidatorCount, error)
+	ExecutionClientVersion(context.Context) ([]ExecutionClientVersion, error)
 }
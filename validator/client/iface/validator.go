@@ -33,6 +33,20 @@ const (
 	RoleSyncCommitteeAggregator
 )
 
+// DoppelgangerStatus defines the doppelganger protection status of a validator key.
+type DoppelgangerStatus string
+
+const (
+	// DoppelgangerUnknown means that the key was not checked by the doppelganger protection.
+	DoppelgangerUnknown DoppelgangerStatus = "unknown"
+	// DoppelgangerPending means that the key is being checked and does not perform any duty yet.
+	DoppelgangerPending DoppelgangerStatus = "pending"
+	// DoppelgangerSafe means that no doppelganger was found for the key, which performs its duties.
+	DoppelgangerSafe DoppelgangerStatus = "safe"
+	// DoppelgangerDetected means that a doppelganger was found for the key, which never performs any duty.
+	DoppelgangerDetected DoppelgangerStatus = "detected"
+)
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
@@ -57,6 +71,7 @@ type Validator interface {
 	Keymanager() (keymanager.IKeymanager, error)
 	HandleKeyReload(ctx context.Context, currentKeys [][fieldparams.BLSPubkeyLength]byte) (bool, error)
 	CheckDoppelGanger(ctx context.Context) error
+	DoppelgangerStatuses() map[[fieldparams.BLSPubkeyLength]byte]DoppelgangerStatus
 	PushProposerSettings(ctx context.Context, km keymanager.IKeymanager, slot primitives.Slot) error
 	SignValidatorRegistrationRequest(ctx context.Context, signer SigningFunc, newValidatorRegistration *ethpb.ValidatorRegistrationV1) (*ethpb.SignedValidatorRegistrationV1, error)
 	StartEventStream(ctx context.Context, topics []string, eventsChan chan<- *event.Event)
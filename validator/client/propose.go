@@ -2,11 +2,14 @@ package client
 
 // Validator client proposer functions.
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	emptypb "github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async"
@@ -27,6 +30,7 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 )
@@ -77,6 +81,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 		// to produce the block.
 		log.WithError(err).Warn("Could not get graffiti")
 	}
+	g = v.renderGraffiti(ctx, slot, pubKey, g)
 
 	// Request block from beacon node
 	b, err := v.validatorClient.BeaconBlock(ctx, &ethpb.BlockRequest{
@@ -467,7 +472,12 @@ func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyL
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the third priority.
+	// When specified, graffiti specified for the validator public key takes the third priority.
+	if g, ok := v.graffitiStruct.PubKeys[hexutil.Encode(pubKey[:])]; ok {
+		return bytesutil.PadTo([]byte(g), 32), nil
+	}
+
+	// When specified, individual validator specified graffiti takes the fourth priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return nil, err
@@ -477,7 +487,7 @@ func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyL
 		return bytesutil.PadTo([]byte(g), 32), nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take fourth priority.
+	// When specified, a graffiti from the ordered list in the file take fifth priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
@@ -488,7 +498,7 @@ func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyL
 		return bytesutil.PadTo([]byte(graffiti), 32), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take Fifth priority.
+	// When specified, a graffiti from the random list in the file take sixth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
@@ -504,6 +514,91 @@ func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyL
 	return []byte{}, nil
 }
 
+// renderGraffiti renders the graffiti if it is a template, with the validator index, the proposal slot and
+// the versions of the beacon node and of its execution client. Values which cannot be retrieved are left empty,
+// and the raw graffiti is returned if it cannot be rendered.
+func (v *validator) renderGraffiti(
+	ctx context.Context,
+	slot primitives.Slot,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	g []byte,
+) []byte {
+	tmpl := string(bytes.TrimRight(g, "\x00"))
+	if !graffiti.IsTemplate(tmpl) {
+		return g
+	}
+
+	data := &graffiti.TemplateData{Slot: slot}
+	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		log.WithError(err).Warn("Could not get validator index for graffiti template")
+	} else {
+		data.ValidatorIndex = idx.Index
+	}
+
+	versions := v.connectedClientVersions(ctx)
+	data.CLVersion = versions.clVersion
+	if len(versions.elVersions) > 0 {
+		data.ELClient = versions.elVersions[0].Code
+		data.ELVersion = versions.elVersions[0].Version
+		data.ELCommit = versions.elVersions[0].Commit
+	}
+
+	rendered, err := graffiti.RenderTemplate(tmpl, data)
+	if err != nil {
+		log.WithError(err).Warn("Could not render graffiti template")
+		return g
+	}
+	return bytesutil.PadTo(rendered, 32)
+}
+
+// clientVersions are the versions of the beacon node and of its execution client, as retrieved from the beacon
+// node at host.
+type clientVersions struct {
+	host       string
+	clVersion  string
+	elVersions []iface.ExecutionClientVersion
+}
+
+// connectedClientVersions returns the versions of the beacon node and of its execution client. They are cached
+// for the connection to the current beacon node, unless one of them could not be retrieved, in which case they
+// are retrieved again at the next call.
+func (v *validator) connectedClientVersions(ctx context.Context) *clientVersions {
+	v.clientVersionsLock.Lock()
+	defer v.clientVersionsLock.Unlock()
+
+	host := v.Host()
+	if v.clientVersions != nil && v.clientVersions.host == host {
+		return v.clientVersions
+	}
+
+	versions := &clientVersions{host: host}
+	complete := true
+	nodeVersion, err := v.nodeClient.Version(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).Warn("Could not get beacon node version for graffiti template")
+		complete = false
+	} else {
+		// The version is formatted as <name>/<version>/<details>.
+		parts := strings.Split(nodeVersion.Version, "/")
+		if len(parts) > 1 {
+			versions.clVersion = parts[1]
+		}
+	}
+
+	versions.elVersions, err = v.prysmChainClient.ExecutionClientVersion(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get execution client version for graffiti template")
+		// The beacon node not supporting the method is not going to change for this connection.
+		complete = complete && errors.Is(err, iface.ErrNotSupported)
+	}
+
+	if complete {
+		v.clientVersions = versions
+	}
+	return versions
+}
+
 func (v *validator) SetGraffiti(ctx context.Context, pubkey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
 	ctx, span := trace.StartSpan(ctx, "validator.SetGraffiti")
 	defer span.End()
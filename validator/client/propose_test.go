@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	testing2 "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -1088,6 +1089,114 @@ func TestGetGraffiti_Ok(t *testing.T) {
 	}
 }
 
+func TestGetGraffiti_PubKey(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	v := &validator{
+		graffitiStruct: &graffiti.Graffiti{
+			Default: "c",
+			Specific: map[primitives.ValidatorIndex]string{
+				2: "g",
+			},
+			PubKeys: map[string]string{
+				hexutil.Encode(pubKey[:]): "p",
+			},
+		},
+	}
+	got, err := v.Graffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte{'p'}, 32), got)
+}
+
+func TestRenderGraffiti(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+
+	t.Run("not a template", func(t *testing.T) {
+		v := &validator{}
+		g := bytesutil.PadTo([]byte("Mr T was here"), 32)
+		require.DeepEqual(t, g, v.renderGraffiti(context.Background(), 10, pubKey, g))
+	})
+
+	t.Run("template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		validatorClient := validatormock.NewMockValidatorClient(ctrl)
+		nodeClient := validatormock.NewMockNodeClient(ctrl)
+		prysmChainClient := validatormock.NewMockPrysmChainClient(ctrl)
+		validatorClient.EXPECT().
+			ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+			Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+		nodeClient.EXPECT().Version(gomock.Any(), gomock.Any()).Return(&ethpb.Version{Version: "Prysm/v5.0.3/b0ba05"}, nil)
+		validatorClient.EXPECT().Host().Return("localhost:4000").AnyTimes()
+		prysmChainClient.EXPECT().ExecutionClientVersion(gomock.Any()).Return([]iface.ExecutionClientVersion{
+			{Code: "GE", Name: "Geth", Version: "v1.14.0", Commit: "0xfa4ff922"},
+		}, nil)
+
+		v := &validator{
+			validatorClient:  validatorClient,
+			nodeClient:       nodeClient,
+			prysmChainClient: prysmChainClient,
+		}
+		g := []byte("{{.ELClient}}{{.ELCommit}}PR{{.CLVersion}} {{.ValidatorIndex}}/{{.Slot}}")
+		require.DeepEqual(t, bytesutil.PadTo([]byte("GE0xfa4ff922PRv5.0.3 2/10"), 32), v.renderGraffiti(context.Background(), 10, pubKey, g))
+	})
+
+	t.Run("execution client version not supported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		validatorClient := validatormock.NewMockValidatorClient(ctrl)
+		nodeClient := validatormock.NewMockNodeClient(ctrl)
+		prysmChainClient := validatormock.NewMockPrysmChainClient(ctrl)
+		validatorClient.EXPECT().
+			ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+			Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+		nodeClient.EXPECT().Version(gomock.Any(), gomock.Any()).Return(&ethpb.Version{Version: "Prysm/v5.0.3/b0ba05"}, nil)
+		validatorClient.EXPECT().Host().Return("localhost:4000").AnyTimes()
+		prysmChainClient.EXPECT().ExecutionClientVersion(gomock.Any()).Return(nil, iface.ErrNotSupported)
+
+		v := &validator{
+			validatorClient:  validatorClient,
+			nodeClient:       nodeClient,
+			prysmChainClient: prysmChainClient,
+		}
+		g := []byte("{{.ELClient}}PR{{.CLVersion}}")
+		require.DeepEqual(t, bytesutil.PadTo([]byte("PRv5.0.3"), 32), v.renderGraffiti(context.Background(), 10, pubKey, g))
+	})
+
+	t.Run("versions cached per beacon node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		validatorClient := validatormock.NewMockValidatorClient(ctrl)
+		nodeClient := validatormock.NewMockNodeClient(ctrl)
+		prysmChainClient := validatormock.NewMockPrysmChainClient(ctrl)
+		validatorClient.EXPECT().
+			ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+			Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil).
+			Times(4)
+		gomock.InOrder(
+			validatorClient.EXPECT().Host().Return("localhost:4000").Times(3),
+			validatorClient.EXPECT().Host().Return("localhost:4001"),
+		)
+		gomock.InOrder(
+			nodeClient.EXPECT().Version(gomock.Any(), gomock.Any()).Return(nil, errors.New("bad")),
+			nodeClient.EXPECT().Version(gomock.Any(), gomock.Any()).Return(&ethpb.Version{Version: "Prysm/v5.0.3/b0ba05"}, nil),
+			nodeClient.EXPECT().Version(gomock.Any(), gomock.Any()).Return(&ethpb.Version{Version: "Prysm/v5.0.4/c1cb16"}, nil),
+		)
+		prysmChainClient.EXPECT().ExecutionClientVersion(gomock.Any()).Return([]iface.ExecutionClientVersion{
+			{Code: "GE", Name: "Geth", Version: "v1.14.0", Commit: "0xfa4ff922"},
+		}, nil).Times(3)
+
+		v := &validator{
+			validatorClient:  validatorClient,
+			nodeClient:       nodeClient,
+			prysmChainClient: prysmChainClient,
+		}
+		g := []byte("{{.ELClient}}PR{{.CLVersion}}")
+		// The beacon node version could not be retrieved, so the versions are retrieved again at the next proposal.
+		require.DeepEqual(t, bytesutil.PadTo([]byte("GEPR"), 32), v.renderGraffiti(context.Background(), 10, pubKey, g))
+		require.DeepEqual(t, bytesutil.PadTo([]byte("GEPRv5.0.3"), 32), v.renderGraffiti(context.Background(), 11, pubKey, g))
+		require.DeepEqual(t, bytesutil.PadTo([]byte("GEPRv5.0.3"), 32), v.renderGraffiti(context.Background(), 12, pubKey, g))
+		// The validator switched to another beacon node.
+		require.DeepEqual(t, bytesutil.PadTo([]byte("GEPRv5.0.4"), 32), v.renderGraffiti(context.Background(), 13, pubKey, g))
+	})
+}
+
 func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
@@ -57,6 +57,7 @@ type ValidatorService struct {
 	emitAccountMetrics      bool
 	logValidatorPerformance bool
 	distributed             bool
+	doppelgangerEpochs      primitives.Epoch
 }
 
 // Config for the validator service.
@@ -83,6 +84,7 @@ type Config struct {
 	LogValidatorPerformance bool
 	EmitAccountMetrics      bool
 	Distributed             bool
+	DoppelgangerEpochs      primitives.Epoch
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -106,6 +108,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		emitAccountMetrics:      cfg.EmitAccountMetrics,
 		logValidatorPerformance: cfg.LogValidatorPerformance,
 		distributed:             cfg.Distributed,
+		doppelgangerEpochs:      cfg.DoppelgangerEpochs,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -177,7 +180,21 @@ func (v *ValidatorService) Start() {
 		hosts[0],
 	)
 
-	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler)
+	// The doppelganger check requests validators liveness from every beacon node.
+	livenessHandlers := make([]beaconApi.JsonRestHandler, len(hosts))
+	for i, host := range hosts {
+		livenessHandlers[i] = beaconApi.NewBeaconApiJsonRestHandler(
+			http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
+			host,
+		)
+	}
+
+	validatorClient := validatorclientfactory.NewValidatorClient(
+		v.conn,
+		restHandler,
+		beaconApi.WithDoppelgangerEpochs(v.doppelgangerEpochs),
+		beaconApi.WithLivenessHandlers(livenessHandlers),
+	)
 
 	valStruct := &validator{
 		slotFeed:                       new(event.Feed),
@@ -215,6 +232,7 @@ func (v *ValidatorService) Start() {
 		emitAccountMetrics:             v.emitAccountMetrics,
 		useWeb:                         v.useWeb,
 		distributed:                    v.distributed,
+		doppelgangerStatuses:           make(map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus),
 	}
 
 	v.validator = valStruct
@@ -256,6 +274,9 @@ func (v *ValidatorService) RemoteSignerConfig() *remoteweb3signer.SetupConfig {
 
 // ProposerSettings returns a deep copy of the underlying proposer settings in the validator
 func (v *ValidatorService) ProposerSettings() *proposer.Settings {
+	if v.validator == nil {
+		return v.proposerSettings.Clone()
+	}
 	settings := v.validator.ProposerSettings()
 	if settings != nil {
 		return settings.Clone()
@@ -267,6 +288,9 @@ func (v *ValidatorService) ProposerSettings() *proposer.Settings {
 func (v *ValidatorService) SetProposerSettings(ctx context.Context, settings *proposer.Settings) error {
 	// validator service proposer settings is only used for pass through from node -> validator service -> validator.
 	// in memory use of proposer settings happens on validator.
+	if v.validator == nil {
+		return errors.New("validator is unavailable")
+	}
 	v.proposerSettings = settings
 
 	// passes settings down to be updated in database and saved in memory.
@@ -328,6 +352,14 @@ func ConstructDialOptions(
 	return dialOpts
 }
 
+// DoppelgangerStatuses returns the doppelganger protection status of the keys checked by the validator.
+func (v *ValidatorService) DoppelgangerStatuses() (map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus, error) {
+	if v.validator == nil {
+		return nil, errors.New("validator is unavailable")
+	}
+	return v.validator.DoppelgangerStatuses(), nil
+}
+
 func (v *ValidatorService) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
 	if v.validator == nil {
 		return nil, errors.New("validator is unavailable")
@@ -291,6 +291,11 @@ func (fv *FakeValidator) SetProposerSettings(_ context.Context, settings *propos
 	return nil
 }
 
+// DoppelgangerStatuses for mocking
+func (*FakeValidator) DoppelgangerStatuses() map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus {
+	return nil
+}
+
 // Graffiti for mocking
 func (fv *FakeValidator) Graffiti(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
 	return []byte(fv.graffiti), nil
@@ -116,6 +116,10 @@ type validator struct {
 	blacklistedPubkeysLock             sync.RWMutex
 	attSelectionLock                   sync.Mutex
 	dutiesLock                         sync.RWMutex
+	doppelgangerStatuses               map[[fieldparams.BLSPubkeyLength]byte]iface.DoppelgangerStatus
+	doppelgangerLock                   sync.RWMutex
+	clientVersions                     *clientVersions
+	clientVersionsLock                 sync.Mutex
 }
 
 type validatorStatus struct {
@@ -452,12 +456,23 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	if len(pubkeys) == 0 {
 		return nil
 	}
+	v.setDoppelgangerStatuses(pubkeys, iface.DoppelgangerPending)
+	responses, err := v.doppelgangerCheck(ctx, pubkeys)
+	if err != nil {
+		return err
+	}
+	return buildDuplicateError(responses)
+}
+
+// doppelgangerCheck requests the beacon node to check the given keys for doppelgangers,
+// and updates the doppelganger status of the keys accordingly.
+func (v *validator) doppelgangerCheck(ctx context.Context, pubkeys [][fieldparams.BLSPubkeyLength]byte) ([]*ethpb.DoppelGangerResponse_ValidatorResponse, error) {
 	req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
 	for _, pkey := range pubkeys {
 		copiedKey := pkey
 		attRec, err := v.db.AttestationHistoryForPubKey(ctx, copiedKey)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if len(attRec) == 0 {
 			// If no history exists we simply send in a zero
@@ -472,7 +487,7 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 		}
 		r := retrieveLatestRecord(attRec)
 		if copiedKey != r.PubKey {
-			return errors.New("attestation record mismatched public key")
+			return nil, errors.New("attestation record mismatched public key")
 		}
 		req.ValidatorRequests = append(req.ValidatorRequests,
 			&ethpb.DoppelGangerRequest_ValidatorRequest{
@@ -483,14 +498,15 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	}
 	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// If nothing is returned by the beacon node, we return an
 	// error as it is unsafe for us to proceed.
 	if resp == nil || resp.Responses == nil || len(resp.Responses) == 0 {
-		return errors.New("beacon node returned 0 responses for doppelganger check")
+		return nil, errors.New("beacon node returned 0 responses for doppelganger check")
 	}
-	return buildDuplicateError(resp.Responses)
+	v.updateDoppelgangerStatuses(pubkeys, resp.Responses)
+	return resp.Responses, nil
 }
 
 func buildDuplicateError(response []*ethpb.DoppelGangerResponse_ValidatorResponse) error {
@@ -723,6 +739,10 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 		if duty == nil {
 			continue
 		}
+		// Keys not yet cleared by the doppelganger protection do not perform any duty.
+		if !v.isDoppelgangerSafe(bytesutil.ToBytes48(duty.PublicKey)) {
+			continue
+		}
 		if len(duty.ProposerSlots) > 0 {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
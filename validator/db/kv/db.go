@@ -58,6 +58,8 @@ var blockedBuckets = [][]byte{
 // Config represents store's config object.
 type Config struct {
 	PubKeys [][fieldparams.BLSPubkeyLength]byte
+	// ReadOnly opens an existing database without writing anything to it.
+	ReadOnly bool
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -125,10 +127,12 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			return nil, err
 		}
 	}
+	readOnly := config != nil && config.ReadOnly
 	datafile := filepath.Join(dirPath, ProtectionDbFileName)
 	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
 		Timeout:         params.BeaconIoConfig().BoltTimeout,
 		InitialMmapSize: mmapSize,
+		ReadOnly:        readOnly,
 	})
 	if err != nil {
 		if errors.Is(err, bolt.ErrTimeout) {
@@ -145,6 +149,10 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		batchAttestationsFlushedFeed: new(event.Feed),
 	}
 
+	if readOnly {
+		return kv, prometheus.Register(createBoltCollector(kv.db))
+	}
+
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
 			tx,
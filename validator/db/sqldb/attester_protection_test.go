@@ -0,0 +1,218 @@
+package sqldb
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func attestation(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+func TestStore_LowestSignedSourceAndTargetEpoch(t *testing.T) {
+	ctx := context.Background()
+	pubkey := getPubKeys(t, 1)[0]
+	s, _ := setupStore(t, nil)
+
+	_, exists, err := s.LowestSignedSourceEpoch(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, false, exists, "lowest signed source epoch should not exist")
+
+	_, exists, err = s.LowestSignedTargetEpoch(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, false, exists, "lowest signed target epoch should not exist")
+
+	require.NoError(t, s.SaveAttestationForPubKey(ctx, pubkey, [32]byte{}, attestation(42, 43)))
+
+	source, exists, err := s.LowestSignedSourceEpoch(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists, "lowest signed source epoch should exist")
+	require.Equal(t, primitives.Epoch(42), source)
+
+	target, exists, err := s.LowestSignedTargetEpoch(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists, "lowest signed target epoch should exist")
+	require.Equal(t, primitives.Epoch(43), target)
+}
+
+func TestStore_AttestedPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	pubkeys := getPubKeys(t, 5)
+	s, _ := setupStore(t, &Config{PubKeys: pubkeys})
+
+	// Attest for some pubkeys.
+	attestedPubkeys := pubkeys[1:3]
+	for _, pubkey := range attestedPubkeys {
+		require.NoError(t, s.SaveAttestationForPubKey(ctx, pubkey, [32]byte{}, attestation(42, 43)))
+	}
+
+	actual, err := s.AttestedPublicKeys(ctx)
+	require.NoError(t, err, "AttestedPublicKeys should not return an error")
+
+	expected := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(attestedPubkeys))
+	for _, pubkey := range attestedPubkeys {
+		expected[pubkey] = true
+	}
+
+	require.Equal(t, len(expected), len(actual))
+	for _, pubkey := range actual {
+		require.Equal(t, true, expected[pubkey], "unexpected attested public key")
+	}
+}
+
+func TestStore_SaveAttestationForPubKey(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		saved         *ethpb.IndexedAttestation
+		incoming      *ethpb.IndexedAttestation
+		expectedErr   string
+		expectedSaved *ethpb.IndexedAttestation
+	}{
+		{
+			name:          "no attestation saved",
+			incoming:      attestation(42, 43),
+			expectedSaved: attestation(42, 43),
+		},
+		{
+			name:          "surrounding attestation",
+			saved:         attestation(42, 43),
+			incoming:      attestation(44, 45),
+			expectedSaved: attestation(44, 45),
+		},
+		{
+			name:          "source lower than recorded",
+			saved:         attestation(42, 43),
+			incoming:      attestation(41, 45),
+			expectedErr:   "could not sign attestation with source lower than recorded source epoch",
+			expectedSaved: attestation(42, 43),
+		},
+		{
+			name:          "target equal to recorded",
+			saved:         attestation(42, 43),
+			incoming:      attestation(42, 43),
+			expectedErr:   "could not sign attestation with target lower than or equal to recorded target epoch",
+			expectedSaved: attestation(42, 43),
+		},
+		{
+			name:          "incoming attestation without target",
+			incoming:      &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{}}},
+			expectedErr:   "incoming attestation does not contain source and/or target epoch",
+			expectedSaved: nil,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			pubkey := getPubKeys(t, 1)[0]
+			s, _ := setupStore(t, nil)
+
+			if tt.saved != nil {
+				require.NoError(t, s.SaveAttestationForPubKey(ctx, pubkey, [32]byte{}, tt.saved))
+			}
+
+			err := s.SaveAttestationForPubKey(ctx, pubkey, [32]byte{}, tt.incoming)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, tt.expectedErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			history, err := s.AttestationHistoryForPubKey(ctx, pubkey)
+			require.NoError(t, err)
+
+			if tt.expectedSaved == nil {
+				require.Equal(t, 0, len(history))
+				return
+			}
+
+			require.DeepEqual(t, []*common.AttestationRecord{
+				{
+					PubKey: pubkey,
+					Source: tt.expectedSaved.Data.Source.Epoch,
+					Target: tt.expectedSaved.Data.Target.Epoch,
+				},
+			}, history)
+		})
+	}
+}
+
+func TestStore_SaveAttestationsForPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubkey := getPubKeys(t, 1)[0]
+	s, _ := setupStore(t, nil)
+
+	require.NoError(t, s.SaveAttestationForPubKey(ctx, pubkey, [32]byte{}, attestation(50, 51)))
+
+	// Incoming attestations are not checked, the maximums are saved.
+	atts := []*ethpb.IndexedAttestation{attestation(40, 60), attestation(45, 46)}
+	require.NoError(t, s.SaveAttestationsForPubKey(ctx, pubkey, nil, atts))
+
+	history, err := s.AttestationHistoryForPubKey(ctx, pubkey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*common.AttestationRecord{{PubKey: pubkey, Source: 50, Target: 60}}, history)
+}
+
+func TestStore_SaveAttestationForPubKey_SharedDatabase(t *testing.T) {
+	const (
+		clientsCount  = 2
+		attemptsCount = 20
+	)
+
+	ctx := context.Background()
+	pubkey := getPubKeys(t, 1)[0]
+
+	// Several validator clients sharing the same database.
+	first, dataSourceName := setupStore(t, nil)
+	stores := []*Store{first}
+	for i := 1; i < clientsCount; i++ {
+		s, err := NewStore(ctx, SQLiteDriverName, dataSourceName, nil)
+		require.NoError(t, err, "NewStore should not return an error")
+		stores = append(stores, s)
+	}
+
+	// Every client tries to sign the same attestations.
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		successes = make(map[primitives.Epoch]int)
+	)
+
+	for _, s := range stores {
+		wg.Add(1)
+		go func(s *Store) {
+			defer wg.Done()
+
+			for target := primitives.Epoch(1); target <= attemptsCount; target++ {
+				if err := s.SaveAttestationForPubKey(ctx, pubkey, [32]byte{}, attestation(target-1, target)); err != nil {
+					continue
+				}
+
+				mu.Lock()
+				successes[target]++
+				mu.Unlock()
+			}
+		}(s)
+	}
+
+	wg.Wait()
+
+	// No attestation has been allowed twice.
+	for target, count := range successes {
+		require.Equal(t, 1, count, "attestation with target %d signed by several clients", target)
+	}
+
+	for _, s := range stores[1:] {
+		require.NoError(t, s.Close())
+	}
+}
@@ -0,0 +1,175 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNoProposerSettingsFound is an error thrown when no settings are found.
+var ErrNoProposerSettingsFound = errors.New("no proposer settings found in database")
+
+// GenesisValidatorsRoot returns the genesis validators root, or nil if it was never saved.
+func (s *Store) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	genValRoot, _, err := s.configurationValue(ctx, s.db, sharedClientID, genesisValidatorsRootKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis validators root")
+	}
+
+	return genValRoot, nil
+}
+
+// SaveGenesisValidatorsRoot saves the genesis validators root to db.
+func (s *Store) SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	// Return nil if genesis validators root is empty.
+	if genValRoot == nil {
+		return nil
+	}
+
+	return s.update(ctx, func(tx *sql.Tx) error {
+		return s.saveConfigurationValue(ctx, tx, sharedClientID, genesisValidatorsRootKey, genValRoot)
+	})
+}
+
+// SaveGraffitiOrderedIndex saves the graffiti ordered index.
+func (s *Store) SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error {
+	return s.update(ctx, func(tx *sql.Tx) error {
+		return s.saveConfigurationValue(ctx, tx, s.clientID, graffitiOrderedIndexKey, bytesutil.Uint64ToBytesBigEndian(index))
+	})
+}
+
+// GraffitiOrderedIndex returns the graffiti ordered index for the given graffiti file hash.
+// If the file hash differs from the recorded one, the ordered index is reset to 0.
+func (s *Store) GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error) {
+	var orderedIndex uint64
+
+	if err := s.update(ctx, func(tx *sql.Tx) error {
+		savedFileHash, exists, err := s.configurationValue(ctx, tx, s.clientID, graffitiFileHashKey)
+		if err != nil {
+			return err
+		}
+
+		// The graffiti file changed, start again from the beginning of the ordered list.
+		if !exists || len(savedFileHash) != len(fileHash) || [32]byte(savedFileHash) != fileHash {
+			if err := s.saveConfigurationValue(ctx, tx, s.clientID, graffitiFileHashKey, fileHash[:]); err != nil {
+				return err
+			}
+
+			return s.saveConfigurationValue(ctx, tx, s.clientID, graffitiOrderedIndexKey, bytesutil.Uint64ToBytesBigEndian(0))
+		}
+
+		savedOrderedIndex, _, err := s.configurationValue(ctx, tx, s.clientID, graffitiOrderedIndexKey)
+		if err != nil {
+			return err
+		}
+
+		orderedIndex = bytesutil.BytesToUint64BigEndian(savedOrderedIndex)
+		return nil
+	}); err != nil {
+		return 0, errors.Wrap(err, "could not get graffiti ordered index")
+	}
+
+	return orderedIndex, nil
+}
+
+// GraffitiFileHash returns the graffiti file hash and whether it exists.
+func (s *Store) GraffitiFileHash() ([32]byte, bool, error) {
+	fileHash, exists, err := s.configurationValue(context.Background(), s.db, s.clientID, graffitiFileHashKey)
+	if err != nil {
+		return [32]byte{}, false, errors.Wrap(err, "could not get graffiti file hash")
+	}
+
+	if !exists {
+		return [32]byte{}, false, nil
+	}
+
+	if len(fileHash) != 32 {
+		return [32]byte{}, false, errors.Errorf("invalid graffiti file hash length %d", len(fileHash))
+	}
+
+	return [32]byte(fileHash), true, nil
+}
+
+// ProposerSettings returns the proposer settings of the validator client.
+func (s *Store) ProposerSettings(ctx context.Context) (*proposer.Settings, error) {
+	encoded, exists, err := s.configurationValue(ctx, s.db, s.clientID, proposerSettingsKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer settings")
+	}
+
+	if !exists {
+		return nil, ErrNoProposerSettingsFound
+	}
+
+	payload := &validatorpb.ProposerSettingsPayload{}
+	if err := proto.Unmarshal(encoded, payload); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer settings")
+	}
+
+	return proposer.SettingFromConsensus(payload)
+}
+
+// ProposerSettingsExists returns true if proposer settings exists, false otherwise.
+func (s *Store) ProposerSettingsExists(ctx context.Context) (bool, error) {
+	_, exists, err := s.configurationValue(ctx, s.db, s.clientID, proposerSettingsKey)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get proposer settings")
+	}
+
+	return exists, nil
+}
+
+// SaveProposerSettings saves the proposer settings of the validator client.
+func (s *Store) SaveProposerSettings(ctx context.Context, proposerSettings *proposer.Settings) error {
+	// Check if there is something to save.
+	if !proposerSettings.ShouldBeSaved() {
+		log.Warn("proposer settings are empty, nothing has been saved")
+		return nil
+	}
+
+	encoded, err := proto.Marshal(proposerSettings.ToConsensus())
+	if err != nil {
+		return errors.Wrap(err, "could not marshal proposer settings")
+	}
+
+	return s.update(ctx, func(tx *sql.Tx) error {
+		return s.saveConfigurationValue(ctx, tx, s.clientID, proposerSettingsKey, encoded)
+	})
+}
+
+// configurationValue returns the value stored under name for the client in the configuration table and whether it exists.
+func (s *Store) configurationValue(ctx context.Context, q querier, clientID, name string) ([]byte, bool, error) {
+	query := s.dialect.rebind(fmt.Sprintf("SELECT value FROM %s WHERE client_id = ? AND name = ?", configurationTable))
+
+	var value []byte
+	err := q.QueryRowContext(ctx, query, clientID, name).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "could not read %s", name)
+	}
+
+	return value, true, nil
+}
+
+// saveConfigurationValue stores value under name for the client in the configuration table, overriding any previous value.
+func (s *Store) saveConfigurationValue(ctx context.Context, tx *sql.Tx, clientID, name string, value []byte) error {
+	query := s.dialect.rebind(fmt.Sprintf(
+		"INSERT INTO %s (client_id, name, value) VALUES (?, ?, ?) ON CONFLICT (client_id, name) DO UPDATE SET value = excluded.value",
+		configurationTable,
+	))
+
+	if _, err := tx.ExecContext(ctx, query, clientID, name, value); err != nil {
+		return errors.Wrapf(err, "could not save %s", name)
+	}
+
+	return nil
+}
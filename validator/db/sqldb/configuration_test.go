@@ -0,0 +1,143 @@
+package sqldb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_GenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	s, _ := setupStore(t, nil)
+
+	genValRoot, err := s.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.IsNil(t, genValRoot)
+
+	expected := []byte{1, 2, 3}
+	require.NoError(t, s.SaveGenesisValidatorsRoot(ctx, expected))
+
+	actual, err := s.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, actual)
+}
+
+func TestStore_GraffitiOrderedIndex(t *testing.T) {
+	ctx := context.Background()
+	s, _ := setupStore(t, nil)
+
+	_, exists, err := s.GraffitiFileHash()
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	fileHash := [32]byte{1}
+	index, err := s.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+
+	require.NoError(t, s.SaveGraffitiOrderedIndex(ctx, 42))
+
+	index, err = s.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), index)
+
+	savedFileHash, exists, err := s.GraffitiFileHash()
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, fileHash, savedFileHash)
+
+	// A new graffiti file resets the ordered index.
+	index, err = s.GraffitiOrderedIndex(ctx, [32]byte{2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+}
+
+func TestStore_ProposerSettings(t *testing.T) {
+	ctx := context.Background()
+	s, _ := setupStore(t, nil)
+
+	_, err := s.ProposerSettings(ctx)
+	require.ErrorIs(t, err, ErrNoProposerSettingsFound)
+
+	exists, err := s.ProposerSettingsExists(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	expected := &proposer.Settings{
+		DefaultConfig: &proposer.Option{
+			FeeRecipientConfig: &proposer.FeeRecipientConfig{
+				FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
+			},
+		},
+	}
+	require.NoError(t, s.SaveProposerSettings(ctx, expected))
+
+	exists, err = s.ProposerSettingsExists(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+
+	actual, err := s.ProposerSettings(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, actual)
+}
+
+func TestStore_Configuration_TwoClients(t *testing.T) {
+	ctx := context.Background()
+	first, dataSourceName := setupStore(t, &Config{ClientID: "first"})
+	second, err := NewStore(ctx, SQLiteDriverName, dataSourceName, &Config{ClientID: "second"})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, second.Close())
+	}()
+
+	// The genesis validators root is shared by the clients.
+	genValRoot := []byte{1, 2, 3}
+	require.NoError(t, first.SaveGenesisValidatorsRoot(ctx, genValRoot))
+	actualGenValRoot, err := second.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, genValRoot, actualGenValRoot)
+
+	// The proposer settings are kept apart.
+	settings := func(feeRecipient string) *proposer.Settings {
+		return &proposer.Settings{
+			DefaultConfig: &proposer.Option{
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{
+					FeeRecipient: common.HexToAddress(feeRecipient),
+				},
+			},
+		}
+	}
+	firstSettings := settings("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	require.NoError(t, first.SaveProposerSettings(ctx, firstSettings))
+	exists, err := second.ProposerSettingsExists(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	secondSettings := settings("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	require.NoError(t, second.SaveProposerSettings(ctx, secondSettings))
+	actualSettings, err := first.ProposerSettings(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, firstSettings, actualSettings)
+	actualSettings, err = second.ProposerSettings(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, secondSettings, actualSettings)
+
+	// The graffiti information is kept apart.
+	fileHash := [32]byte{1}
+	_, err = first.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.NoError(t, first.SaveGraffitiOrderedIndex(ctx, 42))
+	_, exists, err = second.GraffitiFileHash()
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	index, err := second.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+	index, err = first.GraffitiOrderedIndex(ctx, fileHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), index)
+}
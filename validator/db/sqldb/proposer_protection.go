@@ -0,0 +1,143 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// HighestSignedProposal returns the (unique) signed proposal slot for a public key.
+func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	return s.latestSignedBlockSlot(ctx, publicKey)
+}
+
+// LowestSignedProposal returns the (unique) signed proposal slot for a public key.
+func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	return s.latestSignedBlockSlot(ctx, publicKey)
+}
+
+// ProposalHistoryForPubKey returns the proposal history for a given public key.
+func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*common.Proposal, error) {
+	slot, exists, err := s.latestSignedBlockSlot(ctx, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// If there is no proposed block, return an empty slice.
+	if !exists {
+		return []*common.Proposal{}, nil
+	}
+
+	// Return the (unique) proposal history.
+	return []*common.Proposal{{Slot: slot}}, nil
+}
+
+// ProposalHistoryForSlot returns whether a proposal was signed at the given slot.
+// Signing roots are not recorded in the minimal slashing protection database, so the returned
+// signing root is always empty.
+func (s *Store) ProposalHistoryForSlot(
+	ctx context.Context,
+	publicKey [fieldparams.BLSPubkeyLength]byte,
+	slot primitives.Slot,
+) ([fieldparams.RootLength]byte, bool, bool, error) {
+	latestSlot, exists, err := s.latestSignedBlockSlot(ctx, publicKey)
+	if err != nil {
+		return [fieldparams.RootLength]byte{}, false, false, err
+	}
+
+	return [fieldparams.RootLength]byte{}, exists && latestSlot == slot, false, nil
+}
+
+// SaveProposalHistoryForSlot checks if the incoming proposal is valid regarding EIP-3076 minimal slashing protection.
+// If so, it updates the database with the incoming slot, and returns nil.
+// If not, it does not modify the database and return an error.
+// The check and the update are done atomically, while holding the lock of the public key row.
+func (s *Store) SaveProposalHistoryForSlot(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	slot primitives.Slot,
+	_ []byte,
+) error {
+	slotUInt64 := uint64(slot)
+
+	return s.update(ctx, func(tx *sql.Tx) error {
+		// Get and lock validator slashing protection.
+		validatorSlashingProtection, err := s.lockValidatorSlashingProtection(ctx, tx, pubKey)
+		if err != nil {
+			return errors.Wrap(err, "could not get validator slashing protection")
+		}
+
+		// Based on EIP-3076 (minimal database), validator should refuse to sign any proposal
+		// with slot less than or equal to the latest signed block slot in the DB.
+		latestSignedBlockSlot := validatorSlashingProtection.latestSignedBlockSlot
+		if latestSignedBlockSlot != nil && slotUInt64 <= *latestSignedBlockSlot {
+			return errors.Errorf(
+				"could not sign proposal with slot lower than or equal to recorded slot, %d <= %d",
+				slot,
+				*latestSignedBlockSlot,
+			)
+		}
+
+		// Update the latest signed block slot.
+		validatorSlashingProtection.latestSignedBlockSlot = &slotUInt64
+
+		// Save the validator slashing protection.
+		if err := s.saveValidatorSlashingProtection(ctx, tx, pubKey, validatorSlashingProtection); err != nil {
+			return errors.Wrap(err, "could not save validator slashing protection")
+		}
+
+		return nil
+	})
+}
+
+// ProposedPublicKeys returns the list of public keys we have in the database.
+// To be consistent with the complete, BoltDB implementation, pubkeys returned by
+// this function do not necessarily have proposed a block.
+func (s *Store) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return s.publicKeys(ctx, false)
+}
+
+// SlashableProposalCheck checks if a block proposal is slashable by comparing it with the
+// block proposals history for the given public key in our minimal slashing protection database defined by EIP-3076.
+// If it is not, it update the database.
+func (s *Store) SlashableProposalCheck(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signedBlock interfaces.ReadOnlySignedBeaconBlock,
+	signingRoot [fieldparams.RootLength]byte,
+	_ bool,
+	_ *prometheus.CounterVec,
+) error {
+	// Check if the proposal is potentially slashable regarding EIP-3076 minimal conditions.
+	// If not, save the new proposal into the database.
+	if err := s.SaveProposalHistoryForSlot(ctx, pubKey, signedBlock.Block().Slot(), signingRoot[:]); err != nil {
+		if strings.Contains(err.Error(), "could not sign proposal") {
+			return errors.Wrapf(err, common.FailedBlockSignLocalErr)
+		}
+
+		return errors.Wrap(err, "failed to save updated proposal history")
+	}
+
+	return nil
+}
+
+// latestSignedBlockSlot returns the latest signed block slot of a public key and whether it exists.
+func (s *Store) latestSignedBlockSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	validatorSlashingProtection, err := s.validatorSlashingProtection(ctx, s.db, publicKey, false)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "could not get validator slashing protection")
+	}
+
+	if validatorSlashingProtection == nil || validatorSlashingProtection.latestSignedBlockSlot == nil {
+		return 0, false, nil
+	}
+
+	return primitives.Slot(*validatorSlashingProtection.latestSignedBlockSlot), true, nil
+}
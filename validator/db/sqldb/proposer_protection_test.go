@@ -0,0 +1,65 @@
+package sqldb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_SaveProposalHistoryForSlot(t *testing.T) {
+	ctx := context.Background()
+	pubkey := getPubKeys(t, 1)[0]
+	s, _ := setupStore(t, nil)
+
+	history, err := s.ProposalHistoryForPubKey(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(history))
+
+	// First proposal.
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubkey, 42, nil))
+
+	// Same slot is rejected.
+	err = s.SaveProposalHistoryForSlot(ctx, pubkey, 42, nil)
+	require.ErrorContains(t, "could not sign proposal with slot lower than or equal to recorded slot", err)
+
+	// Lower slot is rejected.
+	err = s.SaveProposalHistoryForSlot(ctx, pubkey, 41, nil)
+	require.ErrorContains(t, "could not sign proposal with slot lower than or equal to recorded slot", err)
+
+	// Higher slot is accepted.
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubkey, 43, nil))
+
+	history, err = s.ProposalHistoryForPubKey(ctx, pubkey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*common.Proposal{{Slot: 43}}, history)
+
+	highest, exists, err := s.HighestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, primitives.Slot(43), highest)
+
+	_, proposed, _, err := s.ProposalHistoryForSlot(ctx, pubkey, 43)
+	require.NoError(t, err)
+	require.Equal(t, true, proposed)
+}
+
+func TestStore_SlashableProposalCheck(t *testing.T) {
+	ctx := context.Background()
+	pubkey := getPubKeys(t, 1)[0]
+	s, _ := setupStore(t, nil)
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 42
+	signedBlock, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SlashableProposalCheck(ctx, pubkey, signedBlock, [32]byte{}, false, nil))
+
+	err = s.SlashableProposalCheck(ctx, pubkey, signedBlock, [32]byte{}, false, nil)
+	require.ErrorContains(t, common.FailedBlockSignLocalErr, err)
+}
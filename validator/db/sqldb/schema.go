@@ -0,0 +1,166 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// slashingProtectionTable contains one row per public key, holding the minimal
+	// slashing protection data defined by EIP-3076.
+	slashingProtectionTable = "validator_slashing_protection"
+
+	// configurationTable contains the genesis validators root, shared by all the validator clients,
+	// and the proposer settings and the graffiti information of each validator client.
+	configurationTable = "validator_configuration"
+
+	// sharedClientID is the client identifier of the configuration values shared by all the validator clients.
+	sharedClientID = ""
+)
+
+// Keys of the configuration table.
+const (
+	genesisValidatorsRootKey = "genesis_validators_root"
+	proposerSettingsKey      = "proposer_settings"
+	graffitiFileHashKey      = "graffiti_file_hash"
+	graffitiOrderedIndexKey  = "graffiti_ordered_index"
+)
+
+// dialect holds what differs between the supported SQL databases.
+type dialect struct {
+	driverName string
+
+	// blobType is the column type used to store raw bytes.
+	blobType string
+
+	// lockRowSuffix is appended to a SELECT statement to lock the selected rows
+	// until the end of the transaction.
+	lockRowSuffix string
+
+	// numberedPlaceholders is true if the database expects $1, $2, ... instead of ? placeholders.
+	numberedPlaceholders bool
+
+	// prepareDataSourceName adds the connection parameters the store relies on.
+	prepareDataSourceName func(dataSourceName string) string
+
+	// readOnlyDataSourceName adds the connection parameters preventing any write to the database.
+	readOnlyDataSourceName func(dataSourceName string) string
+}
+
+var dialects = map[string]*dialect{
+	PostgresDriverName: {
+		driverName:            PostgresDriverName,
+		blobType:              "BYTEA",
+		lockRowSuffix:         " FOR UPDATE",
+		numberedPlaceholders:  true,
+		prepareDataSourceName: func(dataSourceName string) string { return dataSourceName },
+		readOnlyDataSourceName: func(dataSourceName string) string {
+			// Keyword/value form, e.g. host=localhost user=prysm
+			if !strings.Contains(dataSourceName, "://") {
+				return dataSourceName + " default_transaction_read_only=on"
+			}
+
+			return withParameter(dataSourceName, "default_transaction_read_only=on")
+		},
+	},
+	SQLiteDriverName: {
+		driverName: SQLiteDriverName,
+		blobType:   "BLOB",
+		// SQLite has no row level locks: transactions are started with BEGIN IMMEDIATE
+		// so the whole database is locked for writing as soon as a transaction starts.
+		lockRowSuffix:        "",
+		numberedPlaceholders: false,
+		prepareDataSourceName: func(dataSourceName string) string {
+			return withParameter(dataSourceName, fmt.Sprintf("_txlock=immediate&_busy_timeout=%d", sqliteBusyTimeoutMillis))
+		},
+		// The access mode is only applied to URI filenames, which also prevents
+		// the creation of a database which does not exist.
+		readOnlyDataSourceName: func(dataSourceName string) string {
+			if !strings.HasPrefix(dataSourceName, "file:") {
+				dataSourceName = "file:" + dataSourceName
+			}
+
+			return withParameter(dataSourceName, "mode=ro")
+		},
+	},
+}
+
+// withParameter appends a query parameter to a data source name in URL form.
+func withParameter(dataSourceName, parameter string) string {
+	separator := "?"
+	if strings.Contains(dataSourceName, "?") {
+		separator = "&"
+	}
+
+	return dataSourceName + separator + parameter
+}
+
+// rebind converts a query written with ? placeholders into the placeholder syntax of the dialect.
+func (d *dialect) rebind(query string) string {
+	if !d.numberedPlaceholders {
+		return query
+	}
+
+	var (
+		builder strings.Builder
+		index   int
+	)
+
+	for _, r := range query {
+		if r != '?' {
+			builder.WriteRune(r)
+			continue
+		}
+
+		index++
+		builder.WriteString("$" + strconv.Itoa(index))
+	}
+
+	return builder.String()
+}
+
+// createSchema creates the tables of the store if they do not exist yet.
+func (s *Store) createSchema(ctx context.Context) error {
+	statements := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			pubkey %s PRIMARY KEY,
+			latest_signed_block_slot BIGINT,
+			last_signed_attestation_source_epoch BIGINT NOT NULL DEFAULT 0,
+			last_signed_attestation_target_epoch BIGINT
+		)`, slashingProtectionTable, s.dialect.blobType),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			client_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			value %s NOT NULL,
+			PRIMARY KEY (client_id, name)
+		)`, configurationTable, s.dialect.blobType),
+	}
+
+	for _, statement := range statements {
+		if _, err := s.db.ExecContext(ctx, statement); err != nil {
+			return errors.Wrap(err, "could not create table")
+		}
+	}
+
+	return nil
+}
+
+// checkSchema ensures the tables of the store exist, without creating them.
+func (s *Store) checkSchema(ctx context.Context) error {
+	for _, table := range []string{slashingProtectionTable, configurationTable} {
+		rows, err := s.db.QueryContext(ctx, fmt.Sprintf("SELECT 1 FROM %s LIMIT 1", table))
+		if err != nil {
+			return errors.Wrapf(err, "could not read table %s", table)
+		}
+
+		if err := rows.Close(); err != nil {
+			return errors.Wrapf(err, "could not read table %s", table)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+)
+
+// validatorSlashingProtection contains the latest signed block slot and the last signed attestation
+// source and target epochs of a validator.
+type validatorSlashingProtection struct {
+	latestSignedBlockSlot            *uint64
+	lastSignedAttestationSourceEpoch uint64
+	lastSignedAttestationTargetEpoch *uint64
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// insertPublicKey creates an empty slashing protection row for the public key, if it does not exist yet.
+func (s *Store) insertPublicKey(ctx context.Context, tx *sql.Tx, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	query := s.dialect.rebind(fmt.Sprintf(
+		"INSERT INTO %s (pubkey) VALUES (?) ON CONFLICT (pubkey) DO NOTHING",
+		slashingProtectionTable,
+	))
+
+	if _, err := tx.ExecContext(ctx, query, pubKey[:]); err != nil {
+		return errors.Wrapf(err, "could not insert public key %#x", pubKey)
+	}
+
+	return nil
+}
+
+// validatorSlashingProtection returns the slashing protection for a public key, or nil if the public key is unknown.
+func (s *Store) validatorSlashingProtection(
+	ctx context.Context,
+	q querier,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	lock bool,
+) (*validatorSlashingProtection, error) {
+	query := fmt.Sprintf(
+		`SELECT latest_signed_block_slot, last_signed_attestation_source_epoch, last_signed_attestation_target_epoch
+		FROM %s WHERE pubkey = ?`,
+		slashingProtectionTable,
+	)
+
+	if lock {
+		query += s.dialect.lockRowSuffix
+	}
+
+	var (
+		latestSignedBlockSlot            sql.NullInt64
+		lastSignedAttestationSourceEpoch int64
+		lastSignedAttestationTargetEpoch sql.NullInt64
+	)
+
+	err := q.QueryRowContext(ctx, s.dialect.rebind(query), pubKey[:]).Scan(
+		&latestSignedBlockSlot,
+		&lastSignedAttestationSourceEpoch,
+		&lastSignedAttestationTargetEpoch,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read slashing protection of public key %#x", pubKey)
+	}
+
+	return &validatorSlashingProtection{
+		latestSignedBlockSlot:            nullableUint64(latestSignedBlockSlot),
+		lastSignedAttestationSourceEpoch: uint64(lastSignedAttestationSourceEpoch),
+		lastSignedAttestationTargetEpoch: nullableUint64(lastSignedAttestationTargetEpoch),
+	}, nil
+}
+
+// lockValidatorSlashingProtection returns the slashing protection for a public key, creating it if needed.
+// The row of the public key stays locked until the end of the transaction, so concurrent
+// validator clients sharing the database check and update it one after the other.
+func (s *Store) lockValidatorSlashingProtection(
+	ctx context.Context,
+	tx *sql.Tx,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+) (*validatorSlashingProtection, error) {
+	if err := s.insertPublicKey(ctx, tx, pubKey); err != nil {
+		return nil, err
+	}
+
+	validatorSlashingProtection, err := s.validatorSlashingProtection(ctx, tx, pubKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if validatorSlashingProtection == nil {
+		return nil, errors.Errorf("slashing protection of public key %#x vanished while locked", pubKey)
+	}
+
+	return validatorSlashingProtection, nil
+}
+
+// saveValidatorSlashingProtection saves the slashing protection for a public key.
+func (s *Store) saveValidatorSlashingProtection(
+	ctx context.Context,
+	tx *sql.Tx,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	validatorSlashingProtection *validatorSlashingProtection,
+) error {
+	latestSignedBlockSlot, err := nullInt64(validatorSlashingProtection.latestSignedBlockSlot)
+	if err != nil {
+		return err
+	}
+
+	lastSignedAttestationSourceEpoch, err := toInt64(validatorSlashingProtection.lastSignedAttestationSourceEpoch)
+	if err != nil {
+		return err
+	}
+
+	lastSignedAttestationTargetEpoch, err := nullInt64(validatorSlashingProtection.lastSignedAttestationTargetEpoch)
+	if err != nil {
+		return err
+	}
+
+	query := s.dialect.rebind(fmt.Sprintf(
+		`UPDATE %s SET
+			latest_signed_block_slot = ?,
+			last_signed_attestation_source_epoch = ?,
+			last_signed_attestation_target_epoch = ?
+		WHERE pubkey = ?`,
+		slashingProtectionTable,
+	))
+
+	if _, err := tx.ExecContext(
+		ctx,
+		query,
+		latestSignedBlockSlot,
+		lastSignedAttestationSourceEpoch,
+		lastSignedAttestationTargetEpoch,
+		pubKey[:],
+	); err != nil {
+		return errors.Wrapf(err, "could not save slashing protection of public key %#x", pubKey)
+	}
+
+	return nil
+}
+
+// publicKeys returns the public keys existing in the database.
+// If onlyAttested is true, only the public keys which already signed an attestation are returned.
+func (s *Store) publicKeys(ctx context.Context, onlyAttested bool) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	query := fmt.Sprintf("SELECT pubkey FROM %s", slashingProtectionTable)
+	if onlyAttested {
+		query += " WHERE last_signed_attestation_target_epoch IS NOT NULL"
+	}
+
+	rows, err := s.db.QueryContext(ctx, query+" ORDER BY pubkey")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not query public keys")
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.WithError(err).Error("Could not close rows")
+		}
+	}()
+
+	publicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for rows.Next() {
+		var publicKeyBytes []byte
+		if err := rows.Scan(&publicKeyBytes); err != nil {
+			return nil, errors.Wrap(err, "could not scan public key")
+		}
+
+		if len(publicKeyBytes) != fieldparams.BLSPubkeyLength {
+			return nil, errors.Errorf("invalid public key length %d", len(publicKeyBytes))
+		}
+
+		publicKeys = append(publicKeys, [fieldparams.BLSPubkeyLength]byte(publicKeyBytes))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not iterate over public keys")
+	}
+
+	return publicKeys, nil
+}
+
+func nullableUint64(value sql.NullInt64) *uint64 {
+	if !value.Valid {
+		return nil
+	}
+
+	result := uint64(value.Int64)
+	return &result
+}
+
+func nullInt64(value *uint64) (sql.NullInt64, error) {
+	if value == nil {
+		return sql.NullInt64{}, nil
+	}
+
+	result, err := toInt64(*value)
+	if err != nil {
+		return sql.NullInt64{}, err
+	}
+
+	return sql.NullInt64{Int64: result, Valid: true}, nil
+}
@@ -5,7 +5,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
 	"gopkg.in/yaml.v2"
@@ -23,6 +25,8 @@ type Graffiti struct {
 	Ordered  []string                             `yaml:"ordered,omitempty"`
 	Random   []string                             `yaml:"random,omitempty"`
 	Specific map[primitives.ValidatorIndex]string `yaml:"specific,omitempty"`
+	// PubKeys maps 0x prefixed, lower case, validator public keys to their graffiti.
+	PubKeys map[string]string `yaml:"pubkeys,omitempty"`
 }
 
 // ParseGraffitiFile parses the graffiti file and returns the graffiti struct.
@@ -53,12 +57,50 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 		g.Random[i] = ParseHexGraffiti(v)
 	}
 
+	if len(g.PubKeys) > 0 {
+		pubKeys := make(map[string]string, len(g.PubKeys))
+		for k, v := range g.PubKeys {
+			pubKey, err := hexutil.Decode(k)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not decode public key %s", k)
+			}
+			if len(pubKey) != fieldparams.BLSPubkeyLength {
+				return nil, errors.Errorf("public key %s has length %d, wanted %d", k, len(pubKey), fieldparams.BLSPubkeyLength)
+			}
+			pubKeys[hexutil.Encode(pubKey)] = ParseHexGraffiti(v)
+		}
+		g.PubKeys = pubKeys
+	}
+
 	g.Default = ParseHexGraffiti(g.Default)
 	g.Hash = hash.Hash(yamlFile)
 
+	if err := g.validateTemplates(); err != nil {
+		return nil, err
+	}
+
 	return g, nil
 }
 
+// validateTemplates checks all the graffiti templates can be rendered, so errors are reported at startup
+// rather than at proposal time.
+func (g *Graffiti) validateTemplates() error {
+	graffitis := append([]string{g.Default}, g.Ordered...)
+	graffitis = append(graffitis, g.Random...)
+	for _, v := range g.Specific {
+		graffitis = append(graffitis, v)
+	}
+	for _, v := range g.PubKeys {
+		graffitis = append(graffitis, v)
+	}
+	for _, v := range graffitis {
+		if err := ValidateTemplate(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ParseHexGraffiti checks if a graffiti input is being represented in hex and converts it to ASCII if so
 func ParseHexGraffiti(rawGraffiti string) string {
 	splitGraffiti := strings.SplitN(rawGraffiti, ":", 2)
@@ -218,3 +218,50 @@ func TestParseHexGraffiti(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGraffitiFile_PubKeys(t *testing.T) {
+	input := []byte(`default: "Mr T was here"
+pubkeys:
+  "0xA2B5AAAD9C6EFEFE7BB9B1243A043404F3362937CFB6B31833929833173F476630EA2CFEB0D9DDF15F97CA8685948820": "Mr P was here"
+  0xb5a2aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820: "{{.ELClient}}{{.ELVersion}}/{{.ValidatorIndex}}"`)
+
+	dirName := t.TempDir() + "somedir"
+	err := os.MkdirAll(dirName, os.ModePerm)
+	require.NoError(t, err)
+	someFileName := filepath.Join(dirName, "somefile.txt")
+	require.NoError(t, os.WriteFile(someFileName, input, os.ModePerm))
+
+	got, err := ParseGraffitiFile(someFileName)
+	require.NoError(t, err)
+
+	wanted := &Graffiti{
+		Hash:    hash.Hash(input),
+		Default: "Mr T was here",
+		PubKeys: map[string]string{
+			"0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820": "Mr P was here",
+			"0xb5a2aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820": "{{.ELClient}}{{.ELVersion}}/{{.ValidatorIndex}}",
+		},
+	}
+	require.DeepEqual(t, wanted, got)
+}
+
+func TestParseGraffitiFile_InvalidPubKey(t *testing.T) {
+	input := []byte(`pubkeys:
+  "0x1234": "Mr P was here"`)
+
+	someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+	require.NoError(t, os.WriteFile(someFileName, input, os.ModePerm))
+
+	_, err := ParseGraffitiFile(someFileName)
+	require.ErrorContains(t, "public key 0x1234 has length 2", err)
+}
+
+func TestParseGraffitiFile_InvalidTemplate(t *testing.T) {
+	input := []byte(`default: "{{.Unknown}}"`)
+
+	someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+	require.NoError(t, os.WriteFile(someFileName, input, os.ModePerm))
+
+	_, err := ParseGraffitiFile(someFileName)
+	require.ErrorContains(t, "could not render graffiti template", err)
+}
@@ -0,0 +1,63 @@
+package graffiti
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+const templateDelimiter = "{{"
+
+// TemplateData contains the values available to graffiti templates, rendered at proposal time.
+// ELClient is the two letters client code of the execution client (e.g. "GE"), ELVersion and ELCommit
+// its version and commit, and CLVersion the version of the beacon node.
+type TemplateData struct {
+	ValidatorIndex primitives.ValidatorIndex
+	Slot           primitives.Slot
+	ELClient       string
+	ELVersion      string
+	ELCommit       string
+	CLVersion      string
+}
+
+// IsTemplate returns true if the graffiti has to be rendered as a template.
+func IsTemplate(graffiti string) bool {
+	return strings.Contains(graffiti, templateDelimiter)
+}
+
+// ValidateTemplate checks the graffiti template can be parsed and rendered.
+func ValidateTemplate(graffiti string) error {
+	if !IsTemplate(graffiti) {
+		return nil
+	}
+	_, err := RenderTemplate(graffiti, &TemplateData{})
+	return err
+}
+
+// RenderTemplate renders the graffiti template with the given data.
+// The result is truncated to 32 bytes, without splitting a multi-byte character.
+func RenderTemplate(graffiti string, data *TemplateData) ([]byte, error) {
+	tmpl, err := template.New("graffiti").Option("missingkey=error").Parse(graffiti)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse graffiti template %q", graffiti)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrapf(err, "could not render graffiti template %q", graffiti)
+	}
+
+	rendered := buf.Bytes()
+	if len(rendered) > fieldparams.RootLength {
+		log.WithField("graffiti", buf.String()).Warn("Rendered graffiti is longer than 32 bytes, truncating it")
+		rendered = rendered[:fieldparams.RootLength]
+		for len(rendered) > 0 && !utf8.Valid(rendered) {
+			rendered = rendered[:len(rendered)-1]
+		}
+	}
+	return rendered, nil
+}
@@ -0,0 +1,73 @@
+package graffiti
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	data := &TemplateData{
+		ValidatorIndex: 42,
+		Slot:           1234,
+		ELClient:       "GE",
+		ELVersion:      "v1.14.0",
+		ELCommit:       "0xfa4ff922",
+		CLVersion:      "v5.0.3",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "all fields",
+			template: "{{.ELClient}}{{.ELVersion}}PR{{.CLVersion}} {{.ValidatorIndex}}/{{.Slot}}",
+			want:     "GEv1.14.0PRv5.0.3 42/1234",
+		},
+		{
+			name:     "no template",
+			template: "Mr T was here",
+			want:     "Mr T was here",
+		},
+		{
+			name:     "truncated to 32 bytes",
+			template: "{{.ELClient}}{{.ELCommit}} is the best execution client {{.ValidatorIndex}}",
+			want:     "GE0xfa4ff922 is the best executi",
+		},
+		{
+			name:     "multi-byte character not split",
+			template: "0123456789012345678901234567890€",
+			want:     "0123456789012345678901234567890",
+		},
+		{
+			name:     "unknown field",
+			template: "{{.Unknown}}",
+			wantErr:  "could not render graffiti template",
+		},
+		{
+			name:     "invalid syntax",
+			template: "{{.ELClient",
+			wantErr:  "could not parse graffiti template",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderTemplate(tt.template, data)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestIsTemplate(t *testing.T) {
+	assert.Equal(t, true, IsTemplate("{{.Slot}}"))
+	assert.Equal(t, false, IsTemplate("Mr T was here"))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer/loader"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
@@ -46,6 +47,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/sqldb"
 	g "github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
@@ -354,6 +356,11 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	clearFlag := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearFlag := cliCtx.Bool(cmd.ForceClearDB.Name)
 
+	// A shared SQL slashing protection database replaces the local one.
+	if cliCtx.IsSet(flags.SlashingProtectionDBDSNFlag.Name) {
+		return c.initializeSQLDB(cliCtx)
+	}
+
 	// Workaround for https://github.com/prysmaticlabs/prysm/issues/13391
 	kvDataDir, _, err := c.getLegacyDatabaseLocation(
 		isInteropNumValidatorsSet,
@@ -457,6 +464,43 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// initializeSQLDB connects to the SQL slashing protection database, which may be shared with other validator clients.
+func (c *ValidatorClient) initializeSQLDB(cliCtx *cli.Context) error {
+	driverName := cliCtx.String(flags.SlashingProtectionDBDriverFlag.Name)
+	dataSourceName := cliCtx.String(flags.SlashingProtectionDBDSNFlag.Name)
+
+	// Other validator clients may rely on the shared database, so it is never cleared from here.
+	if cliCtx.Bool(cmd.ClearDB.Name) || cliCtx.Bool(cmd.ForceClearDB.Name) {
+		log.Warn("Clearing a SQL slashing protection database is not supported, please use the database tooling instead")
+	}
+
+	// The proposer settings and graffiti information are kept apart for each validator client.
+	clientID := cliCtx.String(flags.SlashingProtectionDBClientIDFlag.Name)
+	if clientID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return errors.Wrapf(err, "could not get host name, please set --%s", flags.SlashingProtectionDBClientIDFlag.Name)
+		}
+		clientID = hostname
+	}
+
+	valDB, err := sqldb.NewStore(cliCtx.Context, driverName, dataSourceName, &sqldb.Config{ClientID: clientID})
+	if err != nil {
+		return errors.Wrap(err, "could not create validator database")
+	}
+
+	log.WithFields(logrus.Fields{
+		"driver":       driverName,
+		"databasePath": valDB.DatabasePath(),
+		"clientID":     clientID,
+	}).Info("Checking DB")
+
+	// Assign the database to the validator client.
+	c.db = valDB
+
+	return nil
+}
+
 func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	var additionalHandlers []prometheus.Handler
 	if cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
@@ -512,6 +556,11 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	doppelgangerEpochs, err := doppelgangerEpochs(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
 	validatorService, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		DB:                      c.db,
 		Wallet:                  c.wallet,
@@ -534,12 +583,56 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		LogValidatorPerformance: !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
 		EmitAccountMetrics:      !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
 		Distributed:             c.cliCtx.Bool(flags.EnableDistributed.Name),
+		DoppelgangerEpochs:      doppelgangerEpochs,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
 	}
 
-	return c.services.RegisterService(validatorService)
+	if err := c.services.RegisterService(validatorService); err != nil {
+		return err
+	}
+
+	if c.cliCtx.IsSet(flags.ProposerSettingsRefreshIntervalFlag.Name) {
+		refresher, err := loader.NewSettingsRefresher(
+			c.cliCtx,
+			validatorService,
+			loader.WithBuilderConfig(),
+			loader.WithGasLimit(),
+			loader.WithSettingsVerification(),
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not initialize proposer settings refresher")
+		}
+		return c.services.RegisterService(refresher)
+	}
+	return nil
+}
+
+// doppelgangerEpochs returns the number of epochs during which validators are checked for liveness
+// by the doppelganger check. The number of epochs and the liveness of multiple beacon nodes are only
+// checked through the beacon node REST API, the gRPC check always covers the last 2 epochs of one beacon node.
+func doppelgangerEpochs(cliCtx *cli.Context) (primitives.Epoch, error) {
+	if features.Get().EnableDoppelGanger && !features.Get().EnableBeaconRESTApi {
+		if cliCtx.IsSet(flags.DoppelgangerEpochsFlag.Name) {
+			return 0, errors.Errorf("--%s requires --%s", flags.DoppelgangerEpochsFlag.Name, features.EnableBeaconRESTApi.Name)
+		}
+		if len(strings.Split(cliCtx.String(flags.BeaconRESTApiProviderFlag.Name), ",")) > 1 {
+			return 0, errors.Errorf(
+				"doppelganger protection with multiple beacon nodes in --%s requires --%s",
+				flags.BeaconRESTApiProviderFlag.Name,
+				features.EnableBeaconRESTApi.Name,
+			)
+		}
+	}
+	if !cliCtx.IsSet(flags.DoppelgangerEpochsFlag.Name) {
+		return primitives.Epoch(flags.DoppelgangerEpochsFlag.Value), nil
+	}
+	epochs := cliCtx.Uint64(flags.DoppelgangerEpochsFlag.Name)
+	if epochs == 0 {
+		return 0, errors.Errorf("--%s must be greater than 0", flags.DoppelgangerEpochsFlag.Name)
+	}
+	return primitives.Epoch(epochs), nil
 }
 
 func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error) {
@@ -586,6 +679,7 @@ func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*proposer.Sett
 		db,
 		loader.WithBuilderConfig(),
 		loader.WithGasLimit(),
+		loader.WithSettingsVerification(),
 	)
 	if err != nil {
 		return nil, err
@@ -11,6 +11,8 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -207,6 +209,47 @@ func TestClearDB(t *testing.T) {
 	}
 }
 
+func TestDoppelgangerEpochs(t *testing.T) {
+	newContext := func(t *testing.T, value string, hosts string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.Uint64(flags.DoppelgangerEpochsFlag.Name, flags.DoppelgangerEpochsFlag.Value, "")
+		set.String(flags.BeaconRESTApiProviderFlag.Name, flags.BeaconRESTApiProviderFlag.Value, "")
+		if value != "" {
+			require.NoError(t, set.Set(flags.DoppelgangerEpochsFlag.Name, value))
+		}
+		if hosts != "" {
+			require.NoError(t, set.Set(flags.BeaconRESTApiProviderFlag.Name, hosts))
+		}
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+
+	t.Run("beacon REST API", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableDoppelGanger: true, EnableBeaconRESTApi: true})
+		defer resetCfg()
+
+		epochs, err := doppelgangerEpochs(newContext(t, "", "http://a:3500,http://b:3500"))
+		require.NoError(t, err)
+		assert.Equal(t, primitives.Epoch(2), epochs)
+		epochs, err = doppelgangerEpochs(newContext(t, "5", ""))
+		require.NoError(t, err)
+		assert.Equal(t, primitives.Epoch(5), epochs)
+		_, err = doppelgangerEpochs(newContext(t, "0", ""))
+		require.ErrorContains(t, "--doppelganger-epochs must be greater than 0", err)
+	})
+	t.Run("gRPC", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+		defer resetCfg()
+
+		epochs, err := doppelgangerEpochs(newContext(t, "", ""))
+		require.NoError(t, err)
+		assert.Equal(t, primitives.Epoch(2), epochs)
+		_, err = doppelgangerEpochs(newContext(t, "5", ""))
+		require.ErrorContains(t, "--doppelganger-epochs requires --enable-beacon-rest-api", err)
+		_, err = doppelgangerEpochs(newContext(t, "", "http://a:3500,http://b:3500"))
+		require.ErrorContains(t, "multiple beacon nodes in --beacon-rest-api-provider requires --enable-beacon-rest-api", err)
+	})
+}
+
 // TestWeb3SignerConfig tests the web3 signer config returns the correct values.
 func TestWeb3SignerConfig(t *testing.T) {
 	type args struct {
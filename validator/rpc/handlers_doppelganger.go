@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+// ListDoppelgangerStatuses returns the doppelganger protection status of every validating key.
+// Keys with a pending or detected status do not perform any duty.
+func (s *Server) ListDoppelgangerStatuses(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.web.doppelganger.ListDoppelgangerStatuses")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	if !s.walletInitialized {
+		httputil.HandleError(w, "Prysm Wallet not initialized. Please create a new wallet.", http.StatusServiceUnavailable)
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not retrieve public keys: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	statuses, err := s.validatorService.DoppelgangerStatuses()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	data := make([]*DoppelgangerStatus, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		status, ok := statuses[pubKey]
+		if !ok {
+			status = iface.DoppelgangerUnknown
+		}
+		data[i] = &DoppelgangerStatus{
+			Pubkey: hexutil.Encode(pubKey[:]),
+			Status: string(status),
+		}
+	}
+
+	httputil.WriteJson(w, &DoppelgangerStatusesResponse{
+		Enabled: features.Get().EnableDoppelGanger,
+		Data:    data,
+	})
+}
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	clientiface "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	constant "github.com/prysmaticlabs/prysm/v5/validator/testing"
+)
+
+func TestServer_ListDoppelgangerStatuses(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableDoppelGanger: true,
+	})
+	defer resetCfg()
+
+	ctx := context.Background()
+	localWalletDir := setupWalletDir(t)
+	defaultWalletPath = localWalletDir
+	opts := []accounts.Option{
+		accounts.WithWalletDir(defaultWalletPath),
+		accounts.WithKeymanagerType(keymanager.Derived),
+		accounts.WithWalletPassword(strongPass),
+		accounts.WithSkipMnemonicConfirm(true),
+	}
+	acc, err := accounts.NewCLIManager(opts...)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(ctx)
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	dr, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, dr.RecoverAccountsFromMnemonic(ctx, constant.TestMnemonic, derived.DefaultMnemonicLanguage, "", 3))
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pubKeys))
+
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet: w,
+		Validator: &mock.Validator{
+			Km: km,
+			DoppelgangerStatus: map[[fieldparams.BLSPubkeyLength]byte]clientiface.DoppelgangerStatus{
+				pubKeys[0]: clientiface.DoppelgangerSafe,
+				pubKeys[1]: clientiface.DoppelgangerPending,
+			},
+		},
+	})
+	require.NoError(t, err)
+	s := &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, api.WebUrlPrefix+"accounts/doppelganger", nil)
+	wr := httptest.NewRecorder()
+	wr.Body = &bytes.Buffer{}
+	s.ListDoppelgangerStatuses(wr, req)
+	require.Equal(t, http.StatusOK, wr.Code)
+	resp := &DoppelgangerStatusesResponse{}
+	require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
+	assert.Equal(t, true, resp.Enabled)
+	require.Equal(t, 3, len(resp.Data))
+	wantStatuses := []clientiface.DoppelgangerStatus{
+		clientiface.DoppelgangerSafe,
+		clientiface.DoppelgangerPending,
+		clientiface.DoppelgangerUnknown,
+	}
+	for i, status := range wantStatuses {
+		assert.Equal(t, hexutil.Encode(pubKeys[i][:]), resp.Data[i].Pubkey)
+		assert.Equal(t, string(status), resp.Data[i].Status)
+	}
+}
@@ -33,6 +33,7 @@ func TestServer_InitializeRoutes(t *testing.T) {
 		"/v2/validator/slashing-protection/import":   {http.MethodPost},
 		"/v2/validator/accounts":                     {http.MethodGet},
 		"/v2/validator/accounts/backup":              {http.MethodPost},
+		"/v2/validator/accounts/doppelganger":        {http.MethodGet},
 		"/v2/validator/accounts/voluntary-exit":      {http.MethodPost},
 		"/v2/validator/beacon/balances":              {http.MethodGet},
 		"/v2/validator/beacon/peers":                 {http.MethodGet},
@@ -195,6 +195,16 @@ type Account struct {
 	DerivationPath      string `json:"derivation_path"`
 }
 
+type DoppelgangerStatusesResponse struct {
+	Enabled bool                  `json:"enabled"`
+	Data    []*DoppelgangerStatus `json:"data"`
+}
+
+type DoppelgangerStatus struct {
+	Pubkey string `json:"pubkey"`
+	Status string `json:"status"`
+}
+
 type VoluntaryExitResponse struct {
 	ExitedKeys [][]byte `protobuf:"bytes,1,rep,name=exited_keys,json=exitedKeys,proto3" json:"exited_keys,omitempty"`
 }
@@ -0,0 +1,293 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// MinimalProtection is the minimal slashing protection of a public key, as defined by EIP-3076:
+// the highest signed block slot and the highest signed attestation source and target epochs.
+// A nil field means nothing was signed yet.
+type MinimalProtection struct {
+	HighestSlot        *primitives.Slot
+	HighestSourceEpoch *primitives.Epoch
+	HighestTargetEpoch *primitives.Epoch
+}
+
+// KeyImportReport describes how importing slashing protection data would change the minimal
+// slashing protection of a public key.
+type KeyImportReport struct {
+	PubKey string
+	// Before and After are the minimal slashing protection of the public key before and after the import.
+	Before MinimalProtection
+	After  MinimalProtection
+	// TighteningBlocks and TighteningAttestations count the imported messages raising the minimal slashing protection.
+	TighteningBlocks       int
+	TighteningAttestations int
+}
+
+// Tightened returns true if the import would raise the minimal slashing protection of the public key.
+func (r *KeyImportReport) Tightened() bool {
+	return r.TighteningBlocks > 0 || r.TighteningAttestations > 0
+}
+
+// ImportReport describes how importing EIP-3076 slashing protection data would change the validator database.
+type ImportReport struct {
+	// Keys contains one report per imported public key, sorted by public key.
+	Keys []*KeyImportReport
+	// Conflicts contains the imported messages which are slashable with respect to other imported
+	// messages or to messages already recorded in the database.
+	Conflicts []*Conflict
+}
+
+// CompareStandardProtectionJSON compares EIP-3076 slashing protection data with the history recorded in the
+// validator database without modifying it, reporting which imported records would tighten the minimal slashing
+// protection of each public key, and which would conflict with the recorded history or the rest of the data.
+func CompareStandardProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	interchangeJSON *format.EIPSlashingProtectionFormat,
+) (*ImportReport, error) {
+	// Conflicts within the imported data itself.
+	conflicts, err := ValidateStandardProtectionJSON(interchangeJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// The import would be rejected if the data was created on a different chain.
+	if err := checkGenesisValidatorsRoot(ctx, validatorDB, interchangeJSON); err != nil {
+		return nil, err
+	}
+
+	recordsList, err := parseSignedRecords(interchangeJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse slashing protection data")
+	}
+
+	report := &ImportReport{
+		Keys:      make([]*KeyImportReport, 0, len(recordsList)),
+		Conflicts: conflicts,
+	}
+
+	for _, imported := range recordsList {
+		recorded, err := recordedSignedRecords(ctx, validatorDB, imported)
+		if err != nil {
+			return nil, err
+		}
+
+		keyReport := &KeyImportReport{PubKey: imported.pubKeyHex}
+		keyReport.Before = minimalProtection(recorded)
+		keyReport.After = keyReport.Before
+
+		for _, block := range imported.blocks {
+			if keyReport.Before.HighestSlot == nil || block.slot > *keyReport.Before.HighestSlot {
+				keyReport.TighteningBlocks++
+			}
+
+			keyReport.After.HighestSlot = maxSlot(keyReport.After.HighestSlot, block.slot)
+		}
+
+		for _, att := range imported.attestations {
+			before := keyReport.Before
+			if before.HighestSourceEpoch == nil || att.source > *before.HighestSourceEpoch || att.target > *before.HighestTargetEpoch {
+				keyReport.TighteningAttestations++
+			}
+
+			keyReport.After.HighestSourceEpoch = maxEpoch(keyReport.After.HighestSourceEpoch, att.source)
+			keyReport.After.HighestTargetEpoch = maxEpoch(keyReport.After.HighestTargetEpoch, att.target)
+		}
+
+		report.Keys = append(report.Keys, keyReport)
+		report.Conflicts = append(report.Conflicts, recordedConflicts(imported, recorded)...)
+	}
+
+	return report, nil
+}
+
+// checkGenesisValidatorsRoot checks, without saving anything, that the genesis validators root of the
+// slashing protection data matches the one of the database, if any.
+func checkGenesisValidatorsRoot(ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat) error {
+	genesisValidatorsRoot, err := helpers.RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+	}
+
+	recordedGenesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis validators root from db")
+	}
+
+	if recordedGenesisValidatorsRoot != nil && !bytes.Equal(recordedGenesisValidatorsRoot, genesisValidatorsRoot[:]) {
+		return errors.New("genesis validators root doesn't match the one that is stored in slashing protection db")
+	}
+
+	return nil
+}
+
+// recordedSignedRecords returns the signed blocks and attestations recorded in the database for the public key
+// of the imported records, sorted the same way as parsed records.
+func recordedSignedRecords(ctx context.Context, validatorDB db.Database, imported *signedRecords) (*signedRecords, error) {
+	recorded := &signedRecords{pubKey: imported.pubKey, pubKeyHex: imported.pubKeyHex}
+
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, imported.pubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get proposal history for public key %s", imported.pubKeyHex)
+	}
+
+	for _, proposal := range proposals {
+		recorded.blocks = append(recorded.blocks, &signedBlock{slot: proposal.Slot, signingRoot: knownSigningRoot(proposal.SigningRoot)})
+	}
+
+	attestations, err := validatorDB.AttestationHistoryForPubKey(ctx, imported.pubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get attestation history for public key %s", imported.pubKeyHex)
+	}
+
+	for _, att := range attestations {
+		recorded.attestations = append(recorded.attestations, &signedAttestation{
+			source:      att.Source,
+			target:      att.Target,
+			signingRoot: knownSigningRoot(att.SigningRoot),
+		})
+	}
+
+	sort.SliceStable(recorded.attestations, func(i, j int) bool {
+		a, b := recorded.attestations[i], recorded.attestations[j]
+		if a.source != b.source {
+			return a.source < b.source
+		}
+
+		return a.target < b.target
+	})
+
+	return recorded, nil
+}
+
+// recordedConflicts returns the imported messages which are slashable with respect to the recorded ones.
+func recordedConflicts(imported, recorded *signedRecords) []*Conflict {
+	conflicts := make([]*Conflict, 0)
+
+	recordedBlockBySlot := make(map[primitives.Slot]*signedBlock, len(recorded.blocks))
+	for _, block := range recorded.blocks {
+		recordedBlockBySlot[block.slot] = block
+	}
+
+	for _, block := range imported.blocks {
+		if existing, ok := recordedBlockBySlot[block.slot]; ok && signingRootsConflict(existing.signingRoot, block.signingRoot) {
+			conflicts = append(conflicts, &Conflict{
+				PubKey:      imported.pubKeyHex,
+				Kind:        DoubleProposal,
+				Description: fmt.Sprintf("slot %d already signed in database with signing root %#x, imported %#x", block.slot, existing.signingRoot, block.signingRoot),
+			})
+		}
+	}
+
+	recordedAttestationByTarget := make(map[primitives.Epoch]*signedAttestation, len(recorded.attestations))
+	for _, att := range recorded.attestations {
+		recordedAttestationByTarget[att.target] = att
+	}
+
+	for _, att := range imported.attestations {
+		if existing, ok := recordedAttestationByTarget[att.target]; ok && signingRootsConflict(existing.signingRoot, att.signingRoot) {
+			conflicts = append(conflicts, &Conflict{
+				PubKey:      imported.pubKeyHex,
+				Kind:        DoubleVote,
+				Description: fmt.Sprintf("target epoch %d already signed in database with signing root %#x, imported %#x", att.target, existing.signingRoot, att.signingRoot),
+			})
+		}
+	}
+
+	for _, pair := range surroundingPairs(recorded.attestations, imported.attestations) {
+		conflicts = append(conflicts, &Conflict{
+			PubKey:      imported.pubKeyHex,
+			Kind:        SurroundVote,
+			Description: fmt.Sprintf("recorded %s surrounds imported %s", attestationString(pair[0]), attestationString(pair[1])),
+		})
+	}
+
+	for _, pair := range surroundingPairs(imported.attestations, recorded.attestations) {
+		conflicts = append(conflicts, &Conflict{
+			PubKey:      imported.pubKeyHex,
+			Kind:        SurroundVote,
+			Description: fmt.Sprintf("imported %s surrounds recorded %s", attestationString(pair[0]), attestationString(pair[1])),
+		})
+	}
+
+	return conflicts
+}
+
+// surroundingPairs returns, for each inner attestation surrounded by at least one outer attestation,
+// the pair (outer attestation with the highest target, inner attestation).
+// Both lists must be sorted by source then target epoch.
+func surroundingPairs(outer, inner []*signedAttestation) [][2]*signedAttestation {
+	pairs := make([][2]*signedAttestation, 0)
+
+	var (
+		outerIndex  int
+		surrounding *signedAttestation
+	)
+
+	for _, att := range inner {
+		// Consider all outer attestations with a strictly lower source.
+		for ; outerIndex < len(outer) && outer[outerIndex].source < att.source; outerIndex++ {
+			if surrounding == nil || outer[outerIndex].target > surrounding.target {
+				surrounding = outer[outerIndex]
+			}
+		}
+
+		if surrounding != nil && surrounding.target > att.target {
+			pairs = append(pairs, [2]*signedAttestation{surrounding, att})
+		}
+	}
+
+	return pairs
+}
+
+// minimalProtection computes the minimal slashing protection corresponding to the signed records.
+func minimalProtection(records *signedRecords) MinimalProtection {
+	protection := MinimalProtection{}
+
+	for _, block := range records.blocks {
+		protection.HighestSlot = maxSlot(protection.HighestSlot, block.slot)
+	}
+
+	for _, att := range records.attestations {
+		protection.HighestSourceEpoch = maxEpoch(protection.HighestSourceEpoch, att.source)
+		protection.HighestTargetEpoch = maxEpoch(protection.HighestTargetEpoch, att.target)
+	}
+
+	return protection
+}
+
+// knownSigningRoot returns nil for a missing or zero signing root.
+func knownSigningRoot(signingRoot []byte) []byte {
+	if len(signingRoot) == 0 || bytes.Equal(signingRoot, make([]byte, len(signingRoot))) {
+		return nil
+	}
+
+	return signingRoot
+}
+
+func maxSlot(current *primitives.Slot, slot primitives.Slot) *primitives.Slot {
+	if current != nil && *current >= slot {
+		return current
+	}
+
+	return &slot
+}
+
+func maxEpoch(current *primitives.Epoch, epoch primitives.Epoch) *primitives.Epoch {
+	if current != nil && *current >= epoch {
+		return current
+	}
+
+	return &epoch
+}
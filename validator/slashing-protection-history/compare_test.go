@@ -0,0 +1,95 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+)
+
+func TestCompareStandardProtectionJSON(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		t.Run(fmt.Sprintf("isSlashingProtectionMinimal=%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			ctx := context.Background()
+			pubKey, err := helpers.PubKeyFromHex(testPubKey)
+			require.NoError(t, err)
+			validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, isSlashingProtectionMinimal)
+
+			genesisValidatorsRoot, err := helpers.RootFromHex(testGenesisValidatorsRoot)
+			require.NoError(t, err)
+			require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+			require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{10}))
+			require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{3}, createAttestation(2, 3)))
+
+			// Slot 9 does not tighten, slot 11 does.
+			// Attestation (2, 3) does not tighten, attestation (3, 4) does.
+			interchangeJSON := testInterchangeJSON([]string{"9", "11"}, [][2]string{{"2", "3"}, {"3", "4"}})
+			interchangeJSON.Data[0].SignedAttestations[0].SigningRoot = fmt.Sprintf("%#x", [32]byte{3})
+
+			report, err := CompareStandardProtectionJSON(ctx, validatorDB, interchangeJSON)
+			require.NoError(t, err)
+			require.Equal(t, 0, len(report.Conflicts))
+			require.Equal(t, 1, len(report.Keys))
+
+			keyReport := report.Keys[0]
+			require.Equal(t, testPubKey, keyReport.PubKey)
+			require.Equal(t, true, keyReport.Tightened())
+			require.Equal(t, 1, keyReport.TighteningBlocks)
+			require.Equal(t, 1, keyReport.TighteningAttestations)
+			require.Equal(t, primitives.Slot(10), *keyReport.Before.HighestSlot)
+			require.Equal(t, primitives.Slot(11), *keyReport.After.HighestSlot)
+			require.Equal(t, primitives.Epoch(2), *keyReport.Before.HighestSourceEpoch)
+			require.Equal(t, primitives.Epoch(3), *keyReport.After.HighestSourceEpoch)
+			require.Equal(t, primitives.Epoch(3), *keyReport.Before.HighestTargetEpoch)
+			require.Equal(t, primitives.Epoch(4), *keyReport.After.HighestTargetEpoch)
+
+			// Nothing was written to the database.
+			proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(proposals))
+			require.Equal(t, primitives.Slot(10), proposals[0].Slot)
+		})
+	}
+}
+
+func TestCompareStandardProtectionJSON_Conflicts(t *testing.T) {
+	ctx := context.Background()
+	pubKey, err := helpers.PubKeyFromHex(testPubKey)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, false)
+
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{10}))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{3}, createAttestation(2, 3)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, [32]byte{8}, createAttestation(5, 8)))
+
+	// Slot 10 is signed with another signing root, attestation (2, 3) double votes,
+	// attestation (1, 4) surrounds (2, 3), both recorded and imported, and attestation (6, 7) is surrounded by (5, 8).
+	interchangeJSON := testInterchangeJSON([]string{"10"}, [][2]string{{"2", "3"}, {"1", "4"}, {"6", "7"}})
+
+	report, err := CompareStandardProtectionJSON(ctx, validatorDB, interchangeJSON)
+	require.NoError(t, err)
+
+	kinds := make([]ConflictKind, 0, len(report.Conflicts))
+	for _, conflict := range report.Conflicts {
+		kinds = append(kinds, conflict.Kind)
+	}
+	require.DeepEqual(t, []ConflictKind{SurroundVote, DoubleProposal, DoubleVote, SurroundVote, SurroundVote}, kinds)
+
+	require.Equal(t, "attestation (source 1, target 4) surrounds attestation (source 2, target 3)", report.Conflicts[0].Description)
+	require.Equal(t, "recorded attestation (source 5, target 8) surrounds imported attestation (source 6, target 7)", report.Conflicts[3].Description)
+	require.Equal(t, "imported attestation (source 1, target 4) surrounds recorded attestation (source 2, target 3)", report.Conflicts[4].Description)
+}
+
+func TestCompareStandardProtectionJSON_GenesisValidatorsRootMismatch(t *testing.T) {
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, nil, false)
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+
+	_, err := CompareStandardProtectionJSON(ctx, validatorDB, testInterchangeJSON(nil, nil))
+	require.ErrorContains(t, "genesis validators root doesn't match", err)
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/pkg/errors"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/progress"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
 	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
@@ -127,6 +129,74 @@ func ExportStandardProtectionJSON(
 	return interchangeJSON, nil
 }
 
+// FilterStandardProtectionJSONSinceEpoch removes from EIP-3076 slashing protection data the signed blocks
+// with a slot before the start of sinceEpoch and the signed attestations with a target epoch before sinceEpoch,
+// so only the history signed since then is transferred. Public keys left without any record are removed.
+func FilterStandardProtectionJSONSinceEpoch(interchangeJSON *format.EIPSlashingProtectionFormat, sinceEpoch primitives.Epoch) error {
+	sinceSlot, err := slots.EpochStart(sinceEpoch)
+	if err != nil {
+		return errors.Wrapf(err, "could not get start slot of epoch %d", sinceEpoch)
+	}
+
+	dataList := make([]*format.ProtectionData, 0, len(interchangeJSON.Data))
+	for _, item := range interchangeJSON.Data {
+		signedBlocks := make([]*format.SignedBlock, 0, len(item.SignedBlocks))
+		for _, sb := range item.SignedBlocks {
+			slot, err := helpers.SlotFromString(sb.Slot)
+			if err != nil {
+				return errors.Wrapf(err, "%s is not a valid slot", sb.Slot)
+			}
+			if slot >= sinceSlot {
+				signedBlocks = append(signedBlocks, sb)
+			}
+		}
+
+		signedAttestations := make([]*format.SignedAttestation, 0, len(item.SignedAttestations))
+		for _, sa := range item.SignedAttestations {
+			target, err := helpers.EpochFromString(sa.TargetEpoch)
+			if err != nil {
+				return errors.Wrapf(err, "%s is not a valid target epoch", sa.TargetEpoch)
+			}
+			if target >= sinceEpoch {
+				signedAttestations = append(signedAttestations, sa)
+			}
+		}
+
+		if len(signedBlocks) == 0 && len(signedAttestations) == 0 {
+			continue
+		}
+		item.SignedBlocks = signedBlocks
+		item.SignedAttestations = signedAttestations
+		dataList = append(dataList, item)
+	}
+	interchangeJSON.Data = dataList
+	return nil
+}
+
+// MissingStandardProtectionJSONPubKeys returns the given public keys which have no history
+// in EIP-3076 slashing protection data.
+func MissingStandardProtectionJSONPubKeys(
+	interchangeJSON *format.EIPSlashingProtectionFormat,
+	pubKeys [][fieldparams.BLSPubkeyLength]byte,
+) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	found := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(interchangeJSON.Data))
+	for _, item := range interchangeJSON.Data {
+		pubKey, err := helpers.PubKeyFromHex(item.Pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid public key", item.Pubkey)
+		}
+		found[pubKey] = true
+	}
+
+	missing := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for _, pubKey := range pubKeys {
+		if !found[pubKey] {
+			missing = append(missing, pubKey)
+		}
+	}
+	return missing, nil
+}
+
 func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 )
 
@@ -263,3 +264,39 @@ func createAttestation(source, target primitives.Epoch) *ethpb.IndexedAttestatio
 		},
 	}
 }
+
+func TestFilterStandardProtectionJSONSinceEpoch(t *testing.T) {
+	// With 32 slots per epoch, epoch 2 starts at slot 64.
+	interchangeJSON := testInterchangeJSON([]string{"63", "64", "100"}, [][2]string{{"0", "1"}, {"1", "2"}, {"2", "3"}})
+	require.NoError(t, FilterStandardProtectionJSONSinceEpoch(interchangeJSON, 2))
+
+	require.Equal(t, 1, len(interchangeJSON.Data))
+	require.DeepEqual(t, []*format.SignedBlock{
+		{Slot: "64", SigningRoot: fmt.Sprintf("0x%064x", 2)},
+		{Slot: "100", SigningRoot: fmt.Sprintf("0x%064x", 3)},
+	}, interchangeJSON.Data[0].SignedBlocks)
+	require.DeepEqual(t, []*format.SignedAttestation{
+		{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("0x%064x", 2)},
+		{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("0x%064x", 3)},
+	}, interchangeJSON.Data[0].SignedAttestations)
+
+	// Public keys without any remaining record are removed.
+	require.NoError(t, FilterStandardProtectionJSONSinceEpoch(interchangeJSON, 10))
+	require.Equal(t, 0, len(interchangeJSON.Data))
+}
+
+func TestMissingStandardProtectionJSONPubKeys(t *testing.T) {
+	interchangeJSON := testInterchangeJSON([]string{"1"}, nil)
+	pubKey, err := helpers.PubKeyFromHex(testPubKey)
+	require.NoError(t, err)
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	missing, err := MissingStandardProtectionJSONPubKeys(interchangeJSON, [][fieldparams.BLSPubkeyLength]byte{otherPubKey, pubKey})
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{otherPubKey}, missing)
+
+	missing, err = MissingStandardProtectionJSONPubKeys(interchangeJSON, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(missing))
+	require.Equal(t, 1, len(interchangeJSON.Data))
+}
@@ -0,0 +1,154 @@
+package history
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// signedBlock is the parsed representation of a format.SignedBlock.
+type signedBlock struct {
+	slot        primitives.Slot
+	signingRoot []byte
+}
+
+// signedAttestation is the parsed representation of a format.SignedAttestation.
+type signedAttestation struct {
+	source      primitives.Epoch
+	target      primitives.Epoch
+	signingRoot []byte
+}
+
+// signedRecords contains all the signed blocks and attestations of a public key.
+type signedRecords struct {
+	pubKey       [fieldparams.BLSPubkeyLength]byte
+	pubKeyHex    string
+	blocks       []*signedBlock
+	attestations []*signedAttestation
+}
+
+// parseSignedRecords parses the interchange data, merging the entries of duplicate public keys.
+// Records are returned sorted by public key, blocks sorted by slot and attestations sorted by source then target.
+func parseSignedRecords(interchangeJSON *format.EIPSlashingProtectionFormat) ([]*signedRecords, error) {
+	recordsByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*signedRecords)
+
+	for _, item := range interchangeJSON.Data {
+		if item == nil {
+			continue
+		}
+
+		pubKey, err := helpers.PubKeyFromHex(item.Pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid public key", item.Pubkey)
+		}
+
+		records, ok := recordsByPubKey[pubKey]
+		if !ok {
+			records = &signedRecords{pubKey: pubKey, pubKeyHex: item.Pubkey}
+			recordsByPubKey[pubKey] = records
+		}
+
+		for _, sb := range item.SignedBlocks {
+			if sb == nil {
+				continue
+			}
+
+			slot, err := helpers.SlotFromString(sb.Slot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid slot", sb.Slot)
+			}
+
+			signingRoot, err := signingRootFromHex(sb.SigningRoot)
+			if err != nil {
+				return nil, err
+			}
+
+			records.blocks = append(records.blocks, &signedBlock{slot: slot, signingRoot: signingRoot})
+		}
+
+		for _, sa := range item.SignedAttestations {
+			if sa == nil {
+				continue
+			}
+
+			source, err := helpers.EpochFromString(sa.SourceEpoch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid source epoch", sa.SourceEpoch)
+			}
+
+			target, err := helpers.EpochFromString(sa.TargetEpoch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid target epoch", sa.TargetEpoch)
+			}
+
+			signingRoot, err := signingRootFromHex(sa.SigningRoot)
+			if err != nil {
+				return nil, err
+			}
+
+			records.attestations = append(records.attestations, &signedAttestation{
+				source:      source,
+				target:      target,
+				signingRoot: signingRoot,
+			})
+		}
+	}
+
+	result := make([]*signedRecords, 0, len(recordsByPubKey))
+	for _, records := range recordsByPubKey {
+		sort.SliceStable(records.blocks, func(i, j int) bool {
+			return records.blocks[i].slot < records.blocks[j].slot
+		})
+
+		sort.SliceStable(records.attestations, func(i, j int) bool {
+			a, b := records.attestations[i], records.attestations[j]
+			if a.source != b.source {
+				return a.source < b.source
+			}
+
+			return a.target < b.target
+		})
+
+		result = append(result, records)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].pubKey[:], result[j].pubKey[:]) < 0
+	})
+
+	return result, nil
+}
+
+// signingRootFromHex converts an optional signing root to bytes.
+// A missing or zero signing root is returned as nil, meaning unknown.
+func signingRootFromHex(str string) ([]byte, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	root, err := helpers.RootFromHex(str)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s is not a valid signing root", str)
+	}
+
+	if root == [32]byte{} {
+		return nil, nil
+	}
+
+	return root[:], nil
+}
+
+// signingRootsConflict returns true if both signing roots are known and differ.
+// Two messages with unknown signing roots cannot be proven to conflict.
+func signingRootsConflict(a, b []byte) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+
+	return !bytes.Equal(a, b)
+}
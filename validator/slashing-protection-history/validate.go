@@ -0,0 +1,149 @@
+package history
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// ConflictKind describes why two signed messages conflict.
+type ConflictKind string
+
+const (
+	// DoubleProposal is two different blocks signed for the same slot.
+	DoubleProposal ConflictKind = "double proposal"
+	// DoubleVote is two different attestations signed for the same target epoch.
+	DoubleVote ConflictKind = "double vote"
+	// SurroundVote is an attestation surrounding, or surrounded by, another attestation.
+	SurroundVote ConflictKind = "surround vote"
+	// InvalidAttestation is an attestation with a source epoch greater than its target epoch.
+	InvalidAttestation ConflictKind = "invalid attestation"
+)
+
+// Conflict is a slashable (or invalid) signed message found in slashing protection data.
+type Conflict struct {
+	PubKey      string
+	Kind        ConflictKind
+	Description string
+}
+
+// String returns a human readable description of the conflict.
+func (c *Conflict) String() string {
+	return fmt.Sprintf("%s: %s, %s", c.PubKey, c.Kind, c.Description)
+}
+
+// ValidateStandardProtectionJSON checks an EIP-3076 interchange file for signed messages which are slashable
+// with respect to other messages of the same file, such as double proposals, double votes or surround votes.
+// Entries of duplicate public keys are checked together. Messages with the same slot (or target epoch) are only
+// considered conflicting if both signing roots are known and differ.
+func ValidateStandardProtectionJSON(interchangeJSON *format.EIPSlashingProtectionFormat) ([]*Conflict, error) {
+	if interchangeJSON == nil {
+		return nil, errors.New("no slashing protection data to validate")
+	}
+
+	if interchangeJSON.Metadata.InterchangeFormatVersion != format.InterchangeFormatVersion {
+		return nil, fmt.Errorf(
+			"slashing protection JSON version '%s' is not supported, wanted '%s'",
+			interchangeJSON.Metadata.InterchangeFormatVersion,
+			format.InterchangeFormatVersion,
+		)
+	}
+
+	recordsList, err := parseSignedRecords(interchangeJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse slashing protection data")
+	}
+
+	conflicts := make([]*Conflict, 0)
+	for _, records := range recordsList {
+		conflicts = append(conflicts, blockConflicts(records)...)
+		conflicts = append(conflicts, attestationConflicts(records)...)
+	}
+
+	return conflicts, nil
+}
+
+// blockConflicts returns the double proposals among the blocks of a public key.
+func blockConflicts(records *signedRecords) []*Conflict {
+	conflicts := make([]*Conflict, 0)
+	blockBySlot := make(map[uint64]*signedBlock, len(records.blocks))
+
+	for _, block := range records.blocks {
+		existing, ok := blockBySlot[uint64(block.slot)]
+		if !ok || len(existing.signingRoot) == 0 {
+			blockBySlot[uint64(block.slot)] = block
+			continue
+		}
+
+		if signingRootsConflict(existing.signingRoot, block.signingRoot) {
+			conflicts = append(conflicts, &Conflict{
+				PubKey:      records.pubKeyHex,
+				Kind:        DoubleProposal,
+				Description: fmt.Sprintf("slot %d signed with signing roots %#x and %#x", block.slot, existing.signingRoot, block.signingRoot),
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// attestationConflicts returns the invalid attestations, double votes and surround votes among the
+// attestations of a public key, which must be sorted by source then target epoch.
+func attestationConflicts(records *signedRecords) []*Conflict {
+	conflicts := make([]*Conflict, 0)
+	attestationByTarget := make(map[uint64]*signedAttestation, len(records.attestations))
+
+	// Attestation with the highest target among the attestations with a strictly lower source
+	// than the current one. If its target is higher than the current target, it surrounds the current attestation.
+	var (
+		surrounding          *signedAttestation
+		candidateSurrounding *signedAttestation
+	)
+
+	for i, att := range records.attestations {
+		// Attestations with the previous source are now strictly lower than the current source.
+		if i > 0 && records.attestations[i-1].source < att.source {
+			surrounding = candidateSurrounding
+		}
+
+		if att.source > att.target {
+			conflicts = append(conflicts, &Conflict{
+				PubKey:      records.pubKeyHex,
+				Kind:        InvalidAttestation,
+				Description: fmt.Sprintf("source epoch %d greater than target epoch %d", att.source, att.target),
+			})
+
+			continue
+		}
+
+		existing, ok := attestationByTarget[uint64(att.target)]
+		if !ok || len(existing.signingRoot) == 0 {
+			attestationByTarget[uint64(att.target)] = att
+		} else if signingRootsConflict(existing.signingRoot, att.signingRoot) {
+			conflicts = append(conflicts, &Conflict{
+				PubKey:      records.pubKeyHex,
+				Kind:        DoubleVote,
+				Description: fmt.Sprintf("target epoch %d signed with signing roots %#x and %#x", att.target, existing.signingRoot, att.signingRoot),
+			})
+		}
+
+		if surrounding != nil && surrounding.target > att.target {
+			conflicts = append(conflicts, &Conflict{
+				PubKey:      records.pubKeyHex,
+				Kind:        SurroundVote,
+				Description: fmt.Sprintf("%s surrounds %s", attestationString(surrounding), attestationString(att)),
+			})
+		}
+
+		if candidateSurrounding == nil || att.target > candidateSurrounding.target {
+			candidateSurrounding = att
+		}
+	}
+
+	return conflicts
+}
+
+func attestationString(att *signedAttestation) string {
+	return fmt.Sprintf("attestation (source %d, target %d)", att.source, att.target)
+}
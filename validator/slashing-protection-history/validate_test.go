@@ -0,0 +1,109 @@
+package history
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+const (
+	testGenesisValidatorsRoot = "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+	testPubKey                = "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed"
+)
+
+func testInterchangeJSON(blockSlots []string, attestations [][2]string) *format.EIPSlashingProtectionFormat {
+	item := &format.ProtectionData{Pubkey: testPubKey}
+	for i, slot := range blockSlots {
+		item.SignedBlocks = append(item.SignedBlocks, &format.SignedBlock{
+			Slot:        slot,
+			SigningRoot: fmt.Sprintf("0x%064x", i+1),
+		})
+	}
+	for i, att := range attestations {
+		item.SignedAttestations = append(item.SignedAttestations, &format.SignedAttestation{
+			SourceEpoch: att[0],
+			TargetEpoch: att[1],
+			SigningRoot: fmt.Sprintf("0x%064x", i+1),
+		})
+	}
+
+	interchangeJSON := &format.EIPSlashingProtectionFormat{Data: []*format.ProtectionData{item}}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = testGenesisValidatorsRoot
+	return interchangeJSON
+}
+
+func TestValidateStandardProtectionJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		blockSlots   []string
+		attestations [][2]string
+		wantKinds    []ConflictKind
+	}{
+		{
+			name:         "no conflict",
+			blockSlots:   []string{"1", "2"},
+			attestations: [][2]string{{"0", "1"}, {"1", "2"}, {"1", "3"}},
+			wantKinds:    []ConflictKind{},
+		},
+		{
+			name:       "double proposal",
+			blockSlots: []string{"2", "2"},
+			wantKinds:  []ConflictKind{DoubleProposal},
+		},
+		{
+			name:         "double vote",
+			attestations: [][2]string{{"0", "2"}, {"1", "2"}},
+			wantKinds:    []ConflictKind{DoubleVote},
+		},
+		{
+			name:         "surround vote",
+			attestations: [][2]string{{"0", "5"}, {"1", "3"}},
+			wantKinds:    []ConflictKind{SurroundVote},
+		},
+		{
+			name:         "surrounded vote",
+			attestations: [][2]string{{"2", "3"}, {"1", "4"}},
+			wantKinds:    []ConflictKind{SurroundVote},
+		},
+		{
+			name:         "invalid attestation",
+			attestations: [][2]string{{"3", "2"}},
+			wantKinds:    []ConflictKind{InvalidAttestation},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts, err := ValidateStandardProtectionJSON(testInterchangeJSON(tt.blockSlots, tt.attestations))
+			require.NoError(t, err)
+
+			kinds := make([]ConflictKind, 0, len(conflicts))
+			for _, conflict := range conflicts {
+				require.Equal(t, testPubKey, conflict.PubKey)
+				kinds = append(kinds, conflict.Kind)
+			}
+			require.DeepEqual(t, tt.wantKinds, kinds)
+		})
+	}
+}
+
+func TestValidateStandardProtectionJSON_SameSigningRoot(t *testing.T) {
+	interchangeJSON := testInterchangeJSON([]string{"2", "2"}, [][2]string{{"0", "2"}, {"0", "2"}})
+	item := interchangeJSON.Data[0]
+	item.SignedBlocks[1].SigningRoot = item.SignedBlocks[0].SigningRoot
+	item.SignedAttestations[1].SigningRoot = ""
+
+	conflicts, err := ValidateStandardProtectionJSON(interchangeJSON)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(conflicts))
+}
+
+func TestValidateStandardProtectionJSON_BadVersion(t *testing.T) {
+	interchangeJSON := testInterchangeJSON(nil, nil)
+	interchangeJSON.Metadata.InterchangeFormatVersion = "1"
+
+	_, err := ValidateStandardProtectionJSON(interchangeJSON)
+	require.ErrorContains(t, "is not supported", err)
+}